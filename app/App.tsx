@@ -5,10 +5,14 @@ import * as Sentry from '@sentry/react-native';
 import {StatusBar} from 'react-native';
 import HomeCall from "./components/HomeCall";
 import {ComponentType} from "react";
+import {registerBackgroundHeartbeats} from "./lib/heartbeat";
 
 // Hopefully make TextEncoder available
 applyGlobalPolyfills()
 
+// Must be registered before the app renders, background messages are handled without it
+registerBackgroundHeartbeats()
+
 let app: ComponentType = () => {
 
   useKeepAwake();
@@ -9,18 +9,6 @@ interface WebViewRef {
   reload(): void
 }
 
-const injectHomecallDeviceToken = async (token: string, webViewRef?: WebViewRef) => {
-  if (!webViewRef) {
-    return;
-  }
-  setTimeout(() => {
-    webViewRef.injectJavaScript(`
-      window.localStorage.setItem('homecallDeviceToken', '${token}');
-    `);
-  }, 100)
-
-}
-
 const injectHomecallAppData = async (deviceId: string, webViewRef?: WebViewRef) => {
   if (!webViewRef) {
     return;
@@ -87,20 +75,17 @@ export default function Call(props: {
       return;
     }
 
-    webViewRef.injectJavaScript(`
-      window.dispatchEvent(new CustomEvent('${type}', { detail: '${message}' }));
-    `);
+    // Tokens can only be used once, so every message comes with a new token for the webview to use
+    authContext.deviceToken().then((token) => {
+      webViewRef.injectJavaScript(`
+        window.localStorage.setItem('homecallDeviceToken', '${token}');
+        window.dispatchEvent(new CustomEvent('${type}', { detail: '${message}' }));
+      `);
+    });
   }, [lastMessage]);
 
 
-  // Inject token whenever
-  useEffect(() => {
-    injectHomecallDeviceToken(authContext.deviceToken, webViewRef);
-  }, [authContext, webViewRef]);
-
-
   const initialLoad = () => {
-    injectHomecallDeviceToken(authContext.deviceToken, webViewRef);
     injectHomecallAppData(authContext.deviceId, webViewRef);
     injectDebugging(webViewRef);
   }
@@ -6,8 +6,7 @@ import Enroll from "./Enroll";
 import Call from "./Call";
 import {deviceClient} from "../lib/api";
 import messaging from "@react-native-firebase/messaging";
-
-const authContextLifetime = 60 * 1000 // 60 seconds
+import {startHeartbeats} from "../lib/heartbeat";
 
 export default function HomeCall(props: {
 
@@ -22,10 +21,6 @@ export default function HomeCall(props: {
 
   const [authContext, setAuthContext] = useState<AuthContext | null>(null)
 
-  const renewAuthContext = async () => {
-    setAuthContext(await getAuthContext());
-  }
-
   useEffect(() => {
     if (!enrolled) {
       // No authContext before we are enrolled
@@ -33,15 +28,20 @@ export default function HomeCall(props: {
       return;
     }
 
-    // Immediately fetch an auth-context
-    // Fire and forget
-    renewAuthContext();
-
-    // Periodically renew the auth context
-    const interval = setInterval(renewAuthContext, authContextLifetime);
-    return () => clearInterval(interval);
+    // Fire and forget, tokens are minted per request so the context never needs renewing
+    (async () => {
+      setAuthContext(await getAuthContext());
+    })();
   }, [enrolled]);
 
+  // Keep the device online
+  useEffect(() => {
+    if (authContext === null) {
+      return;
+    }
+    return startHeartbeats(authContext);
+  }, [authContext]);
+
   let [fcmSetupDone, setFcmSetupDone] = useState<boolean>(false)
 
   // Setup FCM messaging
@@ -52,14 +52,13 @@ export default function HomeCall(props: {
     // Permission request is required on IOS
     messaging().requestPermission();
 
-    const submitFcmToken = (fcmToken: string) => {
-      deviceClient(authContext.instanceUrl).updateNotificationToken({ notificationToken: fcmToken }, {
+    const submitFcmToken = async (fcmToken: string) => {
+      await deviceClient(authContext.instanceUrl).updateNotificationToken({ notificationToken: fcmToken }, {
         headers: {
-          Authorization: `Bearer ${authContext.deviceToken}`,
+          Authorization: `Bearer ${await authContext.deviceToken()}`,
         }
-      }).then(() => {
-        setFcmSetupDone(true)
       })
+      setFcmSetupDone(true)
     }
 
     messaging()
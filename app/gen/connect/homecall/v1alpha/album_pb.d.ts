@@ -0,0 +1,150 @@
+// @generated by protoc-gen-es v1.8.0
+// @generated from file homecall/v1alpha/album.proto (package homecall.v1alpha, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage, Timestamp } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+
+/**
+ * Album is a set of photos that devices show as a slideshow while they are idle.
+ *
+ * @generated from message homecall.v1alpha.Album
+ */
+export declare class Album extends Message<Album> {
+  /**
+   * The ID of the album.
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * The ID of the tenant the album belongs to.
+   *
+   * @generated from field: string tenant_id = 2;
+   */
+  tenantId: string;
+
+  /**
+   * The name of the album.
+   *
+   * @generated from field: string name = 3;
+   */
+  name: string;
+
+  /**
+   * Where the album is shown.
+   *
+   * @generated from oneof homecall.v1alpha.Album.target
+   */
+  target: {
+    /**
+     * The ID of the device that shows the album.
+     *
+     * @generated from field: string device_id = 4;
+     */
+    value: string;
+    case: "deviceId";
+  } | {
+    /**
+     * The ID of the device group whose devices show the album.
+     *
+     * @generated from field: string group_id = 5;
+     */
+    value: string;
+    case: "groupId";
+  } | { case: undefined; value?: undefined };
+
+  /**
+   * The images of the album, oldest first.
+   *
+   * @generated from field: repeated homecall.v1alpha.AlbumImage images = 6;
+   */
+  images: AlbumImage[];
+
+  /**
+   * When the album was created.
+   *
+   * @generated from field: google.protobuf.Timestamp created_at = 7;
+   */
+  createdAt?: Timestamp;
+
+  constructor(data?: PartialMessage<Album>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.Album";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Album;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Album;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Album;
+
+  static equals(a: Album | PlainMessage<Album> | undefined, b: Album | PlainMessage<Album> | undefined): boolean;
+}
+
+/**
+ * AlbumImage is a photo in an album.
+ *
+ * @generated from message homecall.v1alpha.AlbumImage
+ */
+export declare class AlbumImage extends Message<AlbumImage> {
+  /**
+   * The ID of the image.
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * The content type of the image, image/jpeg, image/png, image/gif or image/webp.
+   *
+   * @generated from field: string content_type = 2;
+   */
+  contentType: string;
+
+  /**
+   * The size of the image in bytes.
+   *
+   * @generated from field: int64 size_bytes = 3;
+   */
+  sizeBytes: bigint;
+
+  /**
+   * The hex encoded SHA-256 of the image, changes whenever the content does.
+   *
+   * @generated from field: string etag = 4;
+   */
+  etag: string;
+
+  /**
+   * The ID of the tenant member that uploaded the image.
+   * Not set if the member has been removed from the tenant.
+   *
+   * @generated from field: string uploaded_by_member_id = 5;
+   */
+  uploadedByMemberId: string;
+
+  /**
+   * When the image was uploaded.
+   *
+   * @generated from field: google.protobuf.Timestamp created_at = 6;
+   */
+  createdAt?: Timestamp;
+
+  constructor(data?: PartialMessage<AlbumImage>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.AlbumImage";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AlbumImage;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AlbumImage;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AlbumImage;
+
+  static equals(a: AlbumImage | PlainMessage<AlbumImage> | undefined, b: AlbumImage | PlainMessage<AlbumImage> | undefined): boolean;
+}
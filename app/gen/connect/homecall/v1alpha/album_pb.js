@@ -0,0 +1,41 @@
+// @generated by protoc-gen-es v1.8.0
+// @generated from file homecall/v1alpha/album.proto (package homecall.v1alpha, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { proto3, Timestamp } from "@bufbuild/protobuf";
+
+/**
+ * Album is a set of photos that devices show as a slideshow while they are idle.
+ *
+ * @generated from message homecall.v1alpha.Album
+ */
+export const Album = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.Album",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "tenant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "device_id", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "target" },
+    { no: 5, name: "group_id", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "target" },
+    { no: 6, name: "images", kind: "message", T: AlbumImage, repeated: true },
+    { no: 7, name: "created_at", kind: "message", T: Timestamp },
+  ],
+);
+
+/**
+ * AlbumImage is a photo in an album.
+ *
+ * @generated from message homecall.v1alpha.AlbumImage
+ */
+export const AlbumImage = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.AlbumImage",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "content_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "size_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "etag", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "uploaded_by_member_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "created_at", kind: "message", T: Timestamp },
+  ],
+);
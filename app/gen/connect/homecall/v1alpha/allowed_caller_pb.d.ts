@@ -0,0 +1,144 @@
+// @generated by protoc-gen-es v1.8.0
+// @generated from file homecall/v1alpha/allowed_caller.proto (package homecall.v1alpha, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage, Timestamp } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+
+/**
+ * AllowedCallerAction is a change to who may call a device.
+ *
+ * @generated from enum homecall.v1alpha.AllowedCallerAction
+ */
+export declare enum AllowedCallerAction {
+  /**
+   * The action is unknown.
+   *
+   * @generated from enum value: ALLOWED_CALLER_ACTION_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Calls were restricted to the allowed callers and admins.
+   *
+   * @generated from enum value: ALLOWED_CALLER_ACTION_RESTRICTED = 1;
+   */
+  RESTRICTED = 1,
+
+  /**
+   * Every member with access to the device may call it again.
+   *
+   * @generated from enum value: ALLOWED_CALLER_ACTION_UNRESTRICTED = 2;
+   */
+  UNRESTRICTED = 2,
+
+  /**
+   * A member or group was allowed to call the device.
+   *
+   * @generated from enum value: ALLOWED_CALLER_ACTION_ADDED = 3;
+   */
+  ADDED = 3,
+
+  /**
+   * A member or group is no longer allowed to call the device.
+   *
+   * @generated from enum value: ALLOWED_CALLER_ACTION_REMOVED = 4;
+   */
+  REMOVED = 4,
+}
+
+/**
+ * AllowedCaller is a tenant member, or the members scoped to a device group, allowed to call a device.
+ *
+ * @generated from message homecall.v1alpha.AllowedCaller
+ */
+export declare class AllowedCaller extends Message<AllowedCaller> {
+  /**
+   * Exactly one of the fields must be set.
+   *
+   * @generated from oneof homecall.v1alpha.AllowedCaller.caller
+   */
+  caller: {
+    /**
+     * The ID of the tenant member.
+     *
+     * @generated from field: string member_id = 1;
+     */
+    value: string;
+    case: "memberId";
+  } | {
+    /**
+     * The ID of the device group, every member scoped to the group may call the device.
+     *
+     * @generated from field: string group_id = 2;
+     */
+    value: string;
+    case: "groupId";
+  } | { case: undefined; value?: undefined };
+
+  constructor(data?: PartialMessage<AllowedCaller>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.AllowedCaller";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AllowedCaller;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AllowedCaller;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AllowedCaller;
+
+  static equals(a: AllowedCaller | PlainMessage<AllowedCaller> | undefined, b: AllowedCaller | PlainMessage<AllowedCaller> | undefined): boolean;
+}
+
+/**
+ * AllowedCallerEvent records a change to who may call a device.
+ *
+ * @generated from message homecall.v1alpha.AllowedCallerEvent
+ */
+export declare class AllowedCallerEvent extends Message<AllowedCallerEvent> {
+  /**
+   * What was changed.
+   *
+   * @generated from field: homecall.v1alpha.AllowedCallerAction action = 1;
+   */
+  action: AllowedCallerAction;
+
+  /**
+   * The member or group that was added or removed.
+   * Not set when the device was restricted or unrestricted.
+   *
+   * @generated from field: homecall.v1alpha.AllowedCaller caller = 2;
+   */
+  caller?: AllowedCaller;
+
+  /**
+   * The ID of the tenant member that made the change.
+   * Not set if the member has been removed from the tenant.
+   *
+   * @generated from field: string changed_by_member_id = 3;
+   */
+  changedByMemberId: string;
+
+  /**
+   * When the change was made.
+   *
+   * @generated from field: google.protobuf.Timestamp created_at = 4;
+   */
+  createdAt?: Timestamp;
+
+  constructor(data?: PartialMessage<AllowedCallerEvent>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.AllowedCallerEvent";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AllowedCallerEvent;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AllowedCallerEvent;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AllowedCallerEvent;
+
+  static equals(a: AllowedCallerEvent | PlainMessage<AllowedCallerEvent> | undefined, b: AllowedCallerEvent | PlainMessage<AllowedCallerEvent> | undefined): boolean;
+}
@@ -0,0 +1,50 @@
+// @generated by protoc-gen-es v1.8.0
+// @generated from file homecall/v1alpha/allowed_caller.proto (package homecall.v1alpha, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { proto3, Timestamp } from "@bufbuild/protobuf";
+
+/**
+ * AllowedCallerAction is a change to who may call a device.
+ *
+ * @generated from enum homecall.v1alpha.AllowedCallerAction
+ */
+export const AllowedCallerAction = /*@__PURE__*/ proto3.makeEnum(
+  "homecall.v1alpha.AllowedCallerAction",
+  [
+    {no: 0, name: "ALLOWED_CALLER_ACTION_UNSPECIFIED", localName: "UNSPECIFIED"},
+    {no: 1, name: "ALLOWED_CALLER_ACTION_RESTRICTED", localName: "RESTRICTED"},
+    {no: 2, name: "ALLOWED_CALLER_ACTION_UNRESTRICTED", localName: "UNRESTRICTED"},
+    {no: 3, name: "ALLOWED_CALLER_ACTION_ADDED", localName: "ADDED"},
+    {no: 4, name: "ALLOWED_CALLER_ACTION_REMOVED", localName: "REMOVED"},
+  ],
+);
+
+/**
+ * AllowedCaller is a tenant member, or the members scoped to a device group, allowed to call a device.
+ *
+ * @generated from message homecall.v1alpha.AllowedCaller
+ */
+export const AllowedCaller = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.AllowedCaller",
+  () => [
+    { no: 1, name: "member_id", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "caller" },
+    { no: 2, name: "group_id", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "caller" },
+  ],
+);
+
+/**
+ * AllowedCallerEvent records a change to who may call a device.
+ *
+ * @generated from message homecall.v1alpha.AllowedCallerEvent
+ */
+export const AllowedCallerEvent = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.AllowedCallerEvent",
+  () => [
+    { no: 1, name: "action", kind: "enum", T: proto3.getEnumType(AllowedCallerAction) },
+    { no: 2, name: "caller", kind: "message", T: AllowedCaller },
+    { no: 3, name: "changed_by_member_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "created_at", kind: "message", T: Timestamp },
+  ],
+);
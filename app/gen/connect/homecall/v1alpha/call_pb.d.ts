@@ -0,0 +1,319 @@
+// @generated by protoc-gen-es v1.8.0
+// @generated from file homecall/v1alpha/call.proto (package homecall.v1alpha, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage, Timestamp } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+
+/**
+ * CallParticipantState is the join state of a participant.
+ *
+ * @generated from enum homecall.v1alpha.CallParticipantState
+ */
+export declare enum CallParticipantState {
+  /**
+   * The state is unknown.
+   *
+   * @generated from enum value: CALL_PARTICIPANT_STATE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The participant was invited but has not joined yet.
+   *
+   * @generated from enum value: CALL_PARTICIPANT_STATE_INVITED = 1;
+   */
+  INVITED = 1,
+
+  /**
+   * The participant is in the call.
+   *
+   * @generated from enum value: CALL_PARTICIPANT_STATE_JOINED = 2;
+   */
+  JOINED = 2,
+
+  /**
+   * The participant left the call, it may join again.
+   *
+   * @generated from enum value: CALL_PARTICIPANT_STATE_LEFT = 3;
+   */
+  LEFT = 3,
+
+  /**
+   * The participant declined the call without joining.
+   *
+   * @generated from enum value: CALL_PARTICIPANT_STATE_DECLINED = 4;
+   */
+  DECLINED = 4,
+}
+
+/**
+ * Call is a call between devices and office participants sharing one jitsi room.
+ *
+ * @generated from message homecall.v1alpha.Call
+ */
+export declare class Call extends Message<Call> {
+  /**
+   * The ID of the call.
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * The ID of the tenant the call belongs to.
+   *
+   * @generated from field: string tenant_id = 2;
+   */
+  tenantId: string;
+
+  /**
+   * The ID of the Jitsi room shared by all participants.
+   *
+   * @generated from field: string jitsi_room_id = 3;
+   */
+  jitsiRoomId: string;
+
+  /**
+   * When the call was started.
+   *
+   * @generated from field: google.protobuf.Timestamp created_at = 4;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * The participants of the call, devices first.
+   *
+   * @generated from field: repeated homecall.v1alpha.CallParticipant participants = 5;
+   */
+  participants: CallParticipant[];
+
+  /**
+   * The history of the participants, oldest first.
+   *
+   * @generated from field: repeated homecall.v1alpha.CallParticipantEvent events = 6;
+   */
+  events: CallParticipantEvent[];
+
+  constructor(data?: PartialMessage<Call>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.Call";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Call;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Call;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Call;
+
+  static equals(a: Call | PlainMessage<Call> | undefined, b: Call | PlainMessage<Call> | undefined): boolean;
+}
+
+/**
+ * CallParticipant is a device or tenant member taking part in a call.
+ *
+ * @generated from message homecall.v1alpha.CallParticipant
+ */
+export declare class CallParticipant extends Message<CallParticipant> {
+  /**
+   * The ID of the participant, unique across calls.
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * The ID of the device, set if the participant is a device.
+   *
+   * @generated from field: string device_id = 2;
+   */
+  deviceId: string;
+
+  /**
+   * The ID of the tenant member, set if the participant is an office user.
+   *
+   * @generated from field: string member_id = 3;
+   */
+  memberId: string;
+
+  /**
+   * The name shown to the other participants.
+   *
+   * @generated from field: string display_name = 4;
+   */
+  displayName: string;
+
+  /**
+   * The join state of the participant.
+   *
+   * @generated from field: homecall.v1alpha.CallParticipantState state = 5;
+   */
+  state: CallParticipantState;
+
+  /**
+   * When the participant was notified about the call.
+   * Not set if the participant started the call.
+   *
+   * @generated from field: google.protobuf.Timestamp notified_at = 6;
+   */
+  notifiedAt?: Timestamp;
+
+  /**
+   * When the participant first joined the call.
+   *
+   * @generated from field: google.protobuf.Timestamp joined_at = 7;
+   */
+  joinedAt?: Timestamp;
+
+  /**
+   * When the participant last left the call.
+   *
+   * @generated from field: google.protobuf.Timestamp left_at = 8;
+   */
+  leftAt?: Timestamp;
+
+  /**
+   * Whether the device was called during its quiet hours because the caller overrode them.
+   *
+   * @generated from field: bool quiet_hours_overridden = 9;
+   */
+  quietHoursOverridden: boolean;
+
+  /**
+   * Whether the device answers the call by itself, decided when the call was started.
+   *
+   * @generated from field: bool auto_answer = 10;
+   */
+  autoAnswer: boolean;
+
+  /**
+   * The number of seconds the device rings before answering by itself.
+   * Only set if auto_answer is set.
+   *
+   * @generated from field: int64 auto_answer_delay_seconds = 11;
+   */
+  autoAnswerDelaySeconds: bigint;
+
+  constructor(data?: PartialMessage<CallParticipant>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.CallParticipant";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CallParticipant;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CallParticipant;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CallParticipant;
+
+  static equals(a: CallParticipant | PlainMessage<CallParticipant> | undefined, b: CallParticipant | PlainMessage<CallParticipant> | undefined): boolean;
+}
+
+/**
+ * CallParticipantEvent records that a participant was invited to, joined, left or declined a call.
+ *
+ * @generated from message homecall.v1alpha.CallParticipantEvent
+ */
+export declare class CallParticipantEvent extends Message<CallParticipantEvent> {
+  /**
+   * The ID of the participant.
+   *
+   * @generated from field: string participant_id = 1;
+   */
+  participantId: string;
+
+  /**
+   * The state the participant changed to.
+   *
+   * @generated from field: homecall.v1alpha.CallParticipantState state = 2;
+   */
+  state: CallParticipantState;
+
+  /**
+   * The ID of the tenant member that invited the participant.
+   * Only set for invitations.
+   *
+   * @generated from field: string invited_by_member_id = 3;
+   */
+  invitedByMemberId: string;
+
+  /**
+   * When the event happened.
+   *
+   * @generated from field: google.protobuf.Timestamp created_at = 4;
+   */
+  createdAt?: Timestamp;
+
+  constructor(data?: PartialMessage<CallParticipantEvent>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.CallParticipantEvent";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CallParticipantEvent;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CallParticipantEvent;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CallParticipantEvent;
+
+  static equals(a: CallParticipantEvent | PlainMessage<CallParticipantEvent> | undefined, b: CallParticipantEvent | PlainMessage<CallParticipantEvent> | undefined): boolean;
+}
+
+/**
+ * UpdateCallStateRequest is the request to update the join state of a participant in a call.
+ *
+ * @generated from message homecall.v1alpha.UpdateCallStateRequest
+ */
+export declare class UpdateCallStateRequest extends Message<UpdateCallStateRequest> {
+  /**
+   * The ID of the call.
+   *
+   * @generated from field: string call_id = 1;
+   */
+  callId: string;
+
+  /**
+   * The new state, one of JOINED, LEFT and DECLINED.
+   * A participant can not decline a call after joining it.
+   *
+   * @generated from field: homecall.v1alpha.CallParticipantState state = 2;
+   */
+  state: CallParticipantState;
+
+  constructor(data?: PartialMessage<UpdateCallStateRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.UpdateCallStateRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateCallStateRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateCallStateRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateCallStateRequest;
+
+  static equals(a: UpdateCallStateRequest | PlainMessage<UpdateCallStateRequest> | undefined, b: UpdateCallStateRequest | PlainMessage<UpdateCallStateRequest> | undefined): boolean;
+}
+
+/**
+ * UpdateCallStateResponse is the response to updating the join state of a participant in a call.
+ *
+ * @generated from message homecall.v1alpha.UpdateCallStateResponse
+ */
+export declare class UpdateCallStateResponse extends Message<UpdateCallStateResponse> {
+  constructor(data?: PartialMessage<UpdateCallStateResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.UpdateCallStateResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateCallStateResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateCallStateResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateCallStateResponse;
+
+  static equals(a: UpdateCallStateResponse | PlainMessage<UpdateCallStateResponse> | undefined, b: UpdateCallStateResponse | PlainMessage<UpdateCallStateResponse> | undefined): boolean;
+}
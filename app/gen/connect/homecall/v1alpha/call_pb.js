@@ -0,0 +1,99 @@
+// @generated by protoc-gen-es v1.8.0
+// @generated from file homecall/v1alpha/call.proto (package homecall.v1alpha, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { proto3, Timestamp } from "@bufbuild/protobuf";
+
+/**
+ * CallParticipantState is the join state of a participant.
+ *
+ * @generated from enum homecall.v1alpha.CallParticipantState
+ */
+export const CallParticipantState = /*@__PURE__*/ proto3.makeEnum(
+  "homecall.v1alpha.CallParticipantState",
+  [
+    {no: 0, name: "CALL_PARTICIPANT_STATE_UNSPECIFIED", localName: "UNSPECIFIED"},
+    {no: 1, name: "CALL_PARTICIPANT_STATE_INVITED", localName: "INVITED"},
+    {no: 2, name: "CALL_PARTICIPANT_STATE_JOINED", localName: "JOINED"},
+    {no: 3, name: "CALL_PARTICIPANT_STATE_LEFT", localName: "LEFT"},
+    {no: 4, name: "CALL_PARTICIPANT_STATE_DECLINED", localName: "DECLINED"},
+  ],
+);
+
+/**
+ * Call is a call between devices and office participants sharing one jitsi room.
+ *
+ * @generated from message homecall.v1alpha.Call
+ */
+export const Call = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.Call",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "tenant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "jitsi_room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "created_at", kind: "message", T: Timestamp },
+    { no: 5, name: "participants", kind: "message", T: CallParticipant, repeated: true },
+    { no: 6, name: "events", kind: "message", T: CallParticipantEvent, repeated: true },
+  ],
+);
+
+/**
+ * CallParticipant is a device or tenant member taking part in a call.
+ *
+ * @generated from message homecall.v1alpha.CallParticipant
+ */
+export const CallParticipant = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.CallParticipant",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "device_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "member_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "display_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "state", kind: "enum", T: proto3.getEnumType(CallParticipantState) },
+    { no: 6, name: "notified_at", kind: "message", T: Timestamp },
+    { no: 7, name: "joined_at", kind: "message", T: Timestamp },
+    { no: 8, name: "left_at", kind: "message", T: Timestamp },
+    { no: 9, name: "quiet_hours_overridden", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 10, name: "auto_answer", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 11, name: "auto_answer_delay_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ],
+);
+
+/**
+ * CallParticipantEvent records that a participant was invited to, joined, left or declined a call.
+ *
+ * @generated from message homecall.v1alpha.CallParticipantEvent
+ */
+export const CallParticipantEvent = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.CallParticipantEvent",
+  () => [
+    { no: 1, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "state", kind: "enum", T: proto3.getEnumType(CallParticipantState) },
+    { no: 3, name: "invited_by_member_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "created_at", kind: "message", T: Timestamp },
+  ],
+);
+
+/**
+ * UpdateCallStateRequest is the request to update the join state of a participant in a call.
+ *
+ * @generated from message homecall.v1alpha.UpdateCallStateRequest
+ */
+export const UpdateCallStateRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.UpdateCallStateRequest",
+  () => [
+    { no: 1, name: "call_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "state", kind: "enum", T: proto3.getEnumType(CallParticipantState) },
+  ],
+);
+
+/**
+ * UpdateCallStateResponse is the response to updating the join state of a participant in a call.
+ *
+ * @generated from message homecall.v1alpha.UpdateCallStateResponse
+ */
+export const UpdateCallStateResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.UpdateCallStateResponse",
+  [],
+);
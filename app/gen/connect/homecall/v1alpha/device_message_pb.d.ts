@@ -0,0 +1,129 @@
+// @generated by protoc-gen-es v1.8.0
+// @generated from file homecall/v1alpha/device_message.proto (package homecall.v1alpha, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage, Timestamp } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+
+/**
+ * DeviceMessage is a text or photo message sent to a device.
+ *
+ * @generated from message homecall.v1alpha.DeviceMessage
+ */
+export declare class DeviceMessage extends Message<DeviceMessage> {
+  /**
+   * The ID of the message.
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * The ID of the device the message was sent to.
+   *
+   * @generated from field: string device_id = 2;
+   */
+  deviceId: string;
+
+  /**
+   * The ID of the tenant member that sent the message.
+   * Not set if the member has been removed from the tenant.
+   *
+   * @generated from field: string sender_member_id = 3;
+   */
+  senderMemberId: string;
+
+  /**
+   * The name of the sender when the message was sent.
+   *
+   * @generated from field: string sender_display_name = 4;
+   */
+  senderDisplayName: string;
+
+  /**
+   * The content of the message.
+   *
+   * @generated from oneof homecall.v1alpha.DeviceMessage.content
+   */
+  content: {
+    /**
+     * A text message.
+     *
+     * @generated from field: string text = 5;
+     */
+    value: string;
+    case: "text";
+  } | {
+    /**
+     * A photo.
+     *
+     * @generated from field: homecall.v1alpha.MessageImage image = 6;
+     */
+    value: MessageImage;
+    case: "image";
+  } | { case: undefined; value?: undefined };
+
+  /**
+   * When the message was sent.
+   *
+   * @generated from field: google.protobuf.Timestamp created_at = 7;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * When the device acknowledged the message, not set while it is unread.
+   *
+   * @generated from field: google.protobuf.Timestamp read_at = 8;
+   */
+  readAt?: Timestamp;
+
+  constructor(data?: PartialMessage<DeviceMessage>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.DeviceMessage";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeviceMessage;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeviceMessage;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeviceMessage;
+
+  static equals(a: DeviceMessage | PlainMessage<DeviceMessage> | undefined, b: DeviceMessage | PlainMessage<DeviceMessage> | undefined): boolean;
+}
+
+/**
+ * MessageImage is the photo of a message, its content is downloaded separately.
+ *
+ * @generated from message homecall.v1alpha.MessageImage
+ */
+export declare class MessageImage extends Message<MessageImage> {
+  /**
+   * The content type of the image, image/jpeg, image/png, image/gif or image/webp.
+   *
+   * @generated from field: string content_type = 1;
+   */
+  contentType: string;
+
+  /**
+   * The size of the image in bytes.
+   *
+   * @generated from field: int64 size_bytes = 3;
+   */
+  sizeBytes: bigint;
+
+  constructor(data?: PartialMessage<MessageImage>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.MessageImage";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MessageImage;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MessageImage;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MessageImage;
+
+  static equals(a: MessageImage | PlainMessage<MessageImage> | undefined, b: MessageImage | PlainMessage<MessageImage> | undefined): boolean;
+}
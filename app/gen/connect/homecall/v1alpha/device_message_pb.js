@@ -0,0 +1,38 @@
+// @generated by protoc-gen-es v1.8.0
+// @generated from file homecall/v1alpha/device_message.proto (package homecall.v1alpha, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { proto3, Timestamp } from "@bufbuild/protobuf";
+
+/**
+ * DeviceMessage is a text or photo message sent to a device.
+ *
+ * @generated from message homecall.v1alpha.DeviceMessage
+ */
+export const DeviceMessage = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.DeviceMessage",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "device_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "sender_member_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "sender_display_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "text", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "content" },
+    { no: 6, name: "image", kind: "message", T: MessageImage, oneof: "content" },
+    { no: 7, name: "created_at", kind: "message", T: Timestamp },
+    { no: 8, name: "read_at", kind: "message", T: Timestamp },
+  ],
+);
+
+/**
+ * MessageImage is the photo of a message, its content is downloaded separately.
+ *
+ * @generated from message homecall.v1alpha.MessageImage
+ */
+export const MessageImage = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.MessageImage",
+  () => [
+    { no: 1, name: "content_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "size_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ],
+);
@@ -3,8 +3,9 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { EnrollRequest, EnrollResponse, GetCallDetailsRequest, GetCallDetailsResponse, UpdateNotificationTokenRequest, UpdateNotificationTokenResponse } from "./device_service_pb.js";
+import { AckMessageRequest, AckMessageResponse, DismissMissedCallRequest, DismissMissedCallResponse, EnrollRequest, EnrollResponse, GetAlbumImageRequest, GetAlbumImageResponse, GetCallDetailsRequest, GetCallDetailsResponse, GetMessageImageRequest, GetMessageImageResponse, HeartbeatRequest, HeartbeatResponse, ListMessagesRequest, ListMessagesResponse, ListMissedCallsRequest, ListMissedCallsResponse, ReportDiagnosticsRequest, ReportDiagnosticsResponse, RequestCallbackRequest, RequestCallbackResponse, RotateKeyRequest, RotateKeyResponse, SyncContentRequest, SyncContentResponse, UpdateNotificationTokenRequest, UpdateNotificationTokenResponse, UploadLogsRequest, UploadLogsResponse } from "./device_service_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
+import { UpdateCallStateRequest, UpdateCallStateResponse } from "./call_pb.js";
 
 /**
  * DeviceService is the service that devices talk to in order to enroll and receive calls.
@@ -29,8 +30,7 @@ export declare const DeviceService: {
     /**
      * UpdateNotificationToken is called by a device to update the FCM token.
      * This token is used to send push notifications to the device.
-     * This should be called whenever the FCM token changes.
-     * It should also be called when the device is first enrolled and every 30 minutes to mark the device as active.
+     * This should be called whenever the FCM token changes and when the device is first enrolled.
      *
      * Call is authenticated using the a jwt token signed with the device's private key.
      * The subject of the jwt token must be the device ID.
@@ -58,5 +58,201 @@ export declare const DeviceService: {
       readonly O: typeof GetCallDetailsResponse,
       readonly kind: MethodKind.Unary,
     },
+    /**
+     * Heartbeat is called by a device to report that it is alive and in what state it is.
+     * The device is considered online as long as it keeps sending heartbeats
+     * at the interval returned in the response.
+     *
+     * Call is authenticated using the a jwt token signed with the device's private key.
+     * The subject of the jwt token must be the device ID.
+     *
+     * @generated from rpc homecall.v1alpha.DeviceService.Heartbeat
+     */
+    readonly heartbeat: {
+      readonly name: "Heartbeat",
+      readonly I: typeof HeartbeatRequest,
+      readonly O: typeof HeartbeatResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * ReportDiagnostics is called by a device to report information used to troubleshoot it.
+     * It should be called on startup, whenever a permission changes and after errors.
+     * Reports are kept for a limited time.
+     *
+     * Call is authenticated using the a jwt token signed with the device's private key.
+     * The subject of the jwt token must be the device ID.
+     *
+     * @generated from rpc homecall.v1alpha.DeviceService.ReportDiagnostics
+     */
+    readonly reportDiagnostics: {
+      readonly name: "ReportDiagnostics",
+      readonly I: typeof ReportDiagnosticsRequest,
+      readonly O: typeof ReportDiagnosticsResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * UploadLogs is called by a device to upload its logs after it was asked to
+     * by an "uploadLogs" push notification.
+     * The first message must contain the metadata, all following messages contain chunks of the log file.
+     * The total size of the chunks is limited by the service.
+     *
+     * Call is authenticated using the a jwt token signed with the device's private key.
+     * The subject of the jwt token must be the device ID.
+     *
+     * @generated from rpc homecall.v1alpha.DeviceService.UploadLogs
+     */
+    readonly uploadLogs: {
+      readonly name: "UploadLogs",
+      readonly I: typeof UploadLogsRequest,
+      readonly O: typeof UploadLogsResponse,
+      readonly kind: MethodKind.ClientStreaming,
+    },
+    /**
+     * RotateKey is called by a device to replace its key pair.
+     * The call is authenticated with the current key, after it succeeds only the new key is accepted.
+     *
+     * Call is authenticated using the a jwt token signed with the device's private key.
+     * The subject of the jwt token must be the device ID.
+     *
+     * @generated from rpc homecall.v1alpha.DeviceService.RotateKey
+     */
+    readonly rotateKey: {
+      readonly name: "RotateKey",
+      readonly I: typeof RotateKeyRequest,
+      readonly O: typeof RotateKeyResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * UpdateCallState is called by a device when it joins, leaves or declines a call.
+     *
+     * Call is authenticated using the a jwt token signed with the device's private key.
+     * The subject of the jwt token must be the device ID.
+     *
+     * @generated from rpc homecall.v1alpha.DeviceService.UpdateCallState
+     */
+    readonly updateCallState: {
+      readonly name: "UpdateCallState",
+      readonly I: typeof UpdateCallStateRequest,
+      readonly O: typeof UpdateCallStateResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * ListMissedCalls returns the missed calls that have not been dismissed, newest first.
+     * A device shows these as a badge until they are dismissed.
+     *
+     * Call is authenticated using the a jwt token signed with the device's private key.
+     * The subject of the jwt token must be the device ID.
+     *
+     * @generated from rpc homecall.v1alpha.DeviceService.ListMissedCalls
+     */
+    readonly listMissedCalls: {
+      readonly name: "ListMissedCalls",
+      readonly I: typeof ListMissedCallsRequest,
+      readonly O: typeof ListMissedCallsResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * DismissMissedCall removes a missed call from the badge of the device.
+     *
+     * Call is authenticated using the a jwt token signed with the device's private key.
+     * The subject of the jwt token must be the device ID.
+     *
+     * @generated from rpc homecall.v1alpha.DeviceService.DismissMissedCall
+     */
+    readonly dismissMissedCall: {
+      readonly name: "DismissMissedCall",
+      readonly I: typeof DismissMissedCallRequest,
+      readonly O: typeof DismissMissedCallResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * RequestCallback asks the members of the tenant to call the device back.
+     * A device has at most one open request, requesting a callback again returns the open request.
+     * The missed call the request is made from, if any, is dismissed.
+     *
+     * Call is authenticated using the a jwt token signed with the device's private key.
+     * The subject of the jwt token must be the device ID.
+     *
+     * @generated from rpc homecall.v1alpha.DeviceService.RequestCallback
+     */
+    readonly requestCallback: {
+      readonly name: "RequestCallback",
+      readonly I: typeof RequestCallbackRequest,
+      readonly O: typeof RequestCallbackResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * ListMessages returns the messages sent to the device, newest first.
+     * The device is notified when a message is sent to it.
+     *
+     * Call is authenticated using the a jwt token signed with the device's private key.
+     * The subject of the jwt token must be the device ID.
+     *
+     * @generated from rpc homecall.v1alpha.DeviceService.ListMessages
+     */
+    readonly listMessages: {
+      readonly name: "ListMessages",
+      readonly I: typeof ListMessagesRequest,
+      readonly O: typeof ListMessagesResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * AckMessage marks a message as read, which is shown to the members of the tenant.
+     *
+     * Call is authenticated using the a jwt token signed with the device's private key.
+     * The subject of the jwt token must be the device ID.
+     *
+     * @generated from rpc homecall.v1alpha.DeviceService.AckMessage
+     */
+    readonly ackMessage: {
+      readonly name: "AckMessage",
+      readonly I: typeof AckMessageRequest,
+      readonly O: typeof AckMessageResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * GetMessageImage returns the photo of a message sent to the device.
+     *
+     * Call is authenticated using the a jwt token signed with the device's private key.
+     * The subject of the jwt token must be the device ID.
+     *
+     * @generated from rpc homecall.v1alpha.DeviceService.GetMessageImage
+     */
+    readonly getMessageImage: {
+      readonly name: "GetMessageImage",
+      readonly I: typeof GetMessageImageRequest,
+      readonly O: typeof GetMessageImageResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * SyncContent returns the manifest of the albums the device shows while it is idle.
+     * The manifest has an ETag, if the device already has the current manifest only not_modified is set.
+     * Images are downloaded with GetAlbumImage when their ETag changes.
+     *
+     * Call is authenticated using the a jwt token signed with the device's private key.
+     * The subject of the jwt token must be the device ID.
+     *
+     * @generated from rpc homecall.v1alpha.DeviceService.SyncContent
+     */
+    readonly syncContent: {
+      readonly name: "SyncContent",
+      readonly I: typeof SyncContentRequest,
+      readonly O: typeof SyncContentResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * GetAlbumImage returns an image of an album the device shows.
+     *
+     * Call is authenticated using the a jwt token signed with the device's private key.
+     * The subject of the jwt token must be the device ID.
+     *
+     * @generated from rpc homecall.v1alpha.DeviceService.GetAlbumImage
+     */
+    readonly getAlbumImage: {
+      readonly name: "GetAlbumImage",
+      readonly I: typeof GetAlbumImageRequest,
+      readonly O: typeof GetAlbumImageResponse,
+      readonly kind: MethodKind.Unary,
+    },
   }
 };
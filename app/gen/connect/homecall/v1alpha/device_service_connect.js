@@ -3,8 +3,9 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { EnrollRequest, EnrollResponse, GetCallDetailsRequest, GetCallDetailsResponse, UpdateNotificationTokenRequest, UpdateNotificationTokenResponse } from "./device_service_pb.js";
+import { AckMessageRequest, AckMessageResponse, DismissMissedCallRequest, DismissMissedCallResponse, EnrollRequest, EnrollResponse, GetAlbumImageRequest, GetAlbumImageResponse, GetCallDetailsRequest, GetCallDetailsResponse, GetMessageImageRequest, GetMessageImageResponse, HeartbeatRequest, HeartbeatResponse, ListMessagesRequest, ListMessagesResponse, ListMissedCallsRequest, ListMissedCallsResponse, ReportDiagnosticsRequest, ReportDiagnosticsResponse, RequestCallbackRequest, RequestCallbackResponse, RotateKeyRequest, RotateKeyResponse, SyncContentRequest, SyncContentResponse, UpdateNotificationTokenRequest, UpdateNotificationTokenResponse, UploadLogsRequest, UploadLogsResponse } from "./device_service_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
+import { UpdateCallStateRequest, UpdateCallStateResponse } from "./call_pb.js";
 
 /**
  * DeviceService is the service that devices talk to in order to enroll and receive calls.
@@ -29,8 +30,7 @@ export const DeviceService = {
     /**
      * UpdateNotificationToken is called by a device to update the FCM token.
      * This token is used to send push notifications to the device.
-     * This should be called whenever the FCM token changes.
-     * It should also be called when the device is first enrolled and every 30 minutes to mark the device as active.
+     * This should be called whenever the FCM token changes and when the device is first enrolled.
      *
      * Call is authenticated using the a jwt token signed with the device's private key.
      * The subject of the jwt token must be the device ID.
@@ -58,5 +58,201 @@ export const DeviceService = {
       O: GetCallDetailsResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Heartbeat is called by a device to report that it is alive and in what state it is.
+     * The device is considered online as long as it keeps sending heartbeats
+     * at the interval returned in the response.
+     *
+     * Call is authenticated using the a jwt token signed with the device's private key.
+     * The subject of the jwt token must be the device ID.
+     *
+     * @generated from rpc homecall.v1alpha.DeviceService.Heartbeat
+     */
+    heartbeat: {
+      name: "Heartbeat",
+      I: HeartbeatRequest,
+      O: HeartbeatResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ReportDiagnostics is called by a device to report information used to troubleshoot it.
+     * It should be called on startup, whenever a permission changes and after errors.
+     * Reports are kept for a limited time.
+     *
+     * Call is authenticated using the a jwt token signed with the device's private key.
+     * The subject of the jwt token must be the device ID.
+     *
+     * @generated from rpc homecall.v1alpha.DeviceService.ReportDiagnostics
+     */
+    reportDiagnostics: {
+      name: "ReportDiagnostics",
+      I: ReportDiagnosticsRequest,
+      O: ReportDiagnosticsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * UploadLogs is called by a device to upload its logs after it was asked to
+     * by an "uploadLogs" push notification.
+     * The first message must contain the metadata, all following messages contain chunks of the log file.
+     * The total size of the chunks is limited by the service.
+     *
+     * Call is authenticated using the a jwt token signed with the device's private key.
+     * The subject of the jwt token must be the device ID.
+     *
+     * @generated from rpc homecall.v1alpha.DeviceService.UploadLogs
+     */
+    uploadLogs: {
+      name: "UploadLogs",
+      I: UploadLogsRequest,
+      O: UploadLogsResponse,
+      kind: MethodKind.ClientStreaming,
+    },
+    /**
+     * RotateKey is called by a device to replace its key pair.
+     * The call is authenticated with the current key, after it succeeds only the new key is accepted.
+     *
+     * Call is authenticated using the a jwt token signed with the device's private key.
+     * The subject of the jwt token must be the device ID.
+     *
+     * @generated from rpc homecall.v1alpha.DeviceService.RotateKey
+     */
+    rotateKey: {
+      name: "RotateKey",
+      I: RotateKeyRequest,
+      O: RotateKeyResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * UpdateCallState is called by a device when it joins, leaves or declines a call.
+     *
+     * Call is authenticated using the a jwt token signed with the device's private key.
+     * The subject of the jwt token must be the device ID.
+     *
+     * @generated from rpc homecall.v1alpha.DeviceService.UpdateCallState
+     */
+    updateCallState: {
+      name: "UpdateCallState",
+      I: UpdateCallStateRequest,
+      O: UpdateCallStateResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListMissedCalls returns the missed calls that have not been dismissed, newest first.
+     * A device shows these as a badge until they are dismissed.
+     *
+     * Call is authenticated using the a jwt token signed with the device's private key.
+     * The subject of the jwt token must be the device ID.
+     *
+     * @generated from rpc homecall.v1alpha.DeviceService.ListMissedCalls
+     */
+    listMissedCalls: {
+      name: "ListMissedCalls",
+      I: ListMissedCallsRequest,
+      O: ListMissedCallsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * DismissMissedCall removes a missed call from the badge of the device.
+     *
+     * Call is authenticated using the a jwt token signed with the device's private key.
+     * The subject of the jwt token must be the device ID.
+     *
+     * @generated from rpc homecall.v1alpha.DeviceService.DismissMissedCall
+     */
+    dismissMissedCall: {
+      name: "DismissMissedCall",
+      I: DismissMissedCallRequest,
+      O: DismissMissedCallResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * RequestCallback asks the members of the tenant to call the device back.
+     * A device has at most one open request, requesting a callback again returns the open request.
+     * The missed call the request is made from, if any, is dismissed.
+     *
+     * Call is authenticated using the a jwt token signed with the device's private key.
+     * The subject of the jwt token must be the device ID.
+     *
+     * @generated from rpc homecall.v1alpha.DeviceService.RequestCallback
+     */
+    requestCallback: {
+      name: "RequestCallback",
+      I: RequestCallbackRequest,
+      O: RequestCallbackResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListMessages returns the messages sent to the device, newest first.
+     * The device is notified when a message is sent to it.
+     *
+     * Call is authenticated using the a jwt token signed with the device's private key.
+     * The subject of the jwt token must be the device ID.
+     *
+     * @generated from rpc homecall.v1alpha.DeviceService.ListMessages
+     */
+    listMessages: {
+      name: "ListMessages",
+      I: ListMessagesRequest,
+      O: ListMessagesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * AckMessage marks a message as read, which is shown to the members of the tenant.
+     *
+     * Call is authenticated using the a jwt token signed with the device's private key.
+     * The subject of the jwt token must be the device ID.
+     *
+     * @generated from rpc homecall.v1alpha.DeviceService.AckMessage
+     */
+    ackMessage: {
+      name: "AckMessage",
+      I: AckMessageRequest,
+      O: AckMessageResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetMessageImage returns the photo of a message sent to the device.
+     *
+     * Call is authenticated using the a jwt token signed with the device's private key.
+     * The subject of the jwt token must be the device ID.
+     *
+     * @generated from rpc homecall.v1alpha.DeviceService.GetMessageImage
+     */
+    getMessageImage: {
+      name: "GetMessageImage",
+      I: GetMessageImageRequest,
+      O: GetMessageImageResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * SyncContent returns the manifest of the albums the device shows while it is idle.
+     * The manifest has an ETag, if the device already has the current manifest only not_modified is set.
+     * Images are downloaded with GetAlbumImage when their ETag changes.
+     *
+     * Call is authenticated using the a jwt token signed with the device's private key.
+     * The subject of the jwt token must be the device ID.
+     *
+     * @generated from rpc homecall.v1alpha.DeviceService.SyncContent
+     */
+    syncContent: {
+      name: "SyncContent",
+      I: SyncContentRequest,
+      O: SyncContentResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetAlbumImage returns an image of an album the device shows.
+     *
+     * Call is authenticated using the a jwt token signed with the device's private key.
+     * The subject of the jwt token must be the device ID.
+     *
+     * @generated from rpc homecall.v1alpha.DeviceService.GetAlbumImage
+     */
+    getAlbumImage: {
+      name: "GetAlbumImage",
+      I: GetAlbumImageRequest,
+      O: GetAlbumImageResponse,
+      kind: MethodKind.Unary,
+    },
   }
 };
@@ -5,10 +5,16 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
-import type { DeviceSettings } from "./settings_pb.js";
+import type { DeviceSettings, QuietHours } from "./settings_pb.js";
+import type { DeviceState } from "./device_state_pb.js";
+import type { DeviceDiagnostics } from "./diagnostics_pb.js";
+import type { CallbackRequest, MissedCall } from "./missed_call_pb.js";
+import type { DeviceMessage, MessageImage } from "./device_message_pb.js";
+import type { Album, AlbumImage } from "./album_pb.js";
 
 /**
  * EnrollRequest is the request to enroll a device.
+ * Exactly one of enrollment_key, pairing_code and qr_payload must be set.
  *
  * @generated from message homecall.v1alpha.EnrollRequest
  */
@@ -24,12 +30,38 @@ export declare class EnrollRequest extends Message<EnrollRequest> {
   /**
    * The public key is the public key of the device that is used to encrypt the call information.
    * This key is generated by the device and shared with the service during enrollment.
-   * The public key is a PEM encoded RSA public key.
+   * The public key is a PEM encoded RSA (at least 2048 bits), ECDSA (P-256 or P-384) or Ed25519 public key.
+   * RSA keys may be PKCS #1 or PKIX encoded, other keys must be PKIX encoded.
+   * Tokens must be signed with RS256/RS384/RS512, ES256, ES384 or EdDSA respectively.
    *
    * @generated from field: string public_key = 2;
    */
   publicKey: string;
 
+  /**
+   * The pairing code is a short numeric code that can be typed instead of the enrollment key.
+   * Pairing codes expire quickly and failed attempts are rate limited.
+   *
+   * @generated from field: string pairing_code = 3;
+   */
+  pairingCode: string;
+
+  /**
+   * The QR payload is the content of the enrollment QR code shown in the office app.
+   * It is a signed token containing the URL of the instance and the enrollment key.
+   *
+   * @generated from field: string qr_payload = 4;
+   */
+  qrPayload: string;
+
+  /**
+   * The attestation proves that the genuine app on a genuine device is enrolling.
+   * It is optional unless the tenant requires attestation.
+   *
+   * @generated from field: homecall.v1alpha.DeviceAttestation attestation = 5;
+   */
+  attestation?: DeviceAttestation;
+
   constructor(data?: PartialMessage<EnrollRequest>);
 
   static readonly runtime: typeof proto3;
@@ -45,6 +77,87 @@ export declare class EnrollRequest extends Message<EnrollRequest> {
   static equals(a: EnrollRequest | PlainMessage<EnrollRequest> | undefined, b: EnrollRequest | PlainMessage<EnrollRequest> | undefined): boolean;
 }
 
+/**
+ * DeviceAttestation is a statement from the platform that vouches for the app and device that enrolls.
+ * The statement must be bound to the public key of the EnrollRequest,
+ * the nonce or client data hash is the SHA-256 hash of the public_key field.
+ *
+ * @generated from message homecall.v1alpha.DeviceAttestation
+ */
+export declare class DeviceAttestation extends Message<DeviceAttestation> {
+  /**
+   * @generated from oneof homecall.v1alpha.DeviceAttestation.statement
+   */
+  statement: {
+    /**
+     * A Play Integrity verdict, decrypted and re-encoded as a JWS
+     * that carries its signing certificate chain in the x5c header.
+     * The nonce is the base64url encoded hash.
+     *
+     * @generated from field: string play_integrity_token = 1;
+     */
+    value: string;
+    case: "playIntegrityToken";
+  } | {
+    /**
+     * An App Attest attestation.
+     *
+     * @generated from field: homecall.v1alpha.AppAttestStatement app_attest = 2;
+     */
+    value: AppAttestStatement;
+    case: "appAttest";
+  } | { case: undefined; value?: undefined };
+
+  constructor(data?: PartialMessage<DeviceAttestation>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.DeviceAttestation";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeviceAttestation;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeviceAttestation;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeviceAttestation;
+
+  static equals(a: DeviceAttestation | PlainMessage<DeviceAttestation> | undefined, b: DeviceAttestation | PlainMessage<DeviceAttestation> | undefined): boolean;
+}
+
+/**
+ * AppAttestStatement is the result of attesting an App Attest key.
+ *
+ * @generated from message homecall.v1alpha.AppAttestStatement
+ */
+export declare class AppAttestStatement extends Message<AppAttestStatement> {
+  /**
+   * The CBOR encoded attestation object returned by attestKey.
+   *
+   * @generated from field: bytes attestation_object = 1;
+   */
+  attestationObject: Uint8Array;
+
+  /**
+   * The id of the attested key.
+   *
+   * @generated from field: bytes key_id = 2;
+   */
+  keyId: Uint8Array;
+
+  constructor(data?: PartialMessage<AppAttestStatement>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.AppAttestStatement";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppAttestStatement;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppAttestStatement;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppAttestStatement;
+
+  static equals(a: AppAttestStatement | PlainMessage<AppAttestStatement> | undefined, b: AppAttestStatement | PlainMessage<AppAttestStatement> | undefined): boolean;
+}
+
 /**
  * EnrollResponse is the response to enrolling a device.
  *
@@ -145,6 +258,21 @@ export declare class GetCallDetailsResponse extends Message<GetCallDetailsRespon
    */
   callId: string;
 
+  /**
+   * Whether the device should answer the call by itself, decided by the server when the call was started.
+   *
+   * @generated from field: bool auto_answer = 4;
+   */
+  autoAnswer: boolean;
+
+  /**
+   * The number of seconds the device should ring before answering by itself.
+   * Only set if auto_answer is set.
+   *
+   * @generated from field: int64 auto_answer_delay_seconds = 5;
+   */
+  autoAnswerDelaySeconds: bigint;
+
   constructor(data?: PartialMessage<GetCallDetailsResponse>);
 
   static readonly runtime: typeof proto3;
@@ -208,3 +336,723 @@ export declare class UpdateNotificationTokenResponse extends Message<UpdateNotif
 
   static equals(a: UpdateNotificationTokenResponse | PlainMessage<UpdateNotificationTokenResponse> | undefined, b: UpdateNotificationTokenResponse | PlainMessage<UpdateNotificationTokenResponse> | undefined): boolean;
 }
+
+/**
+ * HeartbeatRequest is the request to report the state of a device.
+ *
+ * @generated from message homecall.v1alpha.HeartbeatRequest
+ */
+export declare class HeartbeatRequest extends Message<HeartbeatRequest> {
+  /**
+   * The current state of the device.
+   *
+   * @generated from field: homecall.v1alpha.DeviceState state = 1;
+   */
+  state?: DeviceState;
+
+  constructor(data?: PartialMessage<HeartbeatRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.HeartbeatRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): HeartbeatRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): HeartbeatRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): HeartbeatRequest;
+
+  static equals(a: HeartbeatRequest | PlainMessage<HeartbeatRequest> | undefined, b: HeartbeatRequest | PlainMessage<HeartbeatRequest> | undefined): boolean;
+}
+
+/**
+ * HeartbeatResponse is the response to reporting the state of a device.
+ *
+ * @generated from message homecall.v1alpha.HeartbeatResponse
+ */
+export declare class HeartbeatResponse extends Message<HeartbeatResponse> {
+  /**
+   * The number of seconds until the device should send its next heartbeat
+   * in order to still be considered online.
+   *
+   * @generated from field: int64 next_heartbeat_seconds = 1;
+   */
+  nextHeartbeatSeconds: bigint;
+
+  /**
+   * The current quiet hours of the device, not set if it has none.
+   * Quiet hours can change after enrolling, so they are returned with every heartbeat.
+   *
+   * @generated from field: homecall.v1alpha.QuietHours quiet_hours = 2;
+   */
+  quietHours?: QuietHours;
+
+  constructor(data?: PartialMessage<HeartbeatResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.HeartbeatResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): HeartbeatResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): HeartbeatResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): HeartbeatResponse;
+
+  static equals(a: HeartbeatResponse | PlainMessage<HeartbeatResponse> | undefined, b: HeartbeatResponse | PlainMessage<HeartbeatResponse> | undefined): boolean;
+}
+
+/**
+ * ReportDiagnosticsRequest is the request to report diagnostics.
+ *
+ * @generated from message homecall.v1alpha.ReportDiagnosticsRequest
+ */
+export declare class ReportDiagnosticsRequest extends Message<ReportDiagnosticsRequest> {
+  /**
+   * The diagnostics of the device.
+   *
+   * @generated from field: homecall.v1alpha.DeviceDiagnostics diagnostics = 1;
+   */
+  diagnostics?: DeviceDiagnostics;
+
+  constructor(data?: PartialMessage<ReportDiagnosticsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.ReportDiagnosticsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReportDiagnosticsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReportDiagnosticsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReportDiagnosticsRequest;
+
+  static equals(a: ReportDiagnosticsRequest | PlainMessage<ReportDiagnosticsRequest> | undefined, b: ReportDiagnosticsRequest | PlainMessage<ReportDiagnosticsRequest> | undefined): boolean;
+}
+
+/**
+ * ReportDiagnosticsResponse is the response to reporting diagnostics.
+ *
+ * @generated from message homecall.v1alpha.ReportDiagnosticsResponse
+ */
+export declare class ReportDiagnosticsResponse extends Message<ReportDiagnosticsResponse> {
+  constructor(data?: PartialMessage<ReportDiagnosticsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.ReportDiagnosticsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReportDiagnosticsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReportDiagnosticsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReportDiagnosticsResponse;
+
+  static equals(a: ReportDiagnosticsResponse | PlainMessage<ReportDiagnosticsResponse> | undefined, b: ReportDiagnosticsResponse | PlainMessage<ReportDiagnosticsResponse> | undefined): boolean;
+}
+
+/**
+ * UploadLogsRequest is a message in the stream of uploading logs.
+ *
+ * @generated from message homecall.v1alpha.UploadLogsRequest
+ */
+export declare class UploadLogsRequest extends Message<UploadLogsRequest> {
+  /**
+   * @generated from oneof homecall.v1alpha.UploadLogsRequest.data
+   */
+  data: {
+    /**
+     * The metadata of the upload, only in the first message.
+     *
+     * @generated from field: homecall.v1alpha.UploadLogsMetadata metadata = 1;
+     */
+    value: UploadLogsMetadata;
+    case: "metadata";
+  } | {
+    /**
+     * A chunk of the (compressed) log file.
+     *
+     * @generated from field: bytes chunk = 2;
+     */
+    value: Uint8Array;
+    case: "chunk";
+  } | { case: undefined; value?: undefined };
+
+  constructor(data?: PartialMessage<UploadLogsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.UploadLogsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UploadLogsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UploadLogsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UploadLogsRequest;
+
+  static equals(a: UploadLogsRequest | PlainMessage<UploadLogsRequest> | undefined, b: UploadLogsRequest | PlainMessage<UploadLogsRequest> | undefined): boolean;
+}
+
+/**
+ * UploadLogsMetadata describes the log file that is uploaded.
+ *
+ * @generated from message homecall.v1alpha.UploadLogsMetadata
+ */
+export declare class UploadLogsMetadata extends Message<UploadLogsMetadata> {
+  /**
+   * The ID of the log upload, as received in the push notification.
+   *
+   * @generated from field: string log_id = 1;
+   */
+  logId: string;
+
+  /**
+   * The encoding of the log file, either "gzip" or "identity".
+   * Defaults to "identity" if not set.
+   *
+   * @generated from field: string content_encoding = 2;
+   */
+  contentEncoding: string;
+
+  constructor(data?: PartialMessage<UploadLogsMetadata>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.UploadLogsMetadata";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UploadLogsMetadata;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UploadLogsMetadata;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UploadLogsMetadata;
+
+  static equals(a: UploadLogsMetadata | PlainMessage<UploadLogsMetadata> | undefined, b: UploadLogsMetadata | PlainMessage<UploadLogsMetadata> | undefined): boolean;
+}
+
+/**
+ * UploadLogsResponse is the response to uploading logs.
+ *
+ * @generated from message homecall.v1alpha.UploadLogsResponse
+ */
+export declare class UploadLogsResponse extends Message<UploadLogsResponse> {
+  /**
+   * The number of bytes that were stored.
+   *
+   * @generated from field: int64 size_bytes = 1;
+   */
+  sizeBytes: bigint;
+
+  constructor(data?: PartialMessage<UploadLogsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.UploadLogsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UploadLogsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UploadLogsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UploadLogsResponse;
+
+  static equals(a: UploadLogsResponse | PlainMessage<UploadLogsResponse> | undefined, b: UploadLogsResponse | PlainMessage<UploadLogsResponse> | undefined): boolean;
+}
+
+/**
+ * RotateKeyRequest is the request to replace the key of a device.
+ *
+ * @generated from message homecall.v1alpha.RotateKeyRequest
+ */
+export declare class RotateKeyRequest extends Message<RotateKeyRequest> {
+  /**
+   * The new public key of the device.
+   * The public key is a PEM encoded RSA (at least 2048 bits), ECDSA (P-256 or P-384) or Ed25519 public key.
+   * RSA keys may be PKCS #1 or PKIX encoded, other keys must be PKIX encoded.
+   * Tokens must be signed with RS256/RS384/RS512, ES256, ES384 or EdDSA respectively.
+   *
+   * @generated from field: string public_key = 1;
+   */
+  publicKey: string;
+
+  constructor(data?: PartialMessage<RotateKeyRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.RotateKeyRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RotateKeyRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RotateKeyRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RotateKeyRequest;
+
+  static equals(a: RotateKeyRequest | PlainMessage<RotateKeyRequest> | undefined, b: RotateKeyRequest | PlainMessage<RotateKeyRequest> | undefined): boolean;
+}
+
+/**
+ * RotateKeyResponse is the response to replacing the key of a device.
+ *
+ * @generated from message homecall.v1alpha.RotateKeyResponse
+ */
+export declare class RotateKeyResponse extends Message<RotateKeyResponse> {
+  constructor(data?: PartialMessage<RotateKeyResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.RotateKeyResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RotateKeyResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RotateKeyResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RotateKeyResponse;
+
+  static equals(a: RotateKeyResponse | PlainMessage<RotateKeyResponse> | undefined, b: RotateKeyResponse | PlainMessage<RotateKeyResponse> | undefined): boolean;
+}
+
+/**
+ * ListMissedCallsRequest is the request to list the missed calls of a device.
+ *
+ * @generated from message homecall.v1alpha.ListMissedCallsRequest
+ */
+export declare class ListMissedCallsRequest extends Message<ListMissedCallsRequest> {
+  constructor(data?: PartialMessage<ListMissedCallsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.ListMissedCallsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListMissedCallsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListMissedCallsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListMissedCallsRequest;
+
+  static equals(a: ListMissedCallsRequest | PlainMessage<ListMissedCallsRequest> | undefined, b: ListMissedCallsRequest | PlainMessage<ListMissedCallsRequest> | undefined): boolean;
+}
+
+/**
+ * ListMissedCallsResponse is the response to listing the missed calls of a device.
+ *
+ * @generated from message homecall.v1alpha.ListMissedCallsResponse
+ */
+export declare class ListMissedCallsResponse extends Message<ListMissedCallsResponse> {
+  /**
+   * The missed calls, newest first.
+   *
+   * @generated from field: repeated homecall.v1alpha.MissedCall missed_calls = 1;
+   */
+  missedCalls: MissedCall[];
+
+  constructor(data?: PartialMessage<ListMissedCallsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.ListMissedCallsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListMissedCallsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListMissedCallsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListMissedCallsResponse;
+
+  static equals(a: ListMissedCallsResponse | PlainMessage<ListMissedCallsResponse> | undefined, b: ListMissedCallsResponse | PlainMessage<ListMissedCallsResponse> | undefined): boolean;
+}
+
+/**
+ * DismissMissedCallRequest is the request to dismiss a missed call.
+ *
+ * @generated from message homecall.v1alpha.DismissMissedCallRequest
+ */
+export declare class DismissMissedCallRequest extends Message<DismissMissedCallRequest> {
+  /**
+   * The ID of the missed call.
+   *
+   * @generated from field: string missed_call_id = 1;
+   */
+  missedCallId: string;
+
+  constructor(data?: PartialMessage<DismissMissedCallRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.DismissMissedCallRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DismissMissedCallRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DismissMissedCallRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DismissMissedCallRequest;
+
+  static equals(a: DismissMissedCallRequest | PlainMessage<DismissMissedCallRequest> | undefined, b: DismissMissedCallRequest | PlainMessage<DismissMissedCallRequest> | undefined): boolean;
+}
+
+/**
+ * DismissMissedCallResponse is the response to dismissing a missed call.
+ *
+ * @generated from message homecall.v1alpha.DismissMissedCallResponse
+ */
+export declare class DismissMissedCallResponse extends Message<DismissMissedCallResponse> {
+  constructor(data?: PartialMessage<DismissMissedCallResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.DismissMissedCallResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DismissMissedCallResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DismissMissedCallResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DismissMissedCallResponse;
+
+  static equals(a: DismissMissedCallResponse | PlainMessage<DismissMissedCallResponse> | undefined, b: DismissMissedCallResponse | PlainMessage<DismissMissedCallResponse> | undefined): boolean;
+}
+
+/**
+ * RequestCallbackRequest is the request to be called back.
+ *
+ * @generated from message homecall.v1alpha.RequestCallbackRequest
+ */
+export declare class RequestCallbackRequest extends Message<RequestCallbackRequest> {
+  /**
+   * The ID of the missed call the callback is requested from, may be empty.
+   *
+   * @generated from field: string missed_call_id = 1;
+   */
+  missedCallId: string;
+
+  constructor(data?: PartialMessage<RequestCallbackRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.RequestCallbackRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RequestCallbackRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RequestCallbackRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RequestCallbackRequest;
+
+  static equals(a: RequestCallbackRequest | PlainMessage<RequestCallbackRequest> | undefined, b: RequestCallbackRequest | PlainMessage<RequestCallbackRequest> | undefined): boolean;
+}
+
+/**
+ * RequestCallbackResponse is the response to requesting a callback.
+ *
+ * @generated from message homecall.v1alpha.RequestCallbackResponse
+ */
+export declare class RequestCallbackResponse extends Message<RequestCallbackResponse> {
+  /**
+   * The open callback request of the device.
+   *
+   * @generated from field: homecall.v1alpha.CallbackRequest callback_request = 1;
+   */
+  callbackRequest?: CallbackRequest;
+
+  constructor(data?: PartialMessage<RequestCallbackResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.RequestCallbackResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RequestCallbackResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RequestCallbackResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RequestCallbackResponse;
+
+  static equals(a: RequestCallbackResponse | PlainMessage<RequestCallbackResponse> | undefined, b: RequestCallbackResponse | PlainMessage<RequestCallbackResponse> | undefined): boolean;
+}
+
+/**
+ * ListMessagesRequest is the request to list the messages of a device.
+ *
+ * @generated from message homecall.v1alpha.ListMessagesRequest
+ */
+export declare class ListMessagesRequest extends Message<ListMessagesRequest> {
+  constructor(data?: PartialMessage<ListMessagesRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.ListMessagesRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListMessagesRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListMessagesRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListMessagesRequest;
+
+  static equals(a: ListMessagesRequest | PlainMessage<ListMessagesRequest> | undefined, b: ListMessagesRequest | PlainMessage<ListMessagesRequest> | undefined): boolean;
+}
+
+/**
+ * ListMessagesResponse is the response to listing the messages of a device.
+ *
+ * @generated from message homecall.v1alpha.ListMessagesResponse
+ */
+export declare class ListMessagesResponse extends Message<ListMessagesResponse> {
+  /**
+   * The messages, newest first, photos are downloaded with GetMessageImage.
+   *
+   * @generated from field: repeated homecall.v1alpha.DeviceMessage messages = 1;
+   */
+  messages: DeviceMessage[];
+
+  constructor(data?: PartialMessage<ListMessagesResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.ListMessagesResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListMessagesResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListMessagesResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListMessagesResponse;
+
+  static equals(a: ListMessagesResponse | PlainMessage<ListMessagesResponse> | undefined, b: ListMessagesResponse | PlainMessage<ListMessagesResponse> | undefined): boolean;
+}
+
+/**
+ * AckMessageRequest is the request to mark a message as read.
+ *
+ * @generated from message homecall.v1alpha.AckMessageRequest
+ */
+export declare class AckMessageRequest extends Message<AckMessageRequest> {
+  /**
+   * The ID of the message.
+   *
+   * @generated from field: string message_id = 1;
+   */
+  messageId: string;
+
+  constructor(data?: PartialMessage<AckMessageRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.AckMessageRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AckMessageRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AckMessageRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AckMessageRequest;
+
+  static equals(a: AckMessageRequest | PlainMessage<AckMessageRequest> | undefined, b: AckMessageRequest | PlainMessage<AckMessageRequest> | undefined): boolean;
+}
+
+/**
+ * AckMessageResponse is the response to marking a message as read.
+ *
+ * @generated from message homecall.v1alpha.AckMessageResponse
+ */
+export declare class AckMessageResponse extends Message<AckMessageResponse> {
+  constructor(data?: PartialMessage<AckMessageResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.AckMessageResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AckMessageResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AckMessageResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AckMessageResponse;
+
+  static equals(a: AckMessageResponse | PlainMessage<AckMessageResponse> | undefined, b: AckMessageResponse | PlainMessage<AckMessageResponse> | undefined): boolean;
+}
+
+/**
+ * GetMessageImageRequest is the request to download the photo of a message.
+ *
+ * @generated from message homecall.v1alpha.GetMessageImageRequest
+ */
+export declare class GetMessageImageRequest extends Message<GetMessageImageRequest> {
+  /**
+   * The ID of the message.
+   *
+   * @generated from field: string message_id = 1;
+   */
+  messageId: string;
+
+  constructor(data?: PartialMessage<GetMessageImageRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.GetMessageImageRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetMessageImageRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetMessageImageRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetMessageImageRequest;
+
+  static equals(a: GetMessageImageRequest | PlainMessage<GetMessageImageRequest> | undefined, b: GetMessageImageRequest | PlainMessage<GetMessageImageRequest> | undefined): boolean;
+}
+
+/**
+ * GetMessageImageResponse is the response to downloading the photo of a message.
+ *
+ * @generated from message homecall.v1alpha.GetMessageImageResponse
+ */
+export declare class GetMessageImageResponse extends Message<GetMessageImageResponse> {
+  /**
+   * The image, without its content.
+   *
+   * @generated from field: homecall.v1alpha.MessageImage image = 1;
+   */
+  image?: MessageImage;
+
+  /**
+   * The content of the image.
+   *
+   * @generated from field: bytes data = 2;
+   */
+  data: Uint8Array;
+
+  constructor(data?: PartialMessage<GetMessageImageResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.GetMessageImageResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetMessageImageResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetMessageImageResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetMessageImageResponse;
+
+  static equals(a: GetMessageImageResponse | PlainMessage<GetMessageImageResponse> | undefined, b: GetMessageImageResponse | PlainMessage<GetMessageImageResponse> | undefined): boolean;
+}
+
+/**
+ * SyncContentRequest is the request to sync the albums of a device.
+ *
+ * @generated from message homecall.v1alpha.SyncContentRequest
+ */
+export declare class SyncContentRequest extends Message<SyncContentRequest> {
+  /**
+   * The ETag of the manifest the device has, empty if it has none.
+   *
+   * @generated from field: string etag = 1;
+   */
+  etag: string;
+
+  constructor(data?: PartialMessage<SyncContentRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.SyncContentRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SyncContentRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SyncContentRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SyncContentRequest;
+
+  static equals(a: SyncContentRequest | PlainMessage<SyncContentRequest> | undefined, b: SyncContentRequest | PlainMessage<SyncContentRequest> | undefined): boolean;
+}
+
+/**
+ * SyncContentResponse is the response to syncing the albums of a device.
+ *
+ * @generated from message homecall.v1alpha.SyncContentResponse
+ */
+export declare class SyncContentResponse extends Message<SyncContentResponse> {
+  /**
+   * Whether the manifest is unchanged, the albums are not set if it is.
+   *
+   * @generated from field: bool not_modified = 1;
+   */
+  notModified: boolean;
+
+  /**
+   * The ETag of the current manifest.
+   *
+   * @generated from field: string etag = 2;
+   */
+  etag: string;
+
+  /**
+   * The albums the device shows, the images do not contain their content.
+   *
+   * @generated from field: repeated homecall.v1alpha.Album albums = 3;
+   */
+  albums: Album[];
+
+  constructor(data?: PartialMessage<SyncContentResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.SyncContentResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SyncContentResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SyncContentResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SyncContentResponse;
+
+  static equals(a: SyncContentResponse | PlainMessage<SyncContentResponse> | undefined, b: SyncContentResponse | PlainMessage<SyncContentResponse> | undefined): boolean;
+}
+
+/**
+ * GetAlbumImageRequest is the request to download an album image.
+ *
+ * @generated from message homecall.v1alpha.GetAlbumImageRequest
+ */
+export declare class GetAlbumImageRequest extends Message<GetAlbumImageRequest> {
+  /**
+   * The ID of the image.
+   *
+   * @generated from field: string image_id = 1;
+   */
+  imageId: string;
+
+  constructor(data?: PartialMessage<GetAlbumImageRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.GetAlbumImageRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAlbumImageRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAlbumImageRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAlbumImageRequest;
+
+  static equals(a: GetAlbumImageRequest | PlainMessage<GetAlbumImageRequest> | undefined, b: GetAlbumImageRequest | PlainMessage<GetAlbumImageRequest> | undefined): boolean;
+}
+
+/**
+ * GetAlbumImageResponse is the response to downloading an album image.
+ *
+ * @generated from message homecall.v1alpha.GetAlbumImageResponse
+ */
+export declare class GetAlbumImageResponse extends Message<GetAlbumImageResponse> {
+  /**
+   * The image, without its content.
+   *
+   * @generated from field: homecall.v1alpha.AlbumImage image = 1;
+   */
+  image?: AlbumImage;
+
+  /**
+   * The content of the image.
+   *
+   * @generated from field: bytes data = 2;
+   */
+  data: Uint8Array;
+
+  constructor(data?: PartialMessage<GetAlbumImageResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.GetAlbumImageResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAlbumImageResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAlbumImageResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAlbumImageResponse;
+
+  static equals(a: GetAlbumImageResponse | PlainMessage<GetAlbumImageResponse> | undefined, b: GetAlbumImageResponse | PlainMessage<GetAlbumImageResponse> | undefined): boolean;
+}
@@ -4,10 +4,16 @@
 // @ts-nocheck
 
 import { proto3 } from "@bufbuild/protobuf";
-import { DeviceSettings } from "./settings_pb.js";
+import { DeviceSettings, QuietHours } from "./settings_pb.js";
+import { DeviceState } from "./device_state_pb.js";
+import { DeviceDiagnostics } from "./diagnostics_pb.js";
+import { CallbackRequest, MissedCall } from "./missed_call_pb.js";
+import { DeviceMessage, MessageImage } from "./device_message_pb.js";
+import { Album, AlbumImage } from "./album_pb.js";
 
 /**
  * EnrollRequest is the request to enroll a device.
+ * Exactly one of enrollment_key, pairing_code and qr_payload must be set.
  *
  * @generated from message homecall.v1alpha.EnrollRequest
  */
@@ -16,6 +22,37 @@ export const EnrollRequest = /*@__PURE__*/ proto3.makeMessageType(
   () => [
     { no: 1, name: "enrollment_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "public_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "pairing_code", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "qr_payload", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "attestation", kind: "message", T: DeviceAttestation },
+  ],
+);
+
+/**
+ * DeviceAttestation is a statement from the platform that vouches for the app and device that enrolls.
+ * The statement must be bound to the public key of the EnrollRequest,
+ * the nonce or client data hash is the SHA-256 hash of the public_key field.
+ *
+ * @generated from message homecall.v1alpha.DeviceAttestation
+ */
+export const DeviceAttestation = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.DeviceAttestation",
+  () => [
+    { no: 1, name: "play_integrity_token", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "statement" },
+    { no: 2, name: "app_attest", kind: "message", T: AppAttestStatement, oneof: "statement" },
+  ],
+);
+
+/**
+ * AppAttestStatement is the result of attesting an App Attest key.
+ *
+ * @generated from message homecall.v1alpha.AppAttestStatement
+ */
+export const AppAttestStatement = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.AppAttestStatement",
+  () => [
+    { no: 1, name: "attestation_object", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "key_id", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
   ],
 );
 
@@ -57,6 +94,8 @@ export const GetCallDetailsResponse = /*@__PURE__*/ proto3.makeMessageType(
     { no: 1, name: "jitsi_room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "jitsi_jwt", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "call_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "auto_answer", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "auto_answer_delay_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
   ],
 );
 
@@ -81,3 +120,298 @@ export const UpdateNotificationTokenResponse = /*@__PURE__*/ proto3.makeMessageT
   "homecall.v1alpha.UpdateNotificationTokenResponse",
   [],
 );
+
+/**
+ * HeartbeatRequest is the request to report the state of a device.
+ *
+ * @generated from message homecall.v1alpha.HeartbeatRequest
+ */
+export const HeartbeatRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.HeartbeatRequest",
+  () => [
+    { no: 1, name: "state", kind: "message", T: DeviceState },
+  ],
+);
+
+/**
+ * HeartbeatResponse is the response to reporting the state of a device.
+ *
+ * @generated from message homecall.v1alpha.HeartbeatResponse
+ */
+export const HeartbeatResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.HeartbeatResponse",
+  () => [
+    { no: 1, name: "next_heartbeat_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "quiet_hours", kind: "message", T: QuietHours },
+  ],
+);
+
+/**
+ * ReportDiagnosticsRequest is the request to report diagnostics.
+ *
+ * @generated from message homecall.v1alpha.ReportDiagnosticsRequest
+ */
+export const ReportDiagnosticsRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.ReportDiagnosticsRequest",
+  () => [
+    { no: 1, name: "diagnostics", kind: "message", T: DeviceDiagnostics },
+  ],
+);
+
+/**
+ * ReportDiagnosticsResponse is the response to reporting diagnostics.
+ *
+ * @generated from message homecall.v1alpha.ReportDiagnosticsResponse
+ */
+export const ReportDiagnosticsResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.ReportDiagnosticsResponse",
+  [],
+);
+
+/**
+ * UploadLogsRequest is a message in the stream of uploading logs.
+ *
+ * @generated from message homecall.v1alpha.UploadLogsRequest
+ */
+export const UploadLogsRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.UploadLogsRequest",
+  () => [
+    { no: 1, name: "metadata", kind: "message", T: UploadLogsMetadata, oneof: "data" },
+    { no: 2, name: "chunk", kind: "scalar", T: 12 /* ScalarType.BYTES */, oneof: "data" },
+  ],
+);
+
+/**
+ * UploadLogsMetadata describes the log file that is uploaded.
+ *
+ * @generated from message homecall.v1alpha.UploadLogsMetadata
+ */
+export const UploadLogsMetadata = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.UploadLogsMetadata",
+  () => [
+    { no: 1, name: "log_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "content_encoding", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * UploadLogsResponse is the response to uploading logs.
+ *
+ * @generated from message homecall.v1alpha.UploadLogsResponse
+ */
+export const UploadLogsResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.UploadLogsResponse",
+  () => [
+    { no: 1, name: "size_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ],
+);
+
+/**
+ * RotateKeyRequest is the request to replace the key of a device.
+ *
+ * @generated from message homecall.v1alpha.RotateKeyRequest
+ */
+export const RotateKeyRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.RotateKeyRequest",
+  () => [
+    { no: 1, name: "public_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * RotateKeyResponse is the response to replacing the key of a device.
+ *
+ * @generated from message homecall.v1alpha.RotateKeyResponse
+ */
+export const RotateKeyResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.RotateKeyResponse",
+  [],
+);
+
+/**
+ * ListMissedCallsRequest is the request to list the missed calls of a device.
+ *
+ * @generated from message homecall.v1alpha.ListMissedCallsRequest
+ */
+export const ListMissedCallsRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.ListMissedCallsRequest",
+  [],
+);
+
+/**
+ * ListMissedCallsResponse is the response to listing the missed calls of a device.
+ *
+ * @generated from message homecall.v1alpha.ListMissedCallsResponse
+ */
+export const ListMissedCallsResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.ListMissedCallsResponse",
+  () => [
+    { no: 1, name: "missed_calls", kind: "message", T: MissedCall, repeated: true },
+  ],
+);
+
+/**
+ * DismissMissedCallRequest is the request to dismiss a missed call.
+ *
+ * @generated from message homecall.v1alpha.DismissMissedCallRequest
+ */
+export const DismissMissedCallRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.DismissMissedCallRequest",
+  () => [
+    { no: 1, name: "missed_call_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * DismissMissedCallResponse is the response to dismissing a missed call.
+ *
+ * @generated from message homecall.v1alpha.DismissMissedCallResponse
+ */
+export const DismissMissedCallResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.DismissMissedCallResponse",
+  [],
+);
+
+/**
+ * RequestCallbackRequest is the request to be called back.
+ *
+ * @generated from message homecall.v1alpha.RequestCallbackRequest
+ */
+export const RequestCallbackRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.RequestCallbackRequest",
+  () => [
+    { no: 1, name: "missed_call_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * RequestCallbackResponse is the response to requesting a callback.
+ *
+ * @generated from message homecall.v1alpha.RequestCallbackResponse
+ */
+export const RequestCallbackResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.RequestCallbackResponse",
+  () => [
+    { no: 1, name: "callback_request", kind: "message", T: CallbackRequest },
+  ],
+);
+
+/**
+ * ListMessagesRequest is the request to list the messages of a device.
+ *
+ * @generated from message homecall.v1alpha.ListMessagesRequest
+ */
+export const ListMessagesRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.ListMessagesRequest",
+  [],
+);
+
+/**
+ * ListMessagesResponse is the response to listing the messages of a device.
+ *
+ * @generated from message homecall.v1alpha.ListMessagesResponse
+ */
+export const ListMessagesResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.ListMessagesResponse",
+  () => [
+    { no: 1, name: "messages", kind: "message", T: DeviceMessage, repeated: true },
+  ],
+);
+
+/**
+ * AckMessageRequest is the request to mark a message as read.
+ *
+ * @generated from message homecall.v1alpha.AckMessageRequest
+ */
+export const AckMessageRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.AckMessageRequest",
+  () => [
+    { no: 1, name: "message_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * AckMessageResponse is the response to marking a message as read.
+ *
+ * @generated from message homecall.v1alpha.AckMessageResponse
+ */
+export const AckMessageResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.AckMessageResponse",
+  [],
+);
+
+/**
+ * GetMessageImageRequest is the request to download the photo of a message.
+ *
+ * @generated from message homecall.v1alpha.GetMessageImageRequest
+ */
+export const GetMessageImageRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.GetMessageImageRequest",
+  () => [
+    { no: 1, name: "message_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * GetMessageImageResponse is the response to downloading the photo of a message.
+ *
+ * @generated from message homecall.v1alpha.GetMessageImageResponse
+ */
+export const GetMessageImageResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.GetMessageImageResponse",
+  () => [
+    { no: 1, name: "image", kind: "message", T: MessageImage },
+    { no: 2, name: "data", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ],
+);
+
+/**
+ * SyncContentRequest is the request to sync the albums of a device.
+ *
+ * @generated from message homecall.v1alpha.SyncContentRequest
+ */
+export const SyncContentRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.SyncContentRequest",
+  () => [
+    { no: 1, name: "etag", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * SyncContentResponse is the response to syncing the albums of a device.
+ *
+ * @generated from message homecall.v1alpha.SyncContentResponse
+ */
+export const SyncContentResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.SyncContentResponse",
+  () => [
+    { no: 1, name: "not_modified", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "etag", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "albums", kind: "message", T: Album, repeated: true },
+  ],
+);
+
+/**
+ * GetAlbumImageRequest is the request to download an album image.
+ *
+ * @generated from message homecall.v1alpha.GetAlbumImageRequest
+ */
+export const GetAlbumImageRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.GetAlbumImageRequest",
+  () => [
+    { no: 1, name: "image_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * GetAlbumImageResponse is the response to downloading an album image.
+ *
+ * @generated from message homecall.v1alpha.GetAlbumImageResponse
+ */
+export const GetAlbumImageResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.GetAlbumImageResponse",
+  () => [
+    { no: 1, name: "image", kind: "message", T: AlbumImage },
+    { no: 2, name: "data", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ],
+);
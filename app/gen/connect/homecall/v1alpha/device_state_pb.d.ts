@@ -0,0 +1,128 @@
+// @generated by protoc-gen-es v1.8.0
+// @generated from file homecall/v1alpha/device_state.proto (package homecall.v1alpha, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+
+/**
+ * AppState represents the state of the app on a device.
+ *
+ * @generated from enum homecall.v1alpha.AppState
+ */
+export declare enum AppState {
+  /**
+   * The app state is unknown.
+   *
+   * @generated from enum value: APP_STATE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The app is in the foreground and can ring immediately.
+   *
+   * @generated from enum value: APP_STATE_FOREGROUND = 1;
+   */
+  FOREGROUND = 1,
+
+  /**
+   * The app is in the background and relies on push notifications to ring.
+   *
+   * @generated from enum value: APP_STATE_BACKGROUND = 2;
+   */
+  BACKGROUND = 2,
+}
+
+/**
+ * NetworkType represents the type of network a device is connected to.
+ *
+ * @generated from enum homecall.v1alpha.NetworkType
+ */
+export declare enum NetworkType {
+  /**
+   * The network type is unknown.
+   *
+   * @generated from enum value: NETWORK_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The device has no network connection.
+   *
+   * @generated from enum value: NETWORK_TYPE_NONE = 1;
+   */
+  NONE = 1,
+
+  /**
+   * The device is connected to a wifi network.
+   *
+   * @generated from enum value: NETWORK_TYPE_WIFI = 2;
+   */
+  WIFI = 2,
+
+  /**
+   * The device is connected to a cellular network.
+   *
+   * @generated from enum value: NETWORK_TYPE_CELLULAR = 3;
+   */
+  CELLULAR = 3,
+
+  /**
+   * The device is connected to a wired network.
+   *
+   * @generated from enum value: NETWORK_TYPE_ETHERNET = 4;
+   */
+  ETHERNET = 4,
+}
+
+/**
+ * DeviceState is the state a device reports about itself in its heartbeats.
+ *
+ * @generated from message homecall.v1alpha.DeviceState
+ */
+export declare class DeviceState extends Message<DeviceState> {
+  /**
+   * The state of the app on the device.
+   *
+   * @generated from field: homecall.v1alpha.AppState app_state = 1;
+   */
+  appState: AppState;
+
+  /**
+   * The battery level of the device in percent (0-100).
+   * Not set if the device does not report its battery level.
+   *
+   * @generated from field: optional int32 battery_level = 2;
+   */
+  batteryLevel?: number;
+
+  /**
+   * Whether the device is currently charging.
+   * Not set if the device does not report its charging state.
+   *
+   * @generated from field: optional bool battery_charging = 3;
+   */
+  batteryCharging?: boolean;
+
+  /**
+   * The type of network the device is connected to.
+   *
+   * @generated from field: homecall.v1alpha.NetworkType network_type = 4;
+   */
+  networkType: NetworkType;
+
+  constructor(data?: PartialMessage<DeviceState>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.DeviceState";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeviceState;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeviceState;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeviceState;
+
+  static equals(a: DeviceState | PlainMessage<DeviceState> | undefined, b: DeviceState | PlainMessage<DeviceState> | undefined): boolean;
+}
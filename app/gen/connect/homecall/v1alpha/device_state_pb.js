@@ -0,0 +1,51 @@
+// @generated by protoc-gen-es v1.8.0
+// @generated from file homecall/v1alpha/device_state.proto (package homecall.v1alpha, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { proto3 } from "@bufbuild/protobuf";
+
+/**
+ * AppState represents the state of the app on a device.
+ *
+ * @generated from enum homecall.v1alpha.AppState
+ */
+export const AppState = /*@__PURE__*/ proto3.makeEnum(
+  "homecall.v1alpha.AppState",
+  [
+    {no: 0, name: "APP_STATE_UNSPECIFIED", localName: "UNSPECIFIED"},
+    {no: 1, name: "APP_STATE_FOREGROUND", localName: "FOREGROUND"},
+    {no: 2, name: "APP_STATE_BACKGROUND", localName: "BACKGROUND"},
+  ],
+);
+
+/**
+ * NetworkType represents the type of network a device is connected to.
+ *
+ * @generated from enum homecall.v1alpha.NetworkType
+ */
+export const NetworkType = /*@__PURE__*/ proto3.makeEnum(
+  "homecall.v1alpha.NetworkType",
+  [
+    {no: 0, name: "NETWORK_TYPE_UNSPECIFIED", localName: "UNSPECIFIED"},
+    {no: 1, name: "NETWORK_TYPE_NONE", localName: "NONE"},
+    {no: 2, name: "NETWORK_TYPE_WIFI", localName: "WIFI"},
+    {no: 3, name: "NETWORK_TYPE_CELLULAR", localName: "CELLULAR"},
+    {no: 4, name: "NETWORK_TYPE_ETHERNET", localName: "ETHERNET"},
+  ],
+);
+
+/**
+ * DeviceState is the state a device reports about itself in its heartbeats.
+ *
+ * @generated from message homecall.v1alpha.DeviceState
+ */
+export const DeviceState = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.DeviceState",
+  () => [
+    { no: 1, name: "app_state", kind: "enum", T: proto3.getEnumType(AppState) },
+    { no: 2, name: "battery_level", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+    { no: 3, name: "battery_charging", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+    { no: 4, name: "network_type", kind: "enum", T: proto3.getEnumType(NetworkType) },
+  ],
+);
@@ -0,0 +1,225 @@
+// @generated by protoc-gen-es v1.8.0
+// @generated from file homecall/v1alpha/diagnostics.proto (package homecall.v1alpha, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage, Timestamp } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+
+/**
+ * PermissionState represents whether a permission has been granted.
+ *
+ * @generated from enum homecall.v1alpha.PermissionState
+ */
+export declare enum PermissionState {
+  /**
+   * The permission state is unknown.
+   *
+   * @generated from enum value: PERMISSION_STATE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The permission has been granted.
+   *
+   * @generated from enum value: PERMISSION_STATE_GRANTED = 1;
+   */
+  GRANTED = 1,
+
+  /**
+   * The permission has been denied.
+   *
+   * @generated from enum value: PERMISSION_STATE_DENIED = 2;
+   */
+  DENIED = 2,
+
+  /**
+   * The user has not yet been asked for the permission.
+   *
+   * @generated from enum value: PERMISSION_STATE_NOT_DETERMINED = 3;
+   */
+  NOT_DETERMINED = 3,
+}
+
+/**
+ * DeviceDiagnostics contains the information a device reports to help troubleshoot it remotely.
+ *
+ * @generated from message homecall.v1alpha.DeviceDiagnostics
+ */
+export declare class DeviceDiagnostics extends Message<DeviceDiagnostics> {
+  /**
+   * The version of the app running on the device.
+   *
+   * @generated from field: string app_version = 1;
+   */
+  appVersion: string;
+
+  /**
+   * The name of the operating system, e.g. "android" or "ios".
+   *
+   * @generated from field: string os_name = 2;
+   */
+  osName: string;
+
+  /**
+   * The version of the operating system.
+   *
+   * @generated from field: string os_version = 3;
+   */
+  osVersion: string;
+
+  /**
+   * The state of the permissions the app needs to ring and join calls.
+   *
+   * @generated from field: homecall.v1alpha.DevicePermissions permissions = 4;
+   */
+  permissions?: DevicePermissions;
+
+  /**
+   * The last time the device received a push notification.
+   * Not set if the device has not received any push notification.
+   *
+   * @generated from field: google.protobuf.Timestamp last_push_received = 5;
+   */
+  lastPushReceived?: Timestamp;
+
+  /**
+   * Errors that recently occurred on the device, oldest first.
+   *
+   * @generated from field: repeated homecall.v1alpha.DiagnosticsError recent_errors = 6;
+   */
+  recentErrors: DiagnosticsError[];
+
+  constructor(data?: PartialMessage<DeviceDiagnostics>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.DeviceDiagnostics";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeviceDiagnostics;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeviceDiagnostics;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeviceDiagnostics;
+
+  static equals(a: DeviceDiagnostics | PlainMessage<DeviceDiagnostics> | undefined, b: DeviceDiagnostics | PlainMessage<DeviceDiagnostics> | undefined): boolean;
+}
+
+/**
+ * DevicePermissions contains the state of the permissions of the app.
+ *
+ * @generated from message homecall.v1alpha.DevicePermissions
+ */
+export declare class DevicePermissions extends Message<DevicePermissions> {
+  /**
+   * Permission to show notifications.
+   *
+   * @generated from field: homecall.v1alpha.PermissionState notifications = 1;
+   */
+  notifications: PermissionState;
+
+  /**
+   * Permission to use the camera.
+   *
+   * @generated from field: homecall.v1alpha.PermissionState camera = 2;
+   */
+  camera: PermissionState;
+
+  /**
+   * Permission to use the microphone.
+   *
+   * @generated from field: homecall.v1alpha.PermissionState microphone = 3;
+   */
+  microphone: PermissionState;
+
+  constructor(data?: PartialMessage<DevicePermissions>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.DevicePermissions";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DevicePermissions;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DevicePermissions;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DevicePermissions;
+
+  static equals(a: DevicePermissions | PlainMessage<DevicePermissions> | undefined, b: DevicePermissions | PlainMessage<DevicePermissions> | undefined): boolean;
+}
+
+/**
+ * DiagnosticsError is an error that occurred on a device.
+ *
+ * @generated from message homecall.v1alpha.DiagnosticsError
+ */
+export declare class DiagnosticsError extends Message<DiagnosticsError> {
+  /**
+   * When the error occurred.
+   *
+   * @generated from field: google.protobuf.Timestamp occurred_at = 1;
+   */
+  occurredAt?: Timestamp;
+
+  /**
+   * Where the error occurred, e.g. "push" or "call".
+   *
+   * @generated from field: string source = 2;
+   */
+  source: string;
+
+  /**
+   * The error message.
+   *
+   * @generated from field: string message = 3;
+   */
+  message: string;
+
+  constructor(data?: PartialMessage<DiagnosticsError>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.DiagnosticsError";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DiagnosticsError;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DiagnosticsError;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DiagnosticsError;
+
+  static equals(a: DiagnosticsError | PlainMessage<DiagnosticsError> | undefined, b: DiagnosticsError | PlainMessage<DiagnosticsError> | undefined): boolean;
+}
+
+/**
+ * DeviceDiagnosticsReport is a diagnostics report as received from a device.
+ *
+ * @generated from message homecall.v1alpha.DeviceDiagnosticsReport
+ */
+export declare class DeviceDiagnosticsReport extends Message<DeviceDiagnosticsReport> {
+  /**
+   * When the report was received.
+   *
+   * @generated from field: google.protobuf.Timestamp reported_at = 1;
+   */
+  reportedAt?: Timestamp;
+
+  /**
+   * The reported diagnostics.
+   *
+   * @generated from field: homecall.v1alpha.DeviceDiagnostics diagnostics = 2;
+   */
+  diagnostics?: DeviceDiagnostics;
+
+  constructor(data?: PartialMessage<DeviceDiagnosticsReport>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.DeviceDiagnosticsReport";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeviceDiagnosticsReport;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeviceDiagnosticsReport;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeviceDiagnosticsReport;
+
+  static equals(a: DeviceDiagnosticsReport | PlainMessage<DeviceDiagnosticsReport> | undefined, b: DeviceDiagnosticsReport | PlainMessage<DeviceDiagnosticsReport> | undefined): boolean;
+}
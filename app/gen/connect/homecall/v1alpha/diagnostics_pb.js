@@ -0,0 +1,79 @@
+// @generated by protoc-gen-es v1.8.0
+// @generated from file homecall/v1alpha/diagnostics.proto (package homecall.v1alpha, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { proto3, Timestamp } from "@bufbuild/protobuf";
+
+/**
+ * PermissionState represents whether a permission has been granted.
+ *
+ * @generated from enum homecall.v1alpha.PermissionState
+ */
+export const PermissionState = /*@__PURE__*/ proto3.makeEnum(
+  "homecall.v1alpha.PermissionState",
+  [
+    {no: 0, name: "PERMISSION_STATE_UNSPECIFIED", localName: "UNSPECIFIED"},
+    {no: 1, name: "PERMISSION_STATE_GRANTED", localName: "GRANTED"},
+    {no: 2, name: "PERMISSION_STATE_DENIED", localName: "DENIED"},
+    {no: 3, name: "PERMISSION_STATE_NOT_DETERMINED", localName: "NOT_DETERMINED"},
+  ],
+);
+
+/**
+ * DeviceDiagnostics contains the information a device reports to help troubleshoot it remotely.
+ *
+ * @generated from message homecall.v1alpha.DeviceDiagnostics
+ */
+export const DeviceDiagnostics = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.DeviceDiagnostics",
+  () => [
+    { no: 1, name: "app_version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "os_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "os_version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "permissions", kind: "message", T: DevicePermissions },
+    { no: 5, name: "last_push_received", kind: "message", T: Timestamp },
+    { no: 6, name: "recent_errors", kind: "message", T: DiagnosticsError, repeated: true },
+  ],
+);
+
+/**
+ * DevicePermissions contains the state of the permissions of the app.
+ *
+ * @generated from message homecall.v1alpha.DevicePermissions
+ */
+export const DevicePermissions = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.DevicePermissions",
+  () => [
+    { no: 1, name: "notifications", kind: "enum", T: proto3.getEnumType(PermissionState) },
+    { no: 2, name: "camera", kind: "enum", T: proto3.getEnumType(PermissionState) },
+    { no: 3, name: "microphone", kind: "enum", T: proto3.getEnumType(PermissionState) },
+  ],
+);
+
+/**
+ * DiagnosticsError is an error that occurred on a device.
+ *
+ * @generated from message homecall.v1alpha.DiagnosticsError
+ */
+export const DiagnosticsError = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.DiagnosticsError",
+  () => [
+    { no: 1, name: "occurred_at", kind: "message", T: Timestamp },
+    { no: 2, name: "source", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * DeviceDiagnosticsReport is a diagnostics report as received from a device.
+ *
+ * @generated from message homecall.v1alpha.DeviceDiagnosticsReport
+ */
+export const DeviceDiagnosticsReport = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.DeviceDiagnosticsReport",
+  () => [
+    { no: 1, name: "reported_at", kind: "message", T: Timestamp },
+    { no: 2, name: "diagnostics", kind: "message", T: DeviceDiagnostics },
+  ],
+);
@@ -0,0 +1,44 @@
+// @generated by protoc-gen-es v1.8.0
+// @generated from file homecall/v1alpha/errors.proto (package homecall.v1alpha, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage, Timestamp } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+
+/**
+ * DeviceDisabledError is attached as an error detail when a request fails because the device is disabled.
+ * Devices receive it with the PERMISSION_DENIED code on every call,
+ * the office app receives it with the FAILED_PRECONDITION code when calling a disabled device.
+ *
+ * @generated from message homecall.v1alpha.DeviceDisabledError
+ */
+export declare class DeviceDisabledError extends Message<DeviceDisabledError> {
+  /**
+   * The ID of the disabled device.
+   *
+   * @generated from field: string device_id = 1;
+   */
+  deviceId: string;
+
+  /**
+   * When the device was disabled.
+   *
+   * @generated from field: google.protobuf.Timestamp disabled_at = 2;
+   */
+  disabledAt?: Timestamp;
+
+  constructor(data?: PartialMessage<DeviceDisabledError>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.DeviceDisabledError";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeviceDisabledError;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeviceDisabledError;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeviceDisabledError;
+
+  static equals(a: DeviceDisabledError | PlainMessage<DeviceDisabledError> | undefined, b: DeviceDisabledError | PlainMessage<DeviceDisabledError> | undefined): boolean;
+}
@@ -0,0 +1,21 @@
+// @generated by protoc-gen-es v1.8.0
+// @generated from file homecall/v1alpha/errors.proto (package homecall.v1alpha, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { proto3, Timestamp } from "@bufbuild/protobuf";
+
+/**
+ * DeviceDisabledError is attached as an error detail when a request fails because the device is disabled.
+ * Devices receive it with the PERMISSION_DENIED code on every call,
+ * the office app receives it with the FAILED_PRECONDITION code when calling a disabled device.
+ *
+ * @generated from message homecall.v1alpha.DeviceDisabledError
+ */
+export const DeviceDisabledError = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.DeviceDisabledError",
+  () => [
+    { no: 1, name: "device_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "disabled_at", kind: "message", T: Timestamp },
+  ],
+);
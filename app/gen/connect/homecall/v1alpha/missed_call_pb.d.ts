@@ -0,0 +1,183 @@
+// @generated by protoc-gen-es v1.8.0
+// @generated from file homecall/v1alpha/missed_call.proto (package homecall.v1alpha, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage, Timestamp } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+
+/**
+ * MissedCallReason is why a device did not answer a call.
+ *
+ * @generated from enum homecall.v1alpha.MissedCallReason
+ */
+export declare enum MissedCallReason {
+  /**
+   * The reason is unknown.
+   *
+   * @generated from enum value: MISSED_CALL_REASON_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The device did not join the call in time.
+   *
+   * @generated from enum value: MISSED_CALL_REASON_TIMEOUT = 1;
+   */
+  TIMEOUT = 1,
+
+  /**
+   * The call was declined on the device.
+   *
+   * @generated from enum value: MISSED_CALL_REASON_DECLINED = 2;
+   */
+  DECLINED = 2,
+}
+
+/**
+ * MissedCall is a call a device did not answer.
+ *
+ * @generated from message homecall.v1alpha.MissedCall
+ */
+export declare class MissedCall extends Message<MissedCall> {
+  /**
+   * The ID of the missed call.
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * The ID of the call.
+   *
+   * @generated from field: string call_id = 2;
+   */
+  callId: string;
+
+  /**
+   * The ID of the device that missed the call.
+   *
+   * @generated from field: string device_id = 3;
+   */
+  deviceId: string;
+
+  /**
+   * The ID of the tenant member that started the call.
+   * Not set if the member has been removed from the tenant.
+   *
+   * @generated from field: string caller_member_id = 4;
+   */
+  callerMemberId: string;
+
+  /**
+   * The name of the caller as shown in the call.
+   *
+   * @generated from field: string caller_display_name = 5;
+   */
+  callerDisplayName: string;
+
+  /**
+   * Why the call was missed.
+   *
+   * @generated from field: homecall.v1alpha.MissedCallReason reason = 6;
+   */
+  reason: MissedCallReason;
+
+  /**
+   * When the call was detected as missed.
+   *
+   * @generated from field: google.protobuf.Timestamp created_at = 7;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * When the device dismissed the missed call, not set if it is still shown.
+   *
+   * @generated from field: google.protobuf.Timestamp dismissed_at = 8;
+   */
+  dismissedAt?: Timestamp;
+
+  constructor(data?: PartialMessage<MissedCall>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.MissedCall";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MissedCall;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MissedCall;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MissedCall;
+
+  static equals(a: MissedCall | PlainMessage<MissedCall> | undefined, b: MissedCall | PlainMessage<MissedCall> | undefined): boolean;
+}
+
+/**
+ * CallbackRequest is a request from a device to be called back by a member of its tenant.
+ *
+ * @generated from message homecall.v1alpha.CallbackRequest
+ */
+export declare class CallbackRequest extends Message<CallbackRequest> {
+  /**
+   * The ID of the callback request.
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * The ID of the tenant of the device.
+   *
+   * @generated from field: string tenant_id = 2;
+   */
+  tenantId: string;
+
+  /**
+   * The ID of the device to call back.
+   *
+   * @generated from field: string device_id = 3;
+   */
+  deviceId: string;
+
+  /**
+   * The missed call the device requested the callback from, not set if it requested it without one.
+   *
+   * @generated from field: homecall.v1alpha.MissedCall missed_call = 4;
+   */
+  missedCall?: MissedCall;
+
+  /**
+   * When the callback was requested.
+   *
+   * @generated from field: google.protobuf.Timestamp created_at = 5;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * When the request was resolved, not set while it is open.
+   *
+   * @generated from field: google.protobuf.Timestamp resolved_at = 6;
+   */
+  resolvedAt?: Timestamp;
+
+  /**
+   * The ID of the tenant member that resolved the request.
+   *
+   * @generated from field: string resolved_by_member_id = 7;
+   */
+  resolvedByMemberId: string;
+
+  constructor(data?: PartialMessage<CallbackRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.CallbackRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CallbackRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CallbackRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CallbackRequest;
+
+  static equals(a: CallbackRequest | PlainMessage<CallbackRequest> | undefined, b: CallbackRequest | PlainMessage<CallbackRequest> | undefined): boolean;
+}
@@ -0,0 +1,57 @@
+// @generated by protoc-gen-es v1.8.0
+// @generated from file homecall/v1alpha/missed_call.proto (package homecall.v1alpha, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { proto3, Timestamp } from "@bufbuild/protobuf";
+
+/**
+ * MissedCallReason is why a device did not answer a call.
+ *
+ * @generated from enum homecall.v1alpha.MissedCallReason
+ */
+export const MissedCallReason = /*@__PURE__*/ proto3.makeEnum(
+  "homecall.v1alpha.MissedCallReason",
+  [
+    {no: 0, name: "MISSED_CALL_REASON_UNSPECIFIED", localName: "UNSPECIFIED"},
+    {no: 1, name: "MISSED_CALL_REASON_TIMEOUT", localName: "TIMEOUT"},
+    {no: 2, name: "MISSED_CALL_REASON_DECLINED", localName: "DECLINED"},
+  ],
+);
+
+/**
+ * MissedCall is a call a device did not answer.
+ *
+ * @generated from message homecall.v1alpha.MissedCall
+ */
+export const MissedCall = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.MissedCall",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "call_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "device_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "caller_member_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "caller_display_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "reason", kind: "enum", T: proto3.getEnumType(MissedCallReason) },
+    { no: 7, name: "created_at", kind: "message", T: Timestamp },
+    { no: 8, name: "dismissed_at", kind: "message", T: Timestamp },
+  ],
+);
+
+/**
+ * CallbackRequest is a request from a device to be called back by a member of its tenant.
+ *
+ * @generated from message homecall.v1alpha.CallbackRequest
+ */
+export const CallbackRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.CallbackRequest",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "tenant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "device_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "missed_call", kind: "message", T: MissedCall },
+    { no: 5, name: "created_at", kind: "message", T: Timestamp },
+    { no: 6, name: "resolved_at", kind: "message", T: Timestamp },
+    { no: 7, name: "resolved_by_member_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
@@ -3,8 +3,9 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { CreateDeviceRequest, CreateDeviceResponse, ListDevicesRequest, ListDevicesResponse, RemoveDeviceRequest, RemoveDeviceResponse, StartCallRequest, StartCallResponse, UpdateDeviceRequest, UpdateDeviceResponse, WaitForEnrollmentRequest, WaitForEnrollmentResponse } from "./office_service_pb.js";
+import { AddAlbumImageRequest, AddAlbumImageResponse, CancelScheduledCallRequest, CancelScheduledCallResponse, CreateAlbumRequest, CreateAlbumResponse, CreateCalendarFeedTokenRequest, CreateCalendarFeedTokenResponse, CreateCallScheduleRequest, CreateCallScheduleResponse, CreateDeviceGroupRequest, CreateDeviceGroupResponse, CreateDeviceRequest, CreateDeviceResponse, DeleteAlbumRequest, DeleteAlbumResponse, DisableDeviceRequest, DisableDeviceResponse, DownloadDeviceLogRequest, DownloadDeviceLogResponse, EnableDeviceRequest, EnableDeviceResponse, GetAllowedCallersRequest, GetAllowedCallersResponse, GetCallRequest, GetCallResponse, GetDeviceDiagnosticsRequest, GetDeviceDiagnosticsResponse, GetDeviceMessageImageRequest, GetDeviceMessageImageResponse, InviteToCallRequest, InviteToCallResponse, JoinCallRequest, JoinCallResponse, ListAlbumsRequest, ListAlbumsResponse, ListAllowedCallerEventsRequest, ListAllowedCallerEventsResponse, ListCalendarFeedTokensRequest, ListCalendarFeedTokensResponse, ListCallbackRequestsRequest, ListCallbackRequestsResponse, ListCallSchedulesRequest, ListCallSchedulesResponse, ListDeviceGroupsRequest, ListDeviceGroupsResponse, ListDeviceLogsRequest, ListDeviceLogsResponse, ListDeviceMessagesRequest, ListDeviceMessagesResponse, ListDevicesRequest, ListDevicesResponse, ListScheduledCallsRequest, ListScheduledCallsResponse, RegenerateEnrollmentKeyRequest, RegenerateEnrollmentKeyResponse, RemoveAlbumImageRequest, RemoveAlbumImageResponse, RemoveCallScheduleRequest, RemoveCallScheduleResponse, RemoveDeviceGroupRequest, RemoveDeviceGroupResponse, RemoveDeviceRequest, RemoveDeviceResponse, RequestDeviceLogsRequest, RequestDeviceLogsResponse, ResetDeviceEnrollmentRequest, ResetDeviceEnrollmentResponse, ResolveCallbackRequestRequest, ResolveCallbackRequestResponse, RevokeCalendarFeedTokenRequest, RevokeCalendarFeedTokenResponse, ScheduleCallRequest, ScheduleCallResponse, SendMessageRequest, SendMessageResponse, SetAllowedCallersRequest, SetAllowedCallersResponse, SetDeviceGroupRequest, SetDeviceGroupResponse, SkipCallScheduleOccurrenceRequest, SkipCallScheduleOccurrenceResponse, StartCallRequest, StartCallResponse, UpdateCallScheduleRequest, UpdateCallScheduleResponse, UpdateDeviceGroupRequest, UpdateDeviceGroupResponse, UpdateDeviceRequest, UpdateDeviceResponse, UpdateScheduledCallRequest, UpdateScheduledCallResponse, WaitForEnrollmentRequest, WaitForEnrollmentResponse, WatchCallInvitationsRequest, WatchCallInvitationsResponse, WatchDevicesRequest, WatchDevicesResponse, WatchMissedCallsRequest, WatchMissedCallsResponse, WatchScheduledCallsRequest, WatchScheduledCallsResponse } from "./office_service_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
+import { UpdateCallStateRequest, UpdateCallStateResponse } from "./call_pb.js";
 
 /**
  * The OfficeService provides methods for managing devices and calls.
@@ -60,7 +61,8 @@ export declare const OfficeService: {
       readonly kind: MethodKind.Unary,
     },
     /**
-     * StartCall starts a call with the specified device.
+     * StartCall starts a call with one or more devices of a tenant.
+     * Other members of the tenant can be invited to the call, they are notified through WatchCallInvitations.
      *
      * @generated from rpc homecall.v1alpha.OfficeService.StartCall
      */
@@ -70,6 +72,63 @@ export declare const OfficeService: {
       readonly O: typeof StartCallResponse,
       readonly kind: MethodKind.Unary,
     },
+    /**
+     * GetCall returns a call and the join state of its participants.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.GetCall
+     */
+    readonly getCall: {
+      readonly name: "GetCall",
+      readonly I: typeof GetCallRequest,
+      readonly O: typeof GetCallResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * InviteToCall invites another member of the tenant to an ongoing call.
+     * Only participants of the call can invite, the invited member is notified through WatchCallInvitations.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.InviteToCall
+     */
+    readonly inviteToCall: {
+      readonly name: "InviteToCall",
+      readonly I: typeof InviteToCallRequest,
+      readonly O: typeof InviteToCallResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * JoinCall returns the details the caller needs to join a call it participates in.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.JoinCall
+     */
+    readonly joinCall: {
+      readonly name: "JoinCall",
+      readonly I: typeof JoinCallRequest,
+      readonly O: typeof JoinCallResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * UpdateCallState is called when the caller joins, leaves or declines a call.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.UpdateCallState
+     */
+    readonly updateCallState: {
+      readonly name: "UpdateCallState",
+      readonly I: typeof UpdateCallStateRequest,
+      readonly O: typeof UpdateCallStateResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * WatchCallInvitations streams the calls of a tenant the caller is invited to,
+     * starting with the recent invitations the caller has not responded to.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.WatchCallInvitations
+     */
+    readonly watchCallInvitations: {
+      readonly name: "WatchCallInvitations",
+      readonly I: typeof WatchCallInvitationsRequest,
+      readonly O: typeof WatchCallInvitationsResponse,
+      readonly kind: MethodKind.ServerStreaming,
+    },
     /**
      * WaitForEnrollment is called to get notified about device enrollment.
      * This call is long-lived and will return when device is enrolled.
@@ -82,5 +141,488 @@ export declare const OfficeService: {
       readonly O: typeof WaitForEnrollmentResponse,
       readonly kind: MethodKind.ServerStreaming,
     },
+    /**
+     * WatchDevices is called to get live updates about the devices of a tenant.
+     * The first response contains a snapshot of all devices, followed by a response for every change.
+     * The stream is closed if the caller loses access to the tenant.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.WatchDevices
+     */
+    readonly watchDevices: {
+      readonly name: "WatchDevices",
+      readonly I: typeof WatchDevicesRequest,
+      readonly O: typeof WatchDevicesResponse,
+      readonly kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * GetDeviceDiagnostics returns the latest diagnostics reports of a device, newest first.
+     * Only available to tenant admins.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.GetDeviceDiagnostics
+     */
+    readonly getDeviceDiagnostics: {
+      readonly name: "GetDeviceDiagnostics",
+      readonly I: typeof GetDeviceDiagnosticsRequest,
+      readonly O: typeof GetDeviceDiagnosticsResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * RequestDeviceLogs asks a device to upload its logs.
+     * The device is notified with a push notification and uploads its logs in the background.
+     * Only available to tenant admins.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.RequestDeviceLogs
+     */
+    readonly requestDeviceLogs: {
+      readonly name: "RequestDeviceLogs",
+      readonly I: typeof RequestDeviceLogsRequest,
+      readonly O: typeof RequestDeviceLogsResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * ListDeviceLogs returns the requested and uploaded logs of a device, newest first.
+     * Only available to tenant admins.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.ListDeviceLogs
+     */
+    readonly listDeviceLogs: {
+      readonly name: "ListDeviceLogs",
+      readonly I: typeof ListDeviceLogsRequest,
+      readonly O: typeof ListDeviceLogsResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * DownloadDeviceLog returns the content of an uploaded log.
+     * Only available to tenant admins.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.DownloadDeviceLog
+     */
+    readonly downloadDeviceLog: {
+      readonly name: "DownloadDeviceLog",
+      readonly I: typeof DownloadDeviceLogRequest,
+      readonly O: typeof DownloadDeviceLogResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * RegenerateEnrollmentKey replaces the enrollment key, pairing code and QR payload of a device
+     * that is not enrolled yet.
+     * The previous credentials stop working immediately.
+     * Only available to tenant admins.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.RegenerateEnrollmentKey
+     */
+    readonly regenerateEnrollmentKey: {
+      readonly name: "RegenerateEnrollmentKey",
+      readonly I: typeof RegenerateEnrollmentKeyRequest,
+      readonly O: typeof RegenerateEnrollmentKeyResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * ResetDeviceEnrollment issues a new enrollment for an existing device, e.g. after it was factory reset.
+     * The device keeps its ID and history, but its current key stops working immediately.
+     * Only available to tenant admins.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.ResetDeviceEnrollment
+     */
+    readonly resetDeviceEnrollment: {
+      readonly name: "ResetDeviceEnrollment",
+      readonly I: typeof ResetDeviceEnrollmentRequest,
+      readonly O: typeof ResetDeviceEnrollmentResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * DisableDevice stops a device from authenticating and receiving calls, without removing it or its history.
+     * Only available to tenant admins.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.DisableDevice
+     */
+    readonly disableDevice: {
+      readonly name: "DisableDevice",
+      readonly I: typeof DisableDeviceRequest,
+      readonly O: typeof DisableDeviceResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * EnableDevice allows a disabled device to authenticate and receive calls again.
+     * Only available to tenant admins.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.EnableDevice
+     */
+    readonly enableDevice: {
+      readonly name: "EnableDevice",
+      readonly I: typeof EnableDeviceRequest,
+      readonly O: typeof EnableDeviceResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * CreateDeviceGroup creates a group of devices within a tenant, e.g. a household or a ward.
+     * Only available to tenant admins.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.CreateDeviceGroup
+     */
+    readonly createDeviceGroup: {
+      readonly name: "CreateDeviceGroup",
+      readonly I: typeof CreateDeviceGroupRequest,
+      readonly O: typeof CreateDeviceGroupResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * ListDeviceGroups returns the device groups of a tenant.
+     * Members that are scoped to device groups only see their groups.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.ListDeviceGroups
+     */
+    readonly listDeviceGroups: {
+      readonly name: "ListDeviceGroups",
+      readonly I: typeof ListDeviceGroupsRequest,
+      readonly O: typeof ListDeviceGroupsResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * UpdateDeviceGroup renames a device group.
+     * Only available to tenant admins.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.UpdateDeviceGroup
+     */
+    readonly updateDeviceGroup: {
+      readonly name: "UpdateDeviceGroup",
+      readonly I: typeof UpdateDeviceGroupRequest,
+      readonly O: typeof UpdateDeviceGroupResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * RemoveDeviceGroup removes a device group, its devices are kept without a group.
+     * Only available to tenant admins.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.RemoveDeviceGroup
+     */
+    readonly removeDeviceGroup: {
+      readonly name: "RemoveDeviceGroup",
+      readonly I: typeof RemoveDeviceGroupRequest,
+      readonly O: typeof RemoveDeviceGroupResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * SetDeviceGroup assigns a device to a group, or removes it from its group.
+     * Only available to tenant admins.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.SetDeviceGroup
+     */
+    readonly setDeviceGroup: {
+      readonly name: "SetDeviceGroup",
+      readonly I: typeof SetDeviceGroupRequest,
+      readonly O: typeof SetDeviceGroupResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * ScheduleCall plans a call with a device.
+     * The device and the assigned member are reminded shortly before the call starts.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.ScheduleCall
+     */
+    readonly scheduleCall: {
+      readonly name: "ScheduleCall",
+      readonly I: typeof ScheduleCallRequest,
+      readonly O: typeof ScheduleCallResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * ListScheduledCalls returns the scheduled calls of a tenant within a time range.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.ListScheduledCalls
+     */
+    readonly listScheduledCalls: {
+      readonly name: "ListScheduledCalls",
+      readonly I: typeof ListScheduledCallsRequest,
+      readonly O: typeof ListScheduledCallsResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * UpdateScheduledCall changes the time, duration or assigned member of a scheduled call.
+     * Only calls that have not taken place yet can be updated.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.UpdateScheduledCall
+     */
+    readonly updateScheduledCall: {
+      readonly name: "UpdateScheduledCall",
+      readonly I: typeof UpdateScheduledCallRequest,
+      readonly O: typeof UpdateScheduledCallResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * CancelScheduledCall cancels a scheduled call that has not taken place yet.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.CancelScheduledCall
+     */
+    readonly cancelScheduledCall: {
+      readonly name: "CancelScheduledCall",
+      readonly I: typeof CancelScheduledCallRequest,
+      readonly O: typeof CancelScheduledCallResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * WatchScheduledCalls streams reminders and missed slots of the scheduled calls
+     * of a tenant the caller is assigned to.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.WatchScheduledCalls
+     */
+    readonly watchScheduledCalls: {
+      readonly name: "WatchScheduledCalls",
+      readonly I: typeof WatchScheduledCallsRequest,
+      readonly O: typeof WatchScheduledCallsResponse,
+      readonly kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * CreateCallSchedule creates a recurring call with a device.
+     * Its upcoming occurrences are returned by ListScheduledCalls.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.CreateCallSchedule
+     */
+    readonly createCallSchedule: {
+      readonly name: "CreateCallSchedule",
+      readonly I: typeof CreateCallScheduleRequest,
+      readonly O: typeof CreateCallScheduleResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * ListCallSchedules returns the call schedules of a tenant.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.ListCallSchedules
+     */
+    readonly listCallSchedules: {
+      readonly name: "ListCallSchedules",
+      readonly I: typeof ListCallSchedulesRequest,
+      readonly O: typeof ListCallSchedulesResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * UpdateCallSchedule changes a call schedule, its upcoming occurrences are replaced.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.UpdateCallSchedule
+     */
+    readonly updateCallSchedule: {
+      readonly name: "UpdateCallSchedule",
+      readonly I: typeof UpdateCallScheduleRequest,
+      readonly O: typeof UpdateCallScheduleResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * RemoveCallSchedule removes a call schedule and its upcoming occurrences.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.RemoveCallSchedule
+     */
+    readonly removeCallSchedule: {
+      readonly name: "RemoveCallSchedule",
+      readonly I: typeof RemoveCallScheduleRequest,
+      readonly O: typeof RemoveCallScheduleResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * SkipCallScheduleOccurrence skips a single occurrence of a call schedule.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.SkipCallScheduleOccurrence
+     */
+    readonly skipCallScheduleOccurrence: {
+      readonly name: "SkipCallScheduleOccurrence",
+      readonly I: typeof SkipCallScheduleOccurrenceRequest,
+      readonly O: typeof SkipCallScheduleOccurrenceResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * CreateCalendarFeedToken creates a token for an iCalendar feed of the scheduled calls
+     * of a tenant the caller is assigned to, which can be subscribed to in calendar apps.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.CreateCalendarFeedToken
+     */
+    readonly createCalendarFeedToken: {
+      readonly name: "CreateCalendarFeedToken",
+      readonly I: typeof CreateCalendarFeedTokenRequest,
+      readonly O: typeof CreateCalendarFeedTokenResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * ListCalendarFeedTokens returns the calendar feed tokens of the caller in a tenant.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.ListCalendarFeedTokens
+     */
+    readonly listCalendarFeedTokens: {
+      readonly name: "ListCalendarFeedTokens",
+      readonly I: typeof ListCalendarFeedTokensRequest,
+      readonly O: typeof ListCalendarFeedTokensResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * RevokeCalendarFeedToken revokes a calendar feed token, the feed can no longer be fetched with it.
+     * Tokens can be revoked by their owner and by tenant admins.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.RevokeCalendarFeedToken
+     */
+    readonly revokeCalendarFeedToken: {
+      readonly name: "RevokeCalendarFeedToken",
+      readonly I: typeof RevokeCalendarFeedTokenRequest,
+      readonly O: typeof RevokeCalendarFeedTokenResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * GetAllowedCallers returns who may call a device.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.GetAllowedCallers
+     */
+    readonly getAllowedCallers: {
+      readonly name: "GetAllowedCallers",
+      readonly I: typeof GetAllowedCallersRequest,
+      readonly O: typeof GetAllowedCallersResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * SetAllowedCallers replaces who may call a device, only admins can change it.
+     * The changes are recorded and can be listed with ListAllowedCallerEvents.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.SetAllowedCallers
+     */
+    readonly setAllowedCallers: {
+      readonly name: "SetAllowedCallers",
+      readonly I: typeof SetAllowedCallersRequest,
+      readonly O: typeof SetAllowedCallersResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * ListAllowedCallerEvents returns the history of changes to who may call a device.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.ListAllowedCallerEvents
+     */
+    readonly listAllowedCallerEvents: {
+      readonly name: "ListAllowedCallerEvents",
+      readonly I: typeof ListAllowedCallerEventsRequest,
+      readonly O: typeof ListAllowedCallerEventsResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * WatchMissedCalls streams the calls started by the caller that a device did not answer,
+     * either because the call was declined or because the device did not join in time.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.WatchMissedCalls
+     */
+    readonly watchMissedCalls: {
+      readonly name: "WatchMissedCalls",
+      readonly I: typeof WatchMissedCallsRequest,
+      readonly O: typeof WatchMissedCallsResponse,
+      readonly kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * ListCallbackRequests returns the callback requests of the devices in a tenant the caller can access,
+     * oldest first.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.ListCallbackRequests
+     */
+    readonly listCallbackRequests: {
+      readonly name: "ListCallbackRequests",
+      readonly I: typeof ListCallbackRequestsRequest,
+      readonly O: typeof ListCallbackRequestsResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * ResolveCallbackRequest marks a callback request as handled.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.ResolveCallbackRequest
+     */
+    readonly resolveCallbackRequest: {
+      readonly name: "ResolveCallbackRequest",
+      readonly I: typeof ResolveCallbackRequestRequest,
+      readonly O: typeof ResolveCallbackRequestResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * SendMessage sends a text or photo message to a device.
+     * Texts are limited to 1000 characters and photos to the configured size,
+     * a device keeps its 20 latest messages.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.SendMessage
+     */
+    readonly sendMessage: {
+      readonly name: "SendMessage",
+      readonly I: typeof SendMessageRequest,
+      readonly O: typeof SendMessageResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * ListDeviceMessages returns the messages of a device, newest first.
+     * Messages the device has acknowledged have their read time set.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.ListDeviceMessages
+     */
+    readonly listDeviceMessages: {
+      readonly name: "ListDeviceMessages",
+      readonly I: typeof ListDeviceMessagesRequest,
+      readonly O: typeof ListDeviceMessagesResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * GetDeviceMessageImage returns the photo of a message sent to a device.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.GetDeviceMessageImage
+     */
+    readonly getDeviceMessageImage: {
+      readonly name: "GetDeviceMessageImage",
+      readonly I: typeof GetDeviceMessageImageRequest,
+      readonly O: typeof GetDeviceMessageImageResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * CreateAlbum creates a photo album shown on a device, or on every device in a group, while it is idle.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.CreateAlbum
+     */
+    readonly createAlbum: {
+      readonly name: "CreateAlbum",
+      readonly I: typeof CreateAlbumRequest,
+      readonly O: typeof CreateAlbumResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * ListAlbums returns the albums of a tenant the caller can access.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.ListAlbums
+     */
+    readonly listAlbums: {
+      readonly name: "ListAlbums",
+      readonly I: typeof ListAlbumsRequest,
+      readonly O: typeof ListAlbumsResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * DeleteAlbum removes an album and its images.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.DeleteAlbum
+     */
+    readonly deleteAlbum: {
+      readonly name: "DeleteAlbum",
+      readonly I: typeof DeleteAlbumRequest,
+      readonly O: typeof DeleteAlbumResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * AddAlbumImage uploads a photo to an album.
+     * Photos are limited to the configured size and an album holds at most 500 photos.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.AddAlbumImage
+     */
+    readonly addAlbumImage: {
+      readonly name: "AddAlbumImage",
+      readonly I: typeof AddAlbumImageRequest,
+      readonly O: typeof AddAlbumImageResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * RemoveAlbumImage removes a photo from its album.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.RemoveAlbumImage
+     */
+    readonly removeAlbumImage: {
+      readonly name: "RemoveAlbumImage",
+      readonly I: typeof RemoveAlbumImageRequest,
+      readonly O: typeof RemoveAlbumImageResponse,
+      readonly kind: MethodKind.Unary,
+    },
   }
 };
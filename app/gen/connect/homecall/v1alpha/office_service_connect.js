@@ -3,8 +3,9 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { CreateDeviceRequest, CreateDeviceResponse, ListDevicesRequest, ListDevicesResponse, RemoveDeviceRequest, RemoveDeviceResponse, StartCallRequest, StartCallResponse, UpdateDeviceRequest, UpdateDeviceResponse, WaitForEnrollmentRequest, WaitForEnrollmentResponse } from "./office_service_pb.js";
+import { AddAlbumImageRequest, AddAlbumImageResponse, CancelScheduledCallRequest, CancelScheduledCallResponse, CreateAlbumRequest, CreateAlbumResponse, CreateCalendarFeedTokenRequest, CreateCalendarFeedTokenResponse, CreateCallScheduleRequest, CreateCallScheduleResponse, CreateDeviceGroupRequest, CreateDeviceGroupResponse, CreateDeviceRequest, CreateDeviceResponse, DeleteAlbumRequest, DeleteAlbumResponse, DisableDeviceRequest, DisableDeviceResponse, DownloadDeviceLogRequest, DownloadDeviceLogResponse, EnableDeviceRequest, EnableDeviceResponse, GetAllowedCallersRequest, GetAllowedCallersResponse, GetCallRequest, GetCallResponse, GetDeviceDiagnosticsRequest, GetDeviceDiagnosticsResponse, GetDeviceMessageImageRequest, GetDeviceMessageImageResponse, InviteToCallRequest, InviteToCallResponse, JoinCallRequest, JoinCallResponse, ListAlbumsRequest, ListAlbumsResponse, ListAllowedCallerEventsRequest, ListAllowedCallerEventsResponse, ListCalendarFeedTokensRequest, ListCalendarFeedTokensResponse, ListCallbackRequestsRequest, ListCallbackRequestsResponse, ListCallSchedulesRequest, ListCallSchedulesResponse, ListDeviceGroupsRequest, ListDeviceGroupsResponse, ListDeviceLogsRequest, ListDeviceLogsResponse, ListDeviceMessagesRequest, ListDeviceMessagesResponse, ListDevicesRequest, ListDevicesResponse, ListScheduledCallsRequest, ListScheduledCallsResponse, RegenerateEnrollmentKeyRequest, RegenerateEnrollmentKeyResponse, RemoveAlbumImageRequest, RemoveAlbumImageResponse, RemoveCallScheduleRequest, RemoveCallScheduleResponse, RemoveDeviceGroupRequest, RemoveDeviceGroupResponse, RemoveDeviceRequest, RemoveDeviceResponse, RequestDeviceLogsRequest, RequestDeviceLogsResponse, ResetDeviceEnrollmentRequest, ResetDeviceEnrollmentResponse, ResolveCallbackRequestRequest, ResolveCallbackRequestResponse, RevokeCalendarFeedTokenRequest, RevokeCalendarFeedTokenResponse, ScheduleCallRequest, ScheduleCallResponse, SendMessageRequest, SendMessageResponse, SetAllowedCallersRequest, SetAllowedCallersResponse, SetDeviceGroupRequest, SetDeviceGroupResponse, SkipCallScheduleOccurrenceRequest, SkipCallScheduleOccurrenceResponse, StartCallRequest, StartCallResponse, UpdateCallScheduleRequest, UpdateCallScheduleResponse, UpdateDeviceGroupRequest, UpdateDeviceGroupResponse, UpdateDeviceRequest, UpdateDeviceResponse, UpdateScheduledCallRequest, UpdateScheduledCallResponse, WaitForEnrollmentRequest, WaitForEnrollmentResponse, WatchCallInvitationsRequest, WatchCallInvitationsResponse, WatchDevicesRequest, WatchDevicesResponse, WatchMissedCallsRequest, WatchMissedCallsResponse, WatchScheduledCallsRequest, WatchScheduledCallsResponse } from "./office_service_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
+import { UpdateCallStateRequest, UpdateCallStateResponse } from "./call_pb.js";
 
 /**
  * The OfficeService provides methods for managing devices and calls.
@@ -60,7 +61,8 @@ export const OfficeService = {
       kind: MethodKind.Unary,
     },
     /**
-     * StartCall starts a call with the specified device.
+     * StartCall starts a call with one or more devices of a tenant.
+     * Other members of the tenant can be invited to the call, they are notified through WatchCallInvitations.
      *
      * @generated from rpc homecall.v1alpha.OfficeService.StartCall
      */
@@ -70,6 +72,63 @@ export const OfficeService = {
       O: StartCallResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * GetCall returns a call and the join state of its participants.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.GetCall
+     */
+    getCall: {
+      name: "GetCall",
+      I: GetCallRequest,
+      O: GetCallResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * InviteToCall invites another member of the tenant to an ongoing call.
+     * Only participants of the call can invite, the invited member is notified through WatchCallInvitations.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.InviteToCall
+     */
+    inviteToCall: {
+      name: "InviteToCall",
+      I: InviteToCallRequest,
+      O: InviteToCallResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * JoinCall returns the details the caller needs to join a call it participates in.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.JoinCall
+     */
+    joinCall: {
+      name: "JoinCall",
+      I: JoinCallRequest,
+      O: JoinCallResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * UpdateCallState is called when the caller joins, leaves or declines a call.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.UpdateCallState
+     */
+    updateCallState: {
+      name: "UpdateCallState",
+      I: UpdateCallStateRequest,
+      O: UpdateCallStateResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * WatchCallInvitations streams the calls of a tenant the caller is invited to,
+     * starting with the recent invitations the caller has not responded to.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.WatchCallInvitations
+     */
+    watchCallInvitations: {
+      name: "WatchCallInvitations",
+      I: WatchCallInvitationsRequest,
+      O: WatchCallInvitationsResponse,
+      kind: MethodKind.ServerStreaming,
+    },
     /**
      * WaitForEnrollment is called to get notified about device enrollment.
      * This call is long-lived and will return when device is enrolled.
@@ -82,5 +141,488 @@ export const OfficeService = {
       O: WaitForEnrollmentResponse,
       kind: MethodKind.ServerStreaming,
     },
+    /**
+     * WatchDevices is called to get live updates about the devices of a tenant.
+     * The first response contains a snapshot of all devices, followed by a response for every change.
+     * The stream is closed if the caller loses access to the tenant.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.WatchDevices
+     */
+    watchDevices: {
+      name: "WatchDevices",
+      I: WatchDevicesRequest,
+      O: WatchDevicesResponse,
+      kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * GetDeviceDiagnostics returns the latest diagnostics reports of a device, newest first.
+     * Only available to tenant admins.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.GetDeviceDiagnostics
+     */
+    getDeviceDiagnostics: {
+      name: "GetDeviceDiagnostics",
+      I: GetDeviceDiagnosticsRequest,
+      O: GetDeviceDiagnosticsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * RequestDeviceLogs asks a device to upload its logs.
+     * The device is notified with a push notification and uploads its logs in the background.
+     * Only available to tenant admins.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.RequestDeviceLogs
+     */
+    requestDeviceLogs: {
+      name: "RequestDeviceLogs",
+      I: RequestDeviceLogsRequest,
+      O: RequestDeviceLogsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListDeviceLogs returns the requested and uploaded logs of a device, newest first.
+     * Only available to tenant admins.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.ListDeviceLogs
+     */
+    listDeviceLogs: {
+      name: "ListDeviceLogs",
+      I: ListDeviceLogsRequest,
+      O: ListDeviceLogsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * DownloadDeviceLog returns the content of an uploaded log.
+     * Only available to tenant admins.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.DownloadDeviceLog
+     */
+    downloadDeviceLog: {
+      name: "DownloadDeviceLog",
+      I: DownloadDeviceLogRequest,
+      O: DownloadDeviceLogResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * RegenerateEnrollmentKey replaces the enrollment key, pairing code and QR payload of a device
+     * that is not enrolled yet.
+     * The previous credentials stop working immediately.
+     * Only available to tenant admins.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.RegenerateEnrollmentKey
+     */
+    regenerateEnrollmentKey: {
+      name: "RegenerateEnrollmentKey",
+      I: RegenerateEnrollmentKeyRequest,
+      O: RegenerateEnrollmentKeyResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ResetDeviceEnrollment issues a new enrollment for an existing device, e.g. after it was factory reset.
+     * The device keeps its ID and history, but its current key stops working immediately.
+     * Only available to tenant admins.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.ResetDeviceEnrollment
+     */
+    resetDeviceEnrollment: {
+      name: "ResetDeviceEnrollment",
+      I: ResetDeviceEnrollmentRequest,
+      O: ResetDeviceEnrollmentResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * DisableDevice stops a device from authenticating and receiving calls, without removing it or its history.
+     * Only available to tenant admins.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.DisableDevice
+     */
+    disableDevice: {
+      name: "DisableDevice",
+      I: DisableDeviceRequest,
+      O: DisableDeviceResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * EnableDevice allows a disabled device to authenticate and receive calls again.
+     * Only available to tenant admins.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.EnableDevice
+     */
+    enableDevice: {
+      name: "EnableDevice",
+      I: EnableDeviceRequest,
+      O: EnableDeviceResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * CreateDeviceGroup creates a group of devices within a tenant, e.g. a household or a ward.
+     * Only available to tenant admins.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.CreateDeviceGroup
+     */
+    createDeviceGroup: {
+      name: "CreateDeviceGroup",
+      I: CreateDeviceGroupRequest,
+      O: CreateDeviceGroupResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListDeviceGroups returns the device groups of a tenant.
+     * Members that are scoped to device groups only see their groups.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.ListDeviceGroups
+     */
+    listDeviceGroups: {
+      name: "ListDeviceGroups",
+      I: ListDeviceGroupsRequest,
+      O: ListDeviceGroupsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * UpdateDeviceGroup renames a device group.
+     * Only available to tenant admins.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.UpdateDeviceGroup
+     */
+    updateDeviceGroup: {
+      name: "UpdateDeviceGroup",
+      I: UpdateDeviceGroupRequest,
+      O: UpdateDeviceGroupResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * RemoveDeviceGroup removes a device group, its devices are kept without a group.
+     * Only available to tenant admins.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.RemoveDeviceGroup
+     */
+    removeDeviceGroup: {
+      name: "RemoveDeviceGroup",
+      I: RemoveDeviceGroupRequest,
+      O: RemoveDeviceGroupResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * SetDeviceGroup assigns a device to a group, or removes it from its group.
+     * Only available to tenant admins.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.SetDeviceGroup
+     */
+    setDeviceGroup: {
+      name: "SetDeviceGroup",
+      I: SetDeviceGroupRequest,
+      O: SetDeviceGroupResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ScheduleCall plans a call with a device.
+     * The device and the assigned member are reminded shortly before the call starts.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.ScheduleCall
+     */
+    scheduleCall: {
+      name: "ScheduleCall",
+      I: ScheduleCallRequest,
+      O: ScheduleCallResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListScheduledCalls returns the scheduled calls of a tenant within a time range.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.ListScheduledCalls
+     */
+    listScheduledCalls: {
+      name: "ListScheduledCalls",
+      I: ListScheduledCallsRequest,
+      O: ListScheduledCallsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * UpdateScheduledCall changes the time, duration or assigned member of a scheduled call.
+     * Only calls that have not taken place yet can be updated.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.UpdateScheduledCall
+     */
+    updateScheduledCall: {
+      name: "UpdateScheduledCall",
+      I: UpdateScheduledCallRequest,
+      O: UpdateScheduledCallResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * CancelScheduledCall cancels a scheduled call that has not taken place yet.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.CancelScheduledCall
+     */
+    cancelScheduledCall: {
+      name: "CancelScheduledCall",
+      I: CancelScheduledCallRequest,
+      O: CancelScheduledCallResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * WatchScheduledCalls streams reminders and missed slots of the scheduled calls
+     * of a tenant the caller is assigned to.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.WatchScheduledCalls
+     */
+    watchScheduledCalls: {
+      name: "WatchScheduledCalls",
+      I: WatchScheduledCallsRequest,
+      O: WatchScheduledCallsResponse,
+      kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * CreateCallSchedule creates a recurring call with a device.
+     * Its upcoming occurrences are returned by ListScheduledCalls.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.CreateCallSchedule
+     */
+    createCallSchedule: {
+      name: "CreateCallSchedule",
+      I: CreateCallScheduleRequest,
+      O: CreateCallScheduleResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListCallSchedules returns the call schedules of a tenant.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.ListCallSchedules
+     */
+    listCallSchedules: {
+      name: "ListCallSchedules",
+      I: ListCallSchedulesRequest,
+      O: ListCallSchedulesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * UpdateCallSchedule changes a call schedule, its upcoming occurrences are replaced.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.UpdateCallSchedule
+     */
+    updateCallSchedule: {
+      name: "UpdateCallSchedule",
+      I: UpdateCallScheduleRequest,
+      O: UpdateCallScheduleResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * RemoveCallSchedule removes a call schedule and its upcoming occurrences.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.RemoveCallSchedule
+     */
+    removeCallSchedule: {
+      name: "RemoveCallSchedule",
+      I: RemoveCallScheduleRequest,
+      O: RemoveCallScheduleResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * SkipCallScheduleOccurrence skips a single occurrence of a call schedule.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.SkipCallScheduleOccurrence
+     */
+    skipCallScheduleOccurrence: {
+      name: "SkipCallScheduleOccurrence",
+      I: SkipCallScheduleOccurrenceRequest,
+      O: SkipCallScheduleOccurrenceResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * CreateCalendarFeedToken creates a token for an iCalendar feed of the scheduled calls
+     * of a tenant the caller is assigned to, which can be subscribed to in calendar apps.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.CreateCalendarFeedToken
+     */
+    createCalendarFeedToken: {
+      name: "CreateCalendarFeedToken",
+      I: CreateCalendarFeedTokenRequest,
+      O: CreateCalendarFeedTokenResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListCalendarFeedTokens returns the calendar feed tokens of the caller in a tenant.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.ListCalendarFeedTokens
+     */
+    listCalendarFeedTokens: {
+      name: "ListCalendarFeedTokens",
+      I: ListCalendarFeedTokensRequest,
+      O: ListCalendarFeedTokensResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * RevokeCalendarFeedToken revokes a calendar feed token, the feed can no longer be fetched with it.
+     * Tokens can be revoked by their owner and by tenant admins.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.RevokeCalendarFeedToken
+     */
+    revokeCalendarFeedToken: {
+      name: "RevokeCalendarFeedToken",
+      I: RevokeCalendarFeedTokenRequest,
+      O: RevokeCalendarFeedTokenResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetAllowedCallers returns who may call a device.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.GetAllowedCallers
+     */
+    getAllowedCallers: {
+      name: "GetAllowedCallers",
+      I: GetAllowedCallersRequest,
+      O: GetAllowedCallersResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * SetAllowedCallers replaces who may call a device, only admins can change it.
+     * The changes are recorded and can be listed with ListAllowedCallerEvents.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.SetAllowedCallers
+     */
+    setAllowedCallers: {
+      name: "SetAllowedCallers",
+      I: SetAllowedCallersRequest,
+      O: SetAllowedCallersResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListAllowedCallerEvents returns the history of changes to who may call a device.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.ListAllowedCallerEvents
+     */
+    listAllowedCallerEvents: {
+      name: "ListAllowedCallerEvents",
+      I: ListAllowedCallerEventsRequest,
+      O: ListAllowedCallerEventsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * WatchMissedCalls streams the calls started by the caller that a device did not answer,
+     * either because the call was declined or because the device did not join in time.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.WatchMissedCalls
+     */
+    watchMissedCalls: {
+      name: "WatchMissedCalls",
+      I: WatchMissedCallsRequest,
+      O: WatchMissedCallsResponse,
+      kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * ListCallbackRequests returns the callback requests of the devices in a tenant the caller can access,
+     * oldest first.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.ListCallbackRequests
+     */
+    listCallbackRequests: {
+      name: "ListCallbackRequests",
+      I: ListCallbackRequestsRequest,
+      O: ListCallbackRequestsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ResolveCallbackRequest marks a callback request as handled.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.ResolveCallbackRequest
+     */
+    resolveCallbackRequest: {
+      name: "ResolveCallbackRequest",
+      I: ResolveCallbackRequestRequest,
+      O: ResolveCallbackRequestResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * SendMessage sends a text or photo message to a device.
+     * Texts are limited to 1000 characters and photos to the configured size,
+     * a device keeps its 20 latest messages.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.SendMessage
+     */
+    sendMessage: {
+      name: "SendMessage",
+      I: SendMessageRequest,
+      O: SendMessageResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListDeviceMessages returns the messages of a device, newest first.
+     * Messages the device has acknowledged have their read time set.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.ListDeviceMessages
+     */
+    listDeviceMessages: {
+      name: "ListDeviceMessages",
+      I: ListDeviceMessagesRequest,
+      O: ListDeviceMessagesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetDeviceMessageImage returns the photo of a message sent to a device.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.GetDeviceMessageImage
+     */
+    getDeviceMessageImage: {
+      name: "GetDeviceMessageImage",
+      I: GetDeviceMessageImageRequest,
+      O: GetDeviceMessageImageResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * CreateAlbum creates a photo album shown on a device, or on every device in a group, while it is idle.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.CreateAlbum
+     */
+    createAlbum: {
+      name: "CreateAlbum",
+      I: CreateAlbumRequest,
+      O: CreateAlbumResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListAlbums returns the albums of a tenant the caller can access.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.ListAlbums
+     */
+    listAlbums: {
+      name: "ListAlbums",
+      I: ListAlbumsRequest,
+      O: ListAlbumsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * DeleteAlbum removes an album and its images.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.DeleteAlbum
+     */
+    deleteAlbum: {
+      name: "DeleteAlbum",
+      I: DeleteAlbumRequest,
+      O: DeleteAlbumResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * AddAlbumImage uploads a photo to an album.
+     * Photos are limited to the configured size and an album holds at most 500 photos.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.AddAlbumImage
+     */
+    addAlbumImage: {
+      name: "AddAlbumImage",
+      I: AddAlbumImageRequest,
+      O: AddAlbumImageResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * RemoveAlbumImage removes a photo from its album.
+     *
+     * @generated from rpc homecall.v1alpha.OfficeService.RemoveAlbumImage
+     */
+    removeAlbumImage: {
+      name: "RemoveAlbumImage",
+      I: RemoveAlbumImageRequest,
+      O: RemoveAlbumImageResponse,
+      kind: MethodKind.Unary,
+    },
   }
 };
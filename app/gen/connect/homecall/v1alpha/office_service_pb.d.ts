@@ -3,9 +3,129 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage, Timestamp } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
-import type { DeviceSettings } from "./settings_pb.js";
+import type { AutoAnswerPolicy, DeviceSettings, QuietHours } from "./settings_pb.js";
+import type { Call, CallParticipant } from "./call_pb.js";
+import type { DeviceDiagnosticsReport } from "./diagnostics_pb.js";
+import type { DeviceState } from "./device_state_pb.js";
+import type { CalendarFeedToken, CallSchedule, ScheduledCall, ScheduledCallEventType } from "./scheduled_call_pb.js";
+import type { AllowedCaller, AllowedCallerEvent } from "./allowed_caller_pb.js";
+import type { CallbackRequest, MissedCall } from "./missed_call_pb.js";
+import type { DeviceMessage, MessageImage } from "./device_message_pb.js";
+import type { Album, AlbumImage } from "./album_pb.js";
+
+/**
+ * DeviceEventType represents the type of change to a device.
+ *
+ * @generated from enum homecall.v1alpha.DeviceEventType
+ */
+export declare enum DeviceEventType {
+  /**
+   * The event type is unknown.
+   *
+   * @generated from enum value: DEVICE_EVENT_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The device was added to the tenant.
+   *
+   * @generated from enum value: DEVICE_EVENT_TYPE_ADDED = 1;
+   */
+  ADDED = 1,
+
+  /**
+   * The device was removed from the tenant.
+   *
+   * @generated from enum value: DEVICE_EVENT_TYPE_REMOVED = 2;
+   */
+  REMOVED = 2,
+
+  /**
+   * The device was renamed.
+   *
+   * @generated from enum value: DEVICE_EVENT_TYPE_RENAMED = 3;
+   */
+  RENAMED = 3,
+
+  /**
+   * The device was enrolled.
+   *
+   * @generated from enum value: DEVICE_EVENT_TYPE_ENROLLED = 4;
+   */
+  ENROLLED = 4,
+
+  /**
+   * The device came online.
+   *
+   * @generated from enum value: DEVICE_EVENT_TYPE_ONLINE = 5;
+   */
+  ONLINE = 5,
+
+  /**
+   * The device went offline.
+   *
+   * @generated from enum value: DEVICE_EVENT_TYPE_OFFLINE = 6;
+   */
+  OFFLINE = 6,
+
+  /**
+   * The enrollment of the device was reset, it has to enroll again.
+   *
+   * @generated from enum value: DEVICE_EVENT_TYPE_ENROLLMENT_RESET = 7;
+   */
+  ENROLLMENT_RESET = 7,
+
+  /**
+   * The device was disabled.
+   *
+   * @generated from enum value: DEVICE_EVENT_TYPE_DISABLED = 8;
+   */
+  DISABLED = 8,
+
+  /**
+   * The device was enabled again.
+   *
+   * @generated from enum value: DEVICE_EVENT_TYPE_ENABLED = 9;
+   */
+  ENABLED = 9,
+
+  /**
+   * The device was moved to another group, or out of its group.
+   *
+   * @generated from enum value: DEVICE_EVENT_TYPE_GROUP_CHANGED = 10;
+   */
+  GROUP_CHANGED = 10,
+}
+
+/**
+ * DeviceLogStatus represents the status of a requested log.
+ *
+ * @generated from enum homecall.v1alpha.DeviceLogStatus
+ */
+export declare enum DeviceLogStatus {
+  /**
+   * The status is unknown.
+   *
+   * @generated from enum value: DEVICE_LOG_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The log has been requested but not uploaded yet.
+   *
+   * @generated from enum value: DEVICE_LOG_STATUS_REQUESTED = 1;
+   */
+  REQUESTED = 1,
+
+  /**
+   * The log has been uploaded.
+   *
+   * @generated from enum value: DEVICE_LOG_STATUS_UPLOADED = 2;
+   */
+  UPLOADED = 2,
+}
 
 /**
  * DeviceSettings contains the settings for a device.
@@ -34,6 +154,21 @@ export declare class CreateDeviceRequest extends Message<CreateDeviceRequest> {
    */
   tenantId: string;
 
+  /**
+   * The ID of the group to add the device to, optional.
+   *
+   * @generated from field: string group_id = 4;
+   */
+  groupId: string;
+
+  /**
+   * The IANA time zone of the device, e.g. "Europe/Stockholm".
+   * If empty, UTC is used.
+   *
+   * @generated from field: string time_zone = 5;
+   */
+  timeZone: string;
+
   constructor(data?: PartialMessage<CreateDeviceRequest>);
 
   static readonly runtime: typeof proto3;
@@ -90,6 +225,13 @@ export declare class ListDevicesRequest extends Message<ListDevicesRequest> {
    */
   tenantId: string;
 
+  /**
+   * Only return the devices in this group, optional.
+   *
+   * @generated from field: string group_id = 2;
+   */
+  groupId: string;
+
   constructor(data?: PartialMessage<ListDevicesRequest>);
 
   static readonly runtime: typeof proto3;
@@ -135,17 +277,42 @@ export declare class ListDevicesResponse extends Message<ListDevicesResponse> {
 
 /**
  * StartCallRequest is the request for the StartCall method.
+ * At least one device must be set, all devices must belong to the same tenant.
  *
  * @generated from message homecall.v1alpha.StartCallRequest
  */
 export declare class StartCallRequest extends Message<StartCallRequest> {
   /**
    * The ID of the device to call.
+   * Kept for clients calling a single device, it is combined with device_ids.
    *
    * @generated from field: string device_id = 1;
    */
   deviceId: string;
 
+  /**
+   * The IDs of the devices to call.
+   *
+   * @generated from field: repeated string device_ids = 2;
+   */
+  deviceIds: string[];
+
+  /**
+   * The IDs of the tenant members to invite, besides the caller.
+   *
+   * @generated from field: repeated string member_ids = 3;
+   */
+  memberIds: string[];
+
+  /**
+   * Whether to call devices that are in their quiet hours.
+   * Without it, calls to devices in their quiet hours are refused.
+   * The override is recorded on the participants of those devices.
+   *
+   * @generated from field: bool override_quiet_hours = 4;
+   */
+  overrideQuietHours: boolean;
+
   constructor(data?: PartialMessage<StartCallRequest>);
 
   static readonly runtime: typeof proto3;
@@ -189,6 +356,29 @@ export declare class StartCallResponse extends Message<StartCallResponse> {
    */
   jitsiJwt: string;
 
+  /**
+   * The call with all its participants.
+   *
+   * @generated from field: homecall.v1alpha.Call call = 4;
+   */
+  call?: Call;
+
+  /**
+   * The IDs of the devices that could not be notified about the call.
+   * The call is started anyway and the devices stay invited.
+   *
+   * @generated from field: repeated string unreachable_device_ids = 5;
+   */
+  unreachableDeviceIds: string[];
+
+  /**
+   * The IDs of the devices that were left out of the call because they are disabled.
+   * The call is only refused if every device is disabled.
+   *
+   * @generated from field: repeated string disabled_device_ids = 6;
+   */
+  disabledDeviceIds: string[];
+
   constructor(data?: PartialMessage<StartCallResponse>);
 
   static readonly runtime: typeof proto3;
@@ -205,235 +395,3369 @@ export declare class StartCallResponse extends Message<StartCallResponse> {
 }
 
 /**
- * RemoveDeviceRequest is the request for the RemoveDevice method.
+ * GetCallRequest is the request for the GetCall method.
  *
- * @generated from message homecall.v1alpha.RemoveDeviceRequest
+ * @generated from message homecall.v1alpha.GetCallRequest
  */
-export declare class RemoveDeviceRequest extends Message<RemoveDeviceRequest> {
+export declare class GetCallRequest extends Message<GetCallRequest> {
   /**
-   * The ID of the device to remove.
+   * The ID of the call.
    *
-   * @generated from field: string device_id = 1;
+   * @generated from field: string call_id = 1;
    */
-  deviceId: string;
+  callId: string;
 
-  constructor(data?: PartialMessage<RemoveDeviceRequest>);
+  constructor(data?: PartialMessage<GetCallRequest>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "homecall.v1alpha.RemoveDeviceRequest";
+  static readonly typeName = "homecall.v1alpha.GetCallRequest";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveDeviceRequest;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetCallRequest;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveDeviceRequest;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetCallRequest;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveDeviceRequest;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetCallRequest;
 
-  static equals(a: RemoveDeviceRequest | PlainMessage<RemoveDeviceRequest> | undefined, b: RemoveDeviceRequest | PlainMessage<RemoveDeviceRequest> | undefined): boolean;
+  static equals(a: GetCallRequest | PlainMessage<GetCallRequest> | undefined, b: GetCallRequest | PlainMessage<GetCallRequest> | undefined): boolean;
 }
 
 /**
- * RemoveDeviceResponse is the response for the RemoveDevice method.
+ * GetCallResponse is the response for the GetCall method.
  *
- * @generated from message homecall.v1alpha.RemoveDeviceResponse
+ * @generated from message homecall.v1alpha.GetCallResponse
  */
-export declare class RemoveDeviceResponse extends Message<RemoveDeviceResponse> {
+export declare class GetCallResponse extends Message<GetCallResponse> {
   /**
-   * The device that was removed.
+   * The call.
    *
-   * @generated from field: homecall.v1alpha.Device device = 1;
+   * @generated from field: homecall.v1alpha.Call call = 1;
    */
-  device?: Device;
+  call?: Call;
 
-  constructor(data?: PartialMessage<RemoveDeviceResponse>);
+  constructor(data?: PartialMessage<GetCallResponse>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "homecall.v1alpha.RemoveDeviceResponse";
+  static readonly typeName = "homecall.v1alpha.GetCallResponse";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveDeviceResponse;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetCallResponse;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveDeviceResponse;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetCallResponse;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveDeviceResponse;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetCallResponse;
 
-  static equals(a: RemoveDeviceResponse | PlainMessage<RemoveDeviceResponse> | undefined, b: RemoveDeviceResponse | PlainMessage<RemoveDeviceResponse> | undefined): boolean;
+  static equals(a: GetCallResponse | PlainMessage<GetCallResponse> | undefined, b: GetCallResponse | PlainMessage<GetCallResponse> | undefined): boolean;
 }
 
 /**
- * UpdateDeviceRequest is the request for the UpdateDevice method.
+ * InviteToCallRequest is the request for the InviteToCall method.
  *
- * @generated from message homecall.v1alpha.UpdateDeviceRequest
+ * @generated from message homecall.v1alpha.InviteToCallRequest
  */
-export declare class UpdateDeviceRequest extends Message<UpdateDeviceRequest> {
+export declare class InviteToCallRequest extends Message<InviteToCallRequest> {
   /**
-   * The ID of the device to update.
+   * The ID of the call.
    *
-   * @generated from field: string device_id = 1;
+   * @generated from field: string call_id = 1;
    */
-  deviceId: string;
+  callId: string;
 
   /**
-   * The new name of the device.
+   * The ID of the tenant member to invite.
    *
-   * @generated from field: string name = 2;
+   * @generated from field: string member_id = 2;
    */
-  name: string;
+  memberId: string;
 
-  constructor(data?: PartialMessage<UpdateDeviceRequest>);
+  constructor(data?: PartialMessage<InviteToCallRequest>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "homecall.v1alpha.UpdateDeviceRequest";
+  static readonly typeName = "homecall.v1alpha.InviteToCallRequest";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateDeviceRequest;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): InviteToCallRequest;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateDeviceRequest;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): InviteToCallRequest;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateDeviceRequest;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): InviteToCallRequest;
 
-  static equals(a: UpdateDeviceRequest | PlainMessage<UpdateDeviceRequest> | undefined, b: UpdateDeviceRequest | PlainMessage<UpdateDeviceRequest> | undefined): boolean;
+  static equals(a: InviteToCallRequest | PlainMessage<InviteToCallRequest> | undefined, b: InviteToCallRequest | PlainMessage<InviteToCallRequest> | undefined): boolean;
 }
 
 /**
- * UpdateDeviceResponse is the response for the UpdateDevice method.
+ * InviteToCallResponse is the response for the InviteToCall method.
  *
- * @generated from message homecall.v1alpha.UpdateDeviceResponse
+ * @generated from message homecall.v1alpha.InviteToCallResponse
  */
-export declare class UpdateDeviceResponse extends Message<UpdateDeviceResponse> {
+export declare class InviteToCallResponse extends Message<InviteToCallResponse> {
   /**
-   * The updated device.
+   * The invited participant.
    *
-   * @generated from field: homecall.v1alpha.Device device = 1;
+   * @generated from field: homecall.v1alpha.CallParticipant participant = 1;
    */
-  device?: Device;
+  participant?: CallParticipant;
 
-  constructor(data?: PartialMessage<UpdateDeviceResponse>);
+  constructor(data?: PartialMessage<InviteToCallResponse>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "homecall.v1alpha.UpdateDeviceResponse";
+  static readonly typeName = "homecall.v1alpha.InviteToCallResponse";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateDeviceResponse;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): InviteToCallResponse;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateDeviceResponse;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): InviteToCallResponse;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateDeviceResponse;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): InviteToCallResponse;
 
-  static equals(a: UpdateDeviceResponse | PlainMessage<UpdateDeviceResponse> | undefined, b: UpdateDeviceResponse | PlainMessage<UpdateDeviceResponse> | undefined): boolean;
+  static equals(a: InviteToCallResponse | PlainMessage<InviteToCallResponse> | undefined, b: InviteToCallResponse | PlainMessage<InviteToCallResponse> | undefined): boolean;
 }
 
 /**
- * WaitForEnrollmentRequest is the request for the WaitForEnrollment method.
+ * JoinCallRequest is the request for the JoinCall method.
  *
- * @generated from message homecall.v1alpha.WaitForEnrollmentRequest
+ * @generated from message homecall.v1alpha.JoinCallRequest
  */
-export declare class WaitForEnrollmentRequest extends Message<WaitForEnrollmentRequest> {
+export declare class JoinCallRequest extends Message<JoinCallRequest> {
   /**
-   * The ID of the device to wait for.
+   * The ID of the call.
    *
-   * @generated from field: string device_id = 1;
+   * @generated from field: string call_id = 1;
    */
-  deviceId: string;
+  callId: string;
 
-  constructor(data?: PartialMessage<WaitForEnrollmentRequest>);
+  constructor(data?: PartialMessage<JoinCallRequest>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "homecall.v1alpha.WaitForEnrollmentRequest";
+  static readonly typeName = "homecall.v1alpha.JoinCallRequest";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WaitForEnrollmentRequest;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JoinCallRequest;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WaitForEnrollmentRequest;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JoinCallRequest;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WaitForEnrollmentRequest;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JoinCallRequest;
 
-  static equals(a: WaitForEnrollmentRequest | PlainMessage<WaitForEnrollmentRequest> | undefined, b: WaitForEnrollmentRequest | PlainMessage<WaitForEnrollmentRequest> | undefined): boolean;
+  static equals(a: JoinCallRequest | PlainMessage<JoinCallRequest> | undefined, b: JoinCallRequest | PlainMessage<JoinCallRequest> | undefined): boolean;
 }
 
 /**
- * WaitForEnrollmentResponse is the response for the WaitForEnrollment method.
+ * JoinCallResponse contains the details to join a call.
  *
- * @generated from message homecall.v1alpha.WaitForEnrollmentResponse
+ * @generated from message homecall.v1alpha.JoinCallResponse
  */
-export declare class WaitForEnrollmentResponse extends Message<WaitForEnrollmentResponse> {
+export declare class JoinCallResponse extends Message<JoinCallResponse> {
   /**
-   * The device that was enrolled.
+   * The ID of the call.
    *
-   * @generated from field: homecall.v1alpha.Device device = 1;
+   * @generated from field: string call_id = 1;
    */
-  device?: Device;
+  callId: string;
 
-  constructor(data?: PartialMessage<WaitForEnrollmentResponse>);
+  /**
+   * The ID of the Jitsi room.
+   *
+   * @generated from field: string jitsi_room_id = 2;
+   */
+  jitsiRoomId: string;
+
+  /**
+   * The JWT used to authenticate the caller in the Jitsi room.
+   *
+   * @generated from field: string jitsi_jwt = 3;
+   */
+  jitsiJwt: string;
+
+  constructor(data?: PartialMessage<JoinCallResponse>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "homecall.v1alpha.WaitForEnrollmentResponse";
+  static readonly typeName = "homecall.v1alpha.JoinCallResponse";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WaitForEnrollmentResponse;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JoinCallResponse;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WaitForEnrollmentResponse;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JoinCallResponse;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WaitForEnrollmentResponse;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JoinCallResponse;
 
-  static equals(a: WaitForEnrollmentResponse | PlainMessage<WaitForEnrollmentResponse> | undefined, b: WaitForEnrollmentResponse | PlainMessage<WaitForEnrollmentResponse> | undefined): boolean;
+  static equals(a: JoinCallResponse | PlainMessage<JoinCallResponse> | undefined, b: JoinCallResponse | PlainMessage<JoinCallResponse> | undefined): boolean;
 }
 
 /**
- * Device represents a device.
+ * WatchCallInvitationsRequest is the request for the WatchCallInvitations method.
  *
- * @generated from message homecall.v1alpha.Device
+ * @generated from message homecall.v1alpha.WatchCallInvitationsRequest
  */
-export declare class Device extends Message<Device> {
+export declare class WatchCallInvitationsRequest extends Message<WatchCallInvitationsRequest> {
   /**
-   * The ID of the device.
+   * The ID of the tenant to watch invitations in.
    *
-   * @generated from field: string id = 1;
+   * @generated from field: string tenant_id = 1;
    */
-  id: string;
+  tenantId: string;
 
-  /**
-   * The name of the device.
-   *
-   * @generated from field: string name = 2;
-   */
-  name: string;
+  constructor(data?: PartialMessage<WatchCallInvitationsRequest>);
 
-  /**
-   * The enrollment key for the device.
-   * This key is used to authenticate the device during the enrollment process.
-   * The key is only set if the device is not enrolled.
-   *
-   * @generated from field: string enrollment_key = 3;
-   */
-  enrollmentKey: string;
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.WatchCallInvitationsRequest";
+  static readonly fields: FieldList;
 
-  /**
-   * Whether the device is online or offline.
-   * Online devices are currently connected to the server.
-   *
-   * @generated from field: bool online = 4;
-   */
-  online: boolean;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchCallInvitationsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchCallInvitationsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchCallInvitationsRequest;
+
+  static equals(a: WatchCallInvitationsRequest | PlainMessage<WatchCallInvitationsRequest> | undefined, b: WatchCallInvitationsRequest | PlainMessage<WatchCallInvitationsRequest> | undefined): boolean;
+}
 
+/**
+ * WatchCallInvitationsResponse is sent for every call the caller is invited to.
+ *
+ * @generated from message homecall.v1alpha.WatchCallInvitationsResponse
+ */
+export declare class WatchCallInvitationsResponse extends Message<WatchCallInvitationsResponse> {
   /**
-   * The tenant ID the device belongs to.
+   * The call the caller is invited to.
    *
-   * @generated from field: string tenant_id = 5;
+   * @generated from field: homecall.v1alpha.Call call = 1;
    */
-  tenantId: string;
+  call?: Call;
 
-  constructor(data?: PartialMessage<Device>);
+  constructor(data?: PartialMessage<WatchCallInvitationsResponse>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "homecall.v1alpha.Device";
+  static readonly typeName = "homecall.v1alpha.WatchCallInvitationsResponse";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Device;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchCallInvitationsResponse;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Device;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchCallInvitationsResponse;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Device;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchCallInvitationsResponse;
 
-  static equals(a: Device | PlainMessage<Device> | undefined, b: Device | PlainMessage<Device> | undefined): boolean;
+  static equals(a: WatchCallInvitationsResponse | PlainMessage<WatchCallInvitationsResponse> | undefined, b: WatchCallInvitationsResponse | PlainMessage<WatchCallInvitationsResponse> | undefined): boolean;
+}
+
+/**
+ * RemoveDeviceRequest is the request for the RemoveDevice method.
+ *
+ * @generated from message homecall.v1alpha.RemoveDeviceRequest
+ */
+export declare class RemoveDeviceRequest extends Message<RemoveDeviceRequest> {
+  /**
+   * The ID of the device to remove.
+   *
+   * @generated from field: string device_id = 1;
+   */
+  deviceId: string;
+
+  constructor(data?: PartialMessage<RemoveDeviceRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.RemoveDeviceRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveDeviceRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveDeviceRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveDeviceRequest;
+
+  static equals(a: RemoveDeviceRequest | PlainMessage<RemoveDeviceRequest> | undefined, b: RemoveDeviceRequest | PlainMessage<RemoveDeviceRequest> | undefined): boolean;
+}
+
+/**
+ * RemoveDeviceResponse is the response for the RemoveDevice method.
+ *
+ * @generated from message homecall.v1alpha.RemoveDeviceResponse
+ */
+export declare class RemoveDeviceResponse extends Message<RemoveDeviceResponse> {
+  /**
+   * The device that was removed.
+   *
+   * @generated from field: homecall.v1alpha.Device device = 1;
+   */
+  device?: Device;
+
+  constructor(data?: PartialMessage<RemoveDeviceResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.RemoveDeviceResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveDeviceResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveDeviceResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveDeviceResponse;
+
+  static equals(a: RemoveDeviceResponse | PlainMessage<RemoveDeviceResponse> | undefined, b: RemoveDeviceResponse | PlainMessage<RemoveDeviceResponse> | undefined): boolean;
+}
+
+/**
+ * UpdateDeviceRequest is the request for the UpdateDevice method.
+ *
+ * @generated from message homecall.v1alpha.UpdateDeviceRequest
+ */
+export declare class UpdateDeviceRequest extends Message<UpdateDeviceRequest> {
+  /**
+   * The ID of the device to update.
+   *
+   * @generated from field: string device_id = 1;
+   */
+  deviceId: string;
+
+  /**
+   * The new name of the device.
+   * If empty, the name is not changed.
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * The new IANA time zone of the device, e.g. "Europe/Stockholm".
+   * If empty, the time zone is not changed.
+   *
+   * @generated from field: string time_zone = 3;
+   */
+  timeZone: string;
+
+  /**
+   * The new quiet hours of the device.
+   * If not set, the quiet hours are not changed.
+   *
+   * @generated from field: homecall.v1alpha.QuietHours quiet_hours = 4;
+   */
+  quietHours?: QuietHours;
+
+  /**
+   * Whether to remove the quiet hours of the device, quiet_hours must not be set.
+   *
+   * @generated from field: bool clear_quiet_hours = 5;
+   */
+  clearQuietHours: boolean;
+
+  /**
+   * The new auto-answer policy of the device.
+   * If not set, the policy is not changed.
+   *
+   * @generated from field: homecall.v1alpha.AutoAnswerPolicy auto_answer = 6;
+   */
+  autoAnswer?: AutoAnswerPolicy;
+
+  constructor(data?: PartialMessage<UpdateDeviceRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.UpdateDeviceRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateDeviceRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateDeviceRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateDeviceRequest;
+
+  static equals(a: UpdateDeviceRequest | PlainMessage<UpdateDeviceRequest> | undefined, b: UpdateDeviceRequest | PlainMessage<UpdateDeviceRequest> | undefined): boolean;
+}
+
+/**
+ * UpdateDeviceResponse is the response for the UpdateDevice method.
+ *
+ * @generated from message homecall.v1alpha.UpdateDeviceResponse
+ */
+export declare class UpdateDeviceResponse extends Message<UpdateDeviceResponse> {
+  /**
+   * The updated device.
+   *
+   * @generated from field: homecall.v1alpha.Device device = 1;
+   */
+  device?: Device;
+
+  constructor(data?: PartialMessage<UpdateDeviceResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.UpdateDeviceResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateDeviceResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateDeviceResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateDeviceResponse;
+
+  static equals(a: UpdateDeviceResponse | PlainMessage<UpdateDeviceResponse> | undefined, b: UpdateDeviceResponse | PlainMessage<UpdateDeviceResponse> | undefined): boolean;
+}
+
+/**
+ * WaitForEnrollmentRequest is the request for the WaitForEnrollment method.
+ *
+ * @generated from message homecall.v1alpha.WaitForEnrollmentRequest
+ */
+export declare class WaitForEnrollmentRequest extends Message<WaitForEnrollmentRequest> {
+  /**
+   * The ID of the device to wait for.
+   *
+   * @generated from field: string device_id = 1;
+   */
+  deviceId: string;
+
+  constructor(data?: PartialMessage<WaitForEnrollmentRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.WaitForEnrollmentRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WaitForEnrollmentRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WaitForEnrollmentRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WaitForEnrollmentRequest;
+
+  static equals(a: WaitForEnrollmentRequest | PlainMessage<WaitForEnrollmentRequest> | undefined, b: WaitForEnrollmentRequest | PlainMessage<WaitForEnrollmentRequest> | undefined): boolean;
+}
+
+/**
+ * WaitForEnrollmentResponse is the response for the WaitForEnrollment method.
+ *
+ * @generated from message homecall.v1alpha.WaitForEnrollmentResponse
+ */
+export declare class WaitForEnrollmentResponse extends Message<WaitForEnrollmentResponse> {
+  /**
+   * The device that was enrolled.
+   *
+   * @generated from field: homecall.v1alpha.Device device = 1;
+   */
+  device?: Device;
+
+  constructor(data?: PartialMessage<WaitForEnrollmentResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.WaitForEnrollmentResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WaitForEnrollmentResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WaitForEnrollmentResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WaitForEnrollmentResponse;
+
+  static equals(a: WaitForEnrollmentResponse | PlainMessage<WaitForEnrollmentResponse> | undefined, b: WaitForEnrollmentResponse | PlainMessage<WaitForEnrollmentResponse> | undefined): boolean;
+}
+
+/**
+ * WatchDevicesRequest is the request for the WatchDevices method.
+ *
+ * @generated from message homecall.v1alpha.WatchDevicesRequest
+ */
+export declare class WatchDevicesRequest extends Message<WatchDevicesRequest> {
+  /**
+   * The ID of the tenant to watch the devices of.
+   *
+   * @generated from field: string tenant_id = 1;
+   */
+  tenantId: string;
+
+  constructor(data?: PartialMessage<WatchDevicesRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.WatchDevicesRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchDevicesRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchDevicesRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchDevicesRequest;
+
+  static equals(a: WatchDevicesRequest | PlainMessage<WatchDevicesRequest> | undefined, b: WatchDevicesRequest | PlainMessage<WatchDevicesRequest> | undefined): boolean;
+}
+
+/**
+ * WatchDevicesResponse is the response for the WatchDevices method.
+ *
+ * @generated from message homecall.v1alpha.WatchDevicesResponse
+ */
+export declare class WatchDevicesResponse extends Message<WatchDevicesResponse> {
+  /**
+   * @generated from oneof homecall.v1alpha.WatchDevicesResponse.event
+   */
+  event: {
+    /**
+     * A snapshot of all devices of the tenant.
+     * Only sent as the first response.
+     *
+     * @generated from field: homecall.v1alpha.DeviceSnapshot snapshot = 1;
+     */
+    value: DeviceSnapshot;
+    case: "snapshot";
+  } | {
+    /**
+     * A change to a single device.
+     *
+     * @generated from field: homecall.v1alpha.DeviceEvent device_event = 2;
+     */
+    value: DeviceEvent;
+    case: "deviceEvent";
+  } | { case: undefined; value?: undefined };
+
+  constructor(data?: PartialMessage<WatchDevicesResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.WatchDevicesResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchDevicesResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchDevicesResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchDevicesResponse;
+
+  static equals(a: WatchDevicesResponse | PlainMessage<WatchDevicesResponse> | undefined, b: WatchDevicesResponse | PlainMessage<WatchDevicesResponse> | undefined): boolean;
+}
+
+/**
+ * DeviceSnapshot contains all devices of a tenant at a point in time.
+ *
+ * @generated from message homecall.v1alpha.DeviceSnapshot
+ */
+export declare class DeviceSnapshot extends Message<DeviceSnapshot> {
+  /**
+   * The list of devices.
+   *
+   * @generated from field: repeated homecall.v1alpha.Device devices = 1;
+   */
+  devices: Device[];
+
+  constructor(data?: PartialMessage<DeviceSnapshot>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.DeviceSnapshot";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeviceSnapshot;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeviceSnapshot;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeviceSnapshot;
+
+  static equals(a: DeviceSnapshot | PlainMessage<DeviceSnapshot> | undefined, b: DeviceSnapshot | PlainMessage<DeviceSnapshot> | undefined): boolean;
+}
+
+/**
+ * DeviceEvent describes a change to a device.
+ *
+ * @generated from message homecall.v1alpha.DeviceEvent
+ */
+export declare class DeviceEvent extends Message<DeviceEvent> {
+  /**
+   * The type of change.
+   *
+   * @generated from field: homecall.v1alpha.DeviceEventType type = 1;
+   */
+  type: DeviceEventType;
+
+  /**
+   * The device after the change.
+   * For removed devices this is the last known state of the device.
+   *
+   * @generated from field: homecall.v1alpha.Device device = 2;
+   */
+  device?: Device;
+
+  constructor(data?: PartialMessage<DeviceEvent>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.DeviceEvent";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeviceEvent;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeviceEvent;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeviceEvent;
+
+  static equals(a: DeviceEvent | PlainMessage<DeviceEvent> | undefined, b: DeviceEvent | PlainMessage<DeviceEvent> | undefined): boolean;
+}
+
+/**
+ * GetDeviceDiagnosticsRequest is the request for the GetDeviceDiagnostics method.
+ *
+ * @generated from message homecall.v1alpha.GetDeviceDiagnosticsRequest
+ */
+export declare class GetDeviceDiagnosticsRequest extends Message<GetDeviceDiagnosticsRequest> {
+  /**
+   * The ID of the device to get the diagnostics of.
+   *
+   * @generated from field: string device_id = 1;
+   */
+  deviceId: string;
+
+  /**
+   * The maximum number of reports to return.
+   * Defaults to 10 if not set, at most 100 are returned.
+   *
+   * @generated from field: int32 limit = 2;
+   */
+  limit: number;
+
+  constructor(data?: PartialMessage<GetDeviceDiagnosticsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.GetDeviceDiagnosticsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetDeviceDiagnosticsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetDeviceDiagnosticsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetDeviceDiagnosticsRequest;
+
+  static equals(a: GetDeviceDiagnosticsRequest | PlainMessage<GetDeviceDiagnosticsRequest> | undefined, b: GetDeviceDiagnosticsRequest | PlainMessage<GetDeviceDiagnosticsRequest> | undefined): boolean;
+}
+
+/**
+ * GetDeviceDiagnosticsResponse is the response for the GetDeviceDiagnostics method.
+ *
+ * @generated from message homecall.v1alpha.GetDeviceDiagnosticsResponse
+ */
+export declare class GetDeviceDiagnosticsResponse extends Message<GetDeviceDiagnosticsResponse> {
+  /**
+   * The diagnostics reports of the device, newest first.
+   *
+   * @generated from field: repeated homecall.v1alpha.DeviceDiagnosticsReport reports = 1;
+   */
+  reports: DeviceDiagnosticsReport[];
+
+  constructor(data?: PartialMessage<GetDeviceDiagnosticsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.GetDeviceDiagnosticsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetDeviceDiagnosticsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetDeviceDiagnosticsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetDeviceDiagnosticsResponse;
+
+  static equals(a: GetDeviceDiagnosticsResponse | PlainMessage<GetDeviceDiagnosticsResponse> | undefined, b: GetDeviceDiagnosticsResponse | PlainMessage<GetDeviceDiagnosticsResponse> | undefined): boolean;
+}
+
+/**
+ * RegenerateEnrollmentKeyRequest is the request for the RegenerateEnrollmentKey method.
+ *
+ * @generated from message homecall.v1alpha.RegenerateEnrollmentKeyRequest
+ */
+export declare class RegenerateEnrollmentKeyRequest extends Message<RegenerateEnrollmentKeyRequest> {
+  /**
+   * The ID of the device to regenerate the enrollment key for.
+   *
+   * @generated from field: string device_id = 1;
+   */
+  deviceId: string;
+
+  constructor(data?: PartialMessage<RegenerateEnrollmentKeyRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.RegenerateEnrollmentKeyRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RegenerateEnrollmentKeyRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RegenerateEnrollmentKeyRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RegenerateEnrollmentKeyRequest;
+
+  static equals(a: RegenerateEnrollmentKeyRequest | PlainMessage<RegenerateEnrollmentKeyRequest> | undefined, b: RegenerateEnrollmentKeyRequest | PlainMessage<RegenerateEnrollmentKeyRequest> | undefined): boolean;
+}
+
+/**
+ * RegenerateEnrollmentKeyResponse is the response for the RegenerateEnrollmentKey method.
+ *
+ * @generated from message homecall.v1alpha.RegenerateEnrollmentKeyResponse
+ */
+export declare class RegenerateEnrollmentKeyResponse extends Message<RegenerateEnrollmentKeyResponse> {
+  /**
+   * The device, with the new enrollment key, pairing code and QR payload set.
+   *
+   * @generated from field: homecall.v1alpha.Device device = 1;
+   */
+  device?: Device;
+
+  constructor(data?: PartialMessage<RegenerateEnrollmentKeyResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.RegenerateEnrollmentKeyResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RegenerateEnrollmentKeyResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RegenerateEnrollmentKeyResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RegenerateEnrollmentKeyResponse;
+
+  static equals(a: RegenerateEnrollmentKeyResponse | PlainMessage<RegenerateEnrollmentKeyResponse> | undefined, b: RegenerateEnrollmentKeyResponse | PlainMessage<RegenerateEnrollmentKeyResponse> | undefined): boolean;
+}
+
+/**
+ * ResetDeviceEnrollmentRequest is the request for the ResetDeviceEnrollment method.
+ *
+ * @generated from message homecall.v1alpha.ResetDeviceEnrollmentRequest
+ */
+export declare class ResetDeviceEnrollmentRequest extends Message<ResetDeviceEnrollmentRequest> {
+  /**
+   * The ID of the device to reset the enrollment of.
+   *
+   * @generated from field: string device_id = 1;
+   */
+  deviceId: string;
+
+  /**
+   * The settings the device receives when it enrolls again.
+   *
+   * @generated from field: homecall.v1alpha.DeviceSettings settings = 2;
+   */
+  settings?: DeviceSettings;
+
+  constructor(data?: PartialMessage<ResetDeviceEnrollmentRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.ResetDeviceEnrollmentRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResetDeviceEnrollmentRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResetDeviceEnrollmentRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResetDeviceEnrollmentRequest;
+
+  static equals(a: ResetDeviceEnrollmentRequest | PlainMessage<ResetDeviceEnrollmentRequest> | undefined, b: ResetDeviceEnrollmentRequest | PlainMessage<ResetDeviceEnrollmentRequest> | undefined): boolean;
+}
+
+/**
+ * ResetDeviceEnrollmentResponse is the response for the ResetDeviceEnrollment method.
+ *
+ * @generated from message homecall.v1alpha.ResetDeviceEnrollmentResponse
+ */
+export declare class ResetDeviceEnrollmentResponse extends Message<ResetDeviceEnrollmentResponse> {
+  /**
+   * The device, with the new enrollment key, pairing code and QR payload set.
+   *
+   * @generated from field: homecall.v1alpha.Device device = 1;
+   */
+  device?: Device;
+
+  constructor(data?: PartialMessage<ResetDeviceEnrollmentResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.ResetDeviceEnrollmentResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResetDeviceEnrollmentResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResetDeviceEnrollmentResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResetDeviceEnrollmentResponse;
+
+  static equals(a: ResetDeviceEnrollmentResponse | PlainMessage<ResetDeviceEnrollmentResponse> | undefined, b: ResetDeviceEnrollmentResponse | PlainMessage<ResetDeviceEnrollmentResponse> | undefined): boolean;
+}
+
+/**
+ * DisableDeviceRequest is the request for the DisableDevice method.
+ *
+ * @generated from message homecall.v1alpha.DisableDeviceRequest
+ */
+export declare class DisableDeviceRequest extends Message<DisableDeviceRequest> {
+  /**
+   * The ID of the device to disable.
+   *
+   * @generated from field: string device_id = 1;
+   */
+  deviceId: string;
+
+  constructor(data?: PartialMessage<DisableDeviceRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.DisableDeviceRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DisableDeviceRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DisableDeviceRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DisableDeviceRequest;
+
+  static equals(a: DisableDeviceRequest | PlainMessage<DisableDeviceRequest> | undefined, b: DisableDeviceRequest | PlainMessage<DisableDeviceRequest> | undefined): boolean;
+}
+
+/**
+ * DisableDeviceResponse is the response for the DisableDevice method.
+ *
+ * @generated from message homecall.v1alpha.DisableDeviceResponse
+ */
+export declare class DisableDeviceResponse extends Message<DisableDeviceResponse> {
+  /**
+   * The disabled device.
+   *
+   * @generated from field: homecall.v1alpha.Device device = 1;
+   */
+  device?: Device;
+
+  constructor(data?: PartialMessage<DisableDeviceResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.DisableDeviceResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DisableDeviceResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DisableDeviceResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DisableDeviceResponse;
+
+  static equals(a: DisableDeviceResponse | PlainMessage<DisableDeviceResponse> | undefined, b: DisableDeviceResponse | PlainMessage<DisableDeviceResponse> | undefined): boolean;
+}
+
+/**
+ * EnableDeviceRequest is the request for the EnableDevice method.
+ *
+ * @generated from message homecall.v1alpha.EnableDeviceRequest
+ */
+export declare class EnableDeviceRequest extends Message<EnableDeviceRequest> {
+  /**
+   * The ID of the device to enable.
+   *
+   * @generated from field: string device_id = 1;
+   */
+  deviceId: string;
+
+  constructor(data?: PartialMessage<EnableDeviceRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.EnableDeviceRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EnableDeviceRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EnableDeviceRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EnableDeviceRequest;
+
+  static equals(a: EnableDeviceRequest | PlainMessage<EnableDeviceRequest> | undefined, b: EnableDeviceRequest | PlainMessage<EnableDeviceRequest> | undefined): boolean;
+}
+
+/**
+ * EnableDeviceResponse is the response for the EnableDevice method.
+ *
+ * @generated from message homecall.v1alpha.EnableDeviceResponse
+ */
+export declare class EnableDeviceResponse extends Message<EnableDeviceResponse> {
+  /**
+   * The enabled device.
+   *
+   * @generated from field: homecall.v1alpha.Device device = 1;
+   */
+  device?: Device;
+
+  constructor(data?: PartialMessage<EnableDeviceResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.EnableDeviceResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EnableDeviceResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EnableDeviceResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EnableDeviceResponse;
+
+  static equals(a: EnableDeviceResponse | PlainMessage<EnableDeviceResponse> | undefined, b: EnableDeviceResponse | PlainMessage<EnableDeviceResponse> | undefined): boolean;
+}
+
+/**
+ * RequestDeviceLogsRequest is the request for the RequestDeviceLogs method.
+ *
+ * @generated from message homecall.v1alpha.RequestDeviceLogsRequest
+ */
+export declare class RequestDeviceLogsRequest extends Message<RequestDeviceLogsRequest> {
+  /**
+   * The ID of the device to request the logs of.
+   *
+   * @generated from field: string device_id = 1;
+   */
+  deviceId: string;
+
+  constructor(data?: PartialMessage<RequestDeviceLogsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.RequestDeviceLogsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RequestDeviceLogsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RequestDeviceLogsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RequestDeviceLogsRequest;
+
+  static equals(a: RequestDeviceLogsRequest | PlainMessage<RequestDeviceLogsRequest> | undefined, b: RequestDeviceLogsRequest | PlainMessage<RequestDeviceLogsRequest> | undefined): boolean;
+}
+
+/**
+ * RequestDeviceLogsResponse is the response for the RequestDeviceLogs method.
+ *
+ * @generated from message homecall.v1alpha.RequestDeviceLogsResponse
+ */
+export declare class RequestDeviceLogsResponse extends Message<RequestDeviceLogsResponse> {
+  /**
+   * The requested log.
+   *
+   * @generated from field: homecall.v1alpha.DeviceLog log = 1;
+   */
+  log?: DeviceLog;
+
+  constructor(data?: PartialMessage<RequestDeviceLogsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.RequestDeviceLogsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RequestDeviceLogsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RequestDeviceLogsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RequestDeviceLogsResponse;
+
+  static equals(a: RequestDeviceLogsResponse | PlainMessage<RequestDeviceLogsResponse> | undefined, b: RequestDeviceLogsResponse | PlainMessage<RequestDeviceLogsResponse> | undefined): boolean;
+}
+
+/**
+ * ListDeviceLogsRequest is the request for the ListDeviceLogs method.
+ *
+ * @generated from message homecall.v1alpha.ListDeviceLogsRequest
+ */
+export declare class ListDeviceLogsRequest extends Message<ListDeviceLogsRequest> {
+  /**
+   * The ID of the device to list the logs of.
+   *
+   * @generated from field: string device_id = 1;
+   */
+  deviceId: string;
+
+  constructor(data?: PartialMessage<ListDeviceLogsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.ListDeviceLogsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListDeviceLogsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListDeviceLogsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListDeviceLogsRequest;
+
+  static equals(a: ListDeviceLogsRequest | PlainMessage<ListDeviceLogsRequest> | undefined, b: ListDeviceLogsRequest | PlainMessage<ListDeviceLogsRequest> | undefined): boolean;
+}
+
+/**
+ * ListDeviceLogsResponse is the response for the ListDeviceLogs method.
+ *
+ * @generated from message homecall.v1alpha.ListDeviceLogsResponse
+ */
+export declare class ListDeviceLogsResponse extends Message<ListDeviceLogsResponse> {
+  /**
+   * The logs of the device, newest first.
+   *
+   * @generated from field: repeated homecall.v1alpha.DeviceLog logs = 1;
+   */
+  logs: DeviceLog[];
+
+  constructor(data?: PartialMessage<ListDeviceLogsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.ListDeviceLogsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListDeviceLogsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListDeviceLogsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListDeviceLogsResponse;
+
+  static equals(a: ListDeviceLogsResponse | PlainMessage<ListDeviceLogsResponse> | undefined, b: ListDeviceLogsResponse | PlainMessage<ListDeviceLogsResponse> | undefined): boolean;
+}
+
+/**
+ * DownloadDeviceLogRequest is the request for the DownloadDeviceLog method.
+ *
+ * @generated from message homecall.v1alpha.DownloadDeviceLogRequest
+ */
+export declare class DownloadDeviceLogRequest extends Message<DownloadDeviceLogRequest> {
+  /**
+   * The ID of the log to download.
+   *
+   * @generated from field: string log_id = 1;
+   */
+  logId: string;
+
+  constructor(data?: PartialMessage<DownloadDeviceLogRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.DownloadDeviceLogRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DownloadDeviceLogRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DownloadDeviceLogRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DownloadDeviceLogRequest;
+
+  static equals(a: DownloadDeviceLogRequest | PlainMessage<DownloadDeviceLogRequest> | undefined, b: DownloadDeviceLogRequest | PlainMessage<DownloadDeviceLogRequest> | undefined): boolean;
+}
+
+/**
+ * DownloadDeviceLogResponse is the response for the DownloadDeviceLog method.
+ *
+ * @generated from message homecall.v1alpha.DownloadDeviceLogResponse
+ */
+export declare class DownloadDeviceLogResponse extends Message<DownloadDeviceLogResponse> {
+  /**
+   * The log.
+   *
+   * @generated from field: homecall.v1alpha.DeviceLog log = 1;
+   */
+  log?: DeviceLog;
+
+  /**
+   * The content of the log file, encoded as described by the content encoding of the log.
+   *
+   * @generated from field: bytes content = 2;
+   */
+  content: Uint8Array;
+
+  constructor(data?: PartialMessage<DownloadDeviceLogResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.DownloadDeviceLogResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DownloadDeviceLogResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DownloadDeviceLogResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DownloadDeviceLogResponse;
+
+  static equals(a: DownloadDeviceLogResponse | PlainMessage<DownloadDeviceLogResponse> | undefined, b: DownloadDeviceLogResponse | PlainMessage<DownloadDeviceLogResponse> | undefined): boolean;
+}
+
+/**
+ * DeviceLog represents a log file requested from a device.
+ *
+ * @generated from message homecall.v1alpha.DeviceLog
+ */
+export declare class DeviceLog extends Message<DeviceLog> {
+  /**
+   * The ID of the log.
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * The ID of the device the log belongs to.
+   *
+   * @generated from field: string device_id = 2;
+   */
+  deviceId: string;
+
+  /**
+   * The status of the log.
+   *
+   * @generated from field: homecall.v1alpha.DeviceLogStatus status = 3;
+   */
+  status: DeviceLogStatus;
+
+  /**
+   * When the log was requested.
+   *
+   * @generated from field: google.protobuf.Timestamp requested_at = 4;
+   */
+  requestedAt?: Timestamp;
+
+  /**
+   * When the log was uploaded.
+   * Not set if the log has not been uploaded yet.
+   *
+   * @generated from field: google.protobuf.Timestamp uploaded_at = 5;
+   */
+  uploadedAt?: Timestamp;
+
+  /**
+   * The encoding of the log file, either "gzip" or "identity".
+   *
+   * @generated from field: string content_encoding = 6;
+   */
+  contentEncoding: string;
+
+  /**
+   * The size of the (encoded) log file in bytes.
+   *
+   * @generated from field: int64 size_bytes = 7;
+   */
+  sizeBytes: bigint;
+
+  constructor(data?: PartialMessage<DeviceLog>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.DeviceLog";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeviceLog;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeviceLog;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeviceLog;
+
+  static equals(a: DeviceLog | PlainMessage<DeviceLog> | undefined, b: DeviceLog | PlainMessage<DeviceLog> | undefined): boolean;
+}
+
+/**
+ * Device represents a device.
+ *
+ * @generated from message homecall.v1alpha.Device
+ */
+export declare class Device extends Message<Device> {
+  /**
+   * The ID of the device.
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * The name of the device.
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * The enrollment key for the device.
+   * This key is used to authenticate the device during the enrollment process.
+   * Keys are stored hashed, so the key is only set in the responses of
+   * CreateDevice and RegenerateEnrollmentKey.
+   *
+   * @generated from field: string enrollment_key = 3;
+   */
+  enrollmentKey: string;
+
+  /**
+   * Whether the device is online or offline.
+   * Online devices have sent a heartbeat recently enough for their reported app state.
+   *
+   * @generated from field: bool online = 4;
+   */
+  online: boolean;
+
+  /**
+   * The tenant ID the device belongs to.
+   *
+   * @generated from field: string tenant_id = 5;
+   */
+  tenantId: string;
+
+  /**
+   * The last time the device sent a heartbeat.
+   * Not set if the device has never sent a heartbeat.
+   *
+   * @generated from field: google.protobuf.Timestamp last_seen = 6;
+   */
+  lastSeen?: Timestamp;
+
+  /**
+   * The state the device reported in its last heartbeat.
+   *
+   * @generated from field: homecall.v1alpha.DeviceState state = 7;
+   */
+  state?: DeviceState;
+
+  /**
+   * Whether the device has been enrolled.
+   *
+   * @generated from field: bool enrolled = 8;
+   */
+  enrolled: boolean;
+
+  /**
+   * When the enrollment key of the device expires.
+   * Only set if the device is not enrolled.
+   *
+   * @generated from field: google.protobuf.Timestamp enrollment_key_expires_at = 9;
+   */
+  enrollmentKeyExpiresAt?: Timestamp;
+
+  /**
+   * A short numeric code that can be typed on the device instead of the enrollment key.
+   * Like the enrollment key, it is only set in the responses of CreateDevice and RegenerateEnrollmentKey.
+   *
+   * @generated from field: string pairing_code = 10;
+   */
+  pairingCode: string;
+
+  /**
+   * When the pairing code expires.
+   * Only set if the device is not enrolled.
+   *
+   * @generated from field: google.protobuf.Timestamp pairing_code_expires_at = 11;
+   */
+  pairingCodeExpiresAt?: Timestamp;
+
+  /**
+   * The content of the enrollment QR code, a signed token containing the URL of the instance
+   * and the enrollment key.
+   * Like the enrollment key, it is only set in the responses of CreateDevice and RegenerateEnrollmentKey.
+   *
+   * @generated from field: string enrollment_qr_payload = 12;
+   */
+  enrollmentQrPayload: string;
+
+  /**
+   * Whether the device has been disabled.
+   * A disabled device can not authenticate or receive calls.
+   *
+   * @generated from field: bool disabled = 13;
+   */
+  disabled: boolean;
+
+  /**
+   * When the device was disabled.
+   * Only set if the device is disabled.
+   *
+   * @generated from field: google.protobuf.Timestamp disabled_at = 14;
+   */
+  disabledAt?: Timestamp;
+
+  /**
+   * The ID of the group the device belongs to.
+   * Not set if the device is not in a group.
+   *
+   * @generated from field: string group_id = 15;
+   */
+  groupId: string;
+
+  /**
+   * The IANA time zone of the device, e.g. "Europe/Stockholm".
+   *
+   * @generated from field: string time_zone = 16;
+   */
+  timeZone: string;
+
+  /**
+   * The quiet hours of the device, not set if it has none.
+   *
+   * @generated from field: homecall.v1alpha.QuietHours quiet_hours = 17;
+   */
+  quietHours?: QuietHours;
+
+  /**
+   * The auto-answer policy of the device.
+   *
+   * @generated from field: homecall.v1alpha.AutoAnswerPolicy auto_answer = 18;
+   */
+  autoAnswer?: AutoAnswerPolicy;
+
+  constructor(data?: PartialMessage<Device>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.Device";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Device;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Device;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Device;
+
+  static equals(a: Device | PlainMessage<Device> | undefined, b: Device | PlainMessage<Device> | undefined): boolean;
+}
+
+/**
+ * DeviceGroup is a group of devices within a tenant, e.g. a household or a ward.
+ *
+ * @generated from message homecall.v1alpha.DeviceGroup
+ */
+export declare class DeviceGroup extends Message<DeviceGroup> {
+  /**
+   * The ID of the group.
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * The ID of the tenant the group belongs to.
+   *
+   * @generated from field: string tenant_id = 2;
+   */
+  tenantId: string;
+
+  /**
+   * The name of the group.
+   *
+   * @generated from field: string name = 3;
+   */
+  name: string;
+
+  constructor(data?: PartialMessage<DeviceGroup>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.DeviceGroup";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeviceGroup;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeviceGroup;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeviceGroup;
+
+  static equals(a: DeviceGroup | PlainMessage<DeviceGroup> | undefined, b: DeviceGroup | PlainMessage<DeviceGroup> | undefined): boolean;
+}
+
+/**
+ * CreateDeviceGroupRequest is the request for the CreateDeviceGroup method.
+ *
+ * @generated from message homecall.v1alpha.CreateDeviceGroupRequest
+ */
+export declare class CreateDeviceGroupRequest extends Message<CreateDeviceGroupRequest> {
+  /**
+   * The ID of the tenant to create the group in.
+   *
+   * @generated from field: string tenant_id = 1;
+   */
+  tenantId: string;
+
+  /**
+   * The name of the group.
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  constructor(data?: PartialMessage<CreateDeviceGroupRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.CreateDeviceGroupRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateDeviceGroupRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateDeviceGroupRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateDeviceGroupRequest;
+
+  static equals(a: CreateDeviceGroupRequest | PlainMessage<CreateDeviceGroupRequest> | undefined, b: CreateDeviceGroupRequest | PlainMessage<CreateDeviceGroupRequest> | undefined): boolean;
+}
+
+/**
+ * CreateDeviceGroupResponse is the response for the CreateDeviceGroup method.
+ *
+ * @generated from message homecall.v1alpha.CreateDeviceGroupResponse
+ */
+export declare class CreateDeviceGroupResponse extends Message<CreateDeviceGroupResponse> {
+  /**
+   * The new group.
+   *
+   * @generated from field: homecall.v1alpha.DeviceGroup group = 1;
+   */
+  group?: DeviceGroup;
+
+  constructor(data?: PartialMessage<CreateDeviceGroupResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.CreateDeviceGroupResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateDeviceGroupResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateDeviceGroupResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateDeviceGroupResponse;
+
+  static equals(a: CreateDeviceGroupResponse | PlainMessage<CreateDeviceGroupResponse> | undefined, b: CreateDeviceGroupResponse | PlainMessage<CreateDeviceGroupResponse> | undefined): boolean;
+}
+
+/**
+ * ListDeviceGroupsRequest is the request for the ListDeviceGroups method.
+ *
+ * @generated from message homecall.v1alpha.ListDeviceGroupsRequest
+ */
+export declare class ListDeviceGroupsRequest extends Message<ListDeviceGroupsRequest> {
+  /**
+   * The ID of the tenant to list the groups of.
+   *
+   * @generated from field: string tenant_id = 1;
+   */
+  tenantId: string;
+
+  constructor(data?: PartialMessage<ListDeviceGroupsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.ListDeviceGroupsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListDeviceGroupsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListDeviceGroupsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListDeviceGroupsRequest;
+
+  static equals(a: ListDeviceGroupsRequest | PlainMessage<ListDeviceGroupsRequest> | undefined, b: ListDeviceGroupsRequest | PlainMessage<ListDeviceGroupsRequest> | undefined): boolean;
+}
+
+/**
+ * ListDeviceGroupsResponse is the response for the ListDeviceGroups method.
+ *
+ * @generated from message homecall.v1alpha.ListDeviceGroupsResponse
+ */
+export declare class ListDeviceGroupsResponse extends Message<ListDeviceGroupsResponse> {
+  /**
+   * The groups, ordered by name.
+   *
+   * @generated from field: repeated homecall.v1alpha.DeviceGroup groups = 1;
+   */
+  groups: DeviceGroup[];
+
+  constructor(data?: PartialMessage<ListDeviceGroupsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.ListDeviceGroupsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListDeviceGroupsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListDeviceGroupsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListDeviceGroupsResponse;
+
+  static equals(a: ListDeviceGroupsResponse | PlainMessage<ListDeviceGroupsResponse> | undefined, b: ListDeviceGroupsResponse | PlainMessage<ListDeviceGroupsResponse> | undefined): boolean;
+}
+
+/**
+ * UpdateDeviceGroupRequest is the request for the UpdateDeviceGroup method.
+ *
+ * @generated from message homecall.v1alpha.UpdateDeviceGroupRequest
+ */
+export declare class UpdateDeviceGroupRequest extends Message<UpdateDeviceGroupRequest> {
+  /**
+   * The ID of the group to update.
+   *
+   * @generated from field: string group_id = 1;
+   */
+  groupId: string;
+
+  /**
+   * The new name of the group.
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  constructor(data?: PartialMessage<UpdateDeviceGroupRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.UpdateDeviceGroupRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateDeviceGroupRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateDeviceGroupRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateDeviceGroupRequest;
+
+  static equals(a: UpdateDeviceGroupRequest | PlainMessage<UpdateDeviceGroupRequest> | undefined, b: UpdateDeviceGroupRequest | PlainMessage<UpdateDeviceGroupRequest> | undefined): boolean;
+}
+
+/**
+ * UpdateDeviceGroupResponse is the response for the UpdateDeviceGroup method.
+ *
+ * @generated from message homecall.v1alpha.UpdateDeviceGroupResponse
+ */
+export declare class UpdateDeviceGroupResponse extends Message<UpdateDeviceGroupResponse> {
+  /**
+   * The updated group.
+   *
+   * @generated from field: homecall.v1alpha.DeviceGroup group = 1;
+   */
+  group?: DeviceGroup;
+
+  constructor(data?: PartialMessage<UpdateDeviceGroupResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.UpdateDeviceGroupResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateDeviceGroupResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateDeviceGroupResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateDeviceGroupResponse;
+
+  static equals(a: UpdateDeviceGroupResponse | PlainMessage<UpdateDeviceGroupResponse> | undefined, b: UpdateDeviceGroupResponse | PlainMessage<UpdateDeviceGroupResponse> | undefined): boolean;
+}
+
+/**
+ * RemoveDeviceGroupRequest is the request for the RemoveDeviceGroup method.
+ *
+ * @generated from message homecall.v1alpha.RemoveDeviceGroupRequest
+ */
+export declare class RemoveDeviceGroupRequest extends Message<RemoveDeviceGroupRequest> {
+  /**
+   * The ID of the group to remove.
+   *
+   * @generated from field: string group_id = 1;
+   */
+  groupId: string;
+
+  constructor(data?: PartialMessage<RemoveDeviceGroupRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.RemoveDeviceGroupRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveDeviceGroupRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveDeviceGroupRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveDeviceGroupRequest;
+
+  static equals(a: RemoveDeviceGroupRequest | PlainMessage<RemoveDeviceGroupRequest> | undefined, b: RemoveDeviceGroupRequest | PlainMessage<RemoveDeviceGroupRequest> | undefined): boolean;
+}
+
+/**
+ * RemoveDeviceGroupResponse is the response for the RemoveDeviceGroup method.
+ *
+ * @generated from message homecall.v1alpha.RemoveDeviceGroupResponse
+ */
+export declare class RemoveDeviceGroupResponse extends Message<RemoveDeviceGroupResponse> {
+  constructor(data?: PartialMessage<RemoveDeviceGroupResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.RemoveDeviceGroupResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveDeviceGroupResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveDeviceGroupResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveDeviceGroupResponse;
+
+  static equals(a: RemoveDeviceGroupResponse | PlainMessage<RemoveDeviceGroupResponse> | undefined, b: RemoveDeviceGroupResponse | PlainMessage<RemoveDeviceGroupResponse> | undefined): boolean;
+}
+
+/**
+ * SetDeviceGroupRequest is the request for the SetDeviceGroup method.
+ *
+ * @generated from message homecall.v1alpha.SetDeviceGroupRequest
+ */
+export declare class SetDeviceGroupRequest extends Message<SetDeviceGroupRequest> {
+  /**
+   * The ID of the device.
+   *
+   * @generated from field: string device_id = 1;
+   */
+  deviceId: string;
+
+  /**
+   * The ID of the group to assign the device to.
+   * If empty, the device is removed from its group.
+   *
+   * @generated from field: string group_id = 2;
+   */
+  groupId: string;
+
+  constructor(data?: PartialMessage<SetDeviceGroupRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.SetDeviceGroupRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetDeviceGroupRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetDeviceGroupRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetDeviceGroupRequest;
+
+  static equals(a: SetDeviceGroupRequest | PlainMessage<SetDeviceGroupRequest> | undefined, b: SetDeviceGroupRequest | PlainMessage<SetDeviceGroupRequest> | undefined): boolean;
+}
+
+/**
+ * SetDeviceGroupResponse is the response for the SetDeviceGroup method.
+ *
+ * @generated from message homecall.v1alpha.SetDeviceGroupResponse
+ */
+export declare class SetDeviceGroupResponse extends Message<SetDeviceGroupResponse> {
+  /**
+   * The updated device.
+   *
+   * @generated from field: homecall.v1alpha.Device device = 1;
+   */
+  device?: Device;
+
+  constructor(data?: PartialMessage<SetDeviceGroupResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.SetDeviceGroupResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetDeviceGroupResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetDeviceGroupResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetDeviceGroupResponse;
+
+  static equals(a: SetDeviceGroupResponse | PlainMessage<SetDeviceGroupResponse> | undefined, b: SetDeviceGroupResponse | PlainMessage<SetDeviceGroupResponse> | undefined): boolean;
+}
+
+/**
+ * ScheduleCallRequest is the request for the ScheduleCall method.
+ *
+ * @generated from message homecall.v1alpha.ScheduleCallRequest
+ */
+export declare class ScheduleCallRequest extends Message<ScheduleCallRequest> {
+  /**
+   * The ID of the device to call.
+   *
+   * @generated from field: string device_id = 1;
+   */
+  deviceId: string;
+
+  /**
+   * When the call starts, must be in the future.
+   *
+   * @generated from field: google.protobuf.Timestamp starts_at = 2;
+   */
+  startsAt?: Timestamp;
+
+  /**
+   * How long the call is planned to last.
+   *
+   * @generated from field: int64 duration_seconds = 3;
+   */
+  durationSeconds: bigint;
+
+  /**
+   * The ID of the tenant member assigned to make the call.
+   * If empty, the caller is assigned.
+   *
+   * @generated from field: string assigned_member_id = 4;
+   */
+  assignedMemberId: string;
+
+  constructor(data?: PartialMessage<ScheduleCallRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.ScheduleCallRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ScheduleCallRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ScheduleCallRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ScheduleCallRequest;
+
+  static equals(a: ScheduleCallRequest | PlainMessage<ScheduleCallRequest> | undefined, b: ScheduleCallRequest | PlainMessage<ScheduleCallRequest> | undefined): boolean;
+}
+
+/**
+ * ScheduleCallResponse is the response for the ScheduleCall method.
+ *
+ * @generated from message homecall.v1alpha.ScheduleCallResponse
+ */
+export declare class ScheduleCallResponse extends Message<ScheduleCallResponse> {
+  /**
+   * The scheduled call.
+   *
+   * @generated from field: homecall.v1alpha.ScheduledCall scheduled_call = 1;
+   */
+  scheduledCall?: ScheduledCall;
+
+  constructor(data?: PartialMessage<ScheduleCallResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.ScheduleCallResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ScheduleCallResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ScheduleCallResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ScheduleCallResponse;
+
+  static equals(a: ScheduleCallResponse | PlainMessage<ScheduleCallResponse> | undefined, b: ScheduleCallResponse | PlainMessage<ScheduleCallResponse> | undefined): boolean;
+}
+
+/**
+ * ListScheduledCallsRequest is the request for the ListScheduledCalls method.
+ *
+ * @generated from message homecall.v1alpha.ListScheduledCallsRequest
+ */
+export declare class ListScheduledCallsRequest extends Message<ListScheduledCallsRequest> {
+  /**
+   * The ID of the tenant to list the scheduled calls of.
+   *
+   * @generated from field: string tenant_id = 1;
+   */
+  tenantId: string;
+
+  /**
+   * Only calls starting at or after this time are returned.
+   * If not set, calls starting from now are returned.
+   *
+   * @generated from field: google.protobuf.Timestamp from = 2;
+   */
+  from?: Timestamp;
+
+  /**
+   * Only calls starting before this time are returned.
+   * If not set, there is no upper limit.
+   *
+   * @generated from field: google.protobuf.Timestamp to = 3;
+   */
+  to?: Timestamp;
+
+  /**
+   * If set, only the calls with this device are returned.
+   *
+   * @generated from field: string device_id = 4;
+   */
+  deviceId: string;
+
+  /**
+   * If set, only the calls assigned to this member are returned.
+   *
+   * @generated from field: string assigned_member_id = 5;
+   */
+  assignedMemberId: string;
+
+  constructor(data?: PartialMessage<ListScheduledCallsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.ListScheduledCallsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListScheduledCallsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListScheduledCallsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListScheduledCallsRequest;
+
+  static equals(a: ListScheduledCallsRequest | PlainMessage<ListScheduledCallsRequest> | undefined, b: ListScheduledCallsRequest | PlainMessage<ListScheduledCallsRequest> | undefined): boolean;
+}
+
+/**
+ * ListScheduledCallsResponse is the response for the ListScheduledCalls method.
+ *
+ * @generated from message homecall.v1alpha.ListScheduledCallsResponse
+ */
+export declare class ListScheduledCallsResponse extends Message<ListScheduledCallsResponse> {
+  /**
+   * The scheduled calls, ordered by start time.
+   *
+   * @generated from field: repeated homecall.v1alpha.ScheduledCall scheduled_calls = 1;
+   */
+  scheduledCalls: ScheduledCall[];
+
+  constructor(data?: PartialMessage<ListScheduledCallsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.ListScheduledCallsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListScheduledCallsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListScheduledCallsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListScheduledCallsResponse;
+
+  static equals(a: ListScheduledCallsResponse | PlainMessage<ListScheduledCallsResponse> | undefined, b: ListScheduledCallsResponse | PlainMessage<ListScheduledCallsResponse> | undefined): boolean;
+}
+
+/**
+ * UpdateScheduledCallRequest is the request for the UpdateScheduledCall method.
+ *
+ * @generated from message homecall.v1alpha.UpdateScheduledCallRequest
+ */
+export declare class UpdateScheduledCallRequest extends Message<UpdateScheduledCallRequest> {
+  /**
+   * The ID of the scheduled call.
+   *
+   * @generated from field: string scheduled_call_id = 1;
+   */
+  scheduledCallId: string;
+
+  /**
+   * When the call starts, must be in the future.
+   *
+   * @generated from field: google.protobuf.Timestamp starts_at = 2;
+   */
+  startsAt?: Timestamp;
+
+  /**
+   * How long the call is planned to last.
+   *
+   * @generated from field: int64 duration_seconds = 3;
+   */
+  durationSeconds: bigint;
+
+  /**
+   * The ID of the tenant member assigned to make the call.
+   * If empty, the caller is assigned.
+   *
+   * @generated from field: string assigned_member_id = 4;
+   */
+  assignedMemberId: string;
+
+  constructor(data?: PartialMessage<UpdateScheduledCallRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.UpdateScheduledCallRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateScheduledCallRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateScheduledCallRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateScheduledCallRequest;
+
+  static equals(a: UpdateScheduledCallRequest | PlainMessage<UpdateScheduledCallRequest> | undefined, b: UpdateScheduledCallRequest | PlainMessage<UpdateScheduledCallRequest> | undefined): boolean;
+}
+
+/**
+ * UpdateScheduledCallResponse is the response for the UpdateScheduledCall method.
+ *
+ * @generated from message homecall.v1alpha.UpdateScheduledCallResponse
+ */
+export declare class UpdateScheduledCallResponse extends Message<UpdateScheduledCallResponse> {
+  /**
+   * The updated scheduled call.
+   *
+   * @generated from field: homecall.v1alpha.ScheduledCall scheduled_call = 1;
+   */
+  scheduledCall?: ScheduledCall;
+
+  constructor(data?: PartialMessage<UpdateScheduledCallResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.UpdateScheduledCallResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateScheduledCallResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateScheduledCallResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateScheduledCallResponse;
+
+  static equals(a: UpdateScheduledCallResponse | PlainMessage<UpdateScheduledCallResponse> | undefined, b: UpdateScheduledCallResponse | PlainMessage<UpdateScheduledCallResponse> | undefined): boolean;
+}
+
+/**
+ * CancelScheduledCallRequest is the request for the CancelScheduledCall method.
+ *
+ * @generated from message homecall.v1alpha.CancelScheduledCallRequest
+ */
+export declare class CancelScheduledCallRequest extends Message<CancelScheduledCallRequest> {
+  /**
+   * The ID of the scheduled call.
+   *
+   * @generated from field: string scheduled_call_id = 1;
+   */
+  scheduledCallId: string;
+
+  constructor(data?: PartialMessage<CancelScheduledCallRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.CancelScheduledCallRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CancelScheduledCallRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CancelScheduledCallRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CancelScheduledCallRequest;
+
+  static equals(a: CancelScheduledCallRequest | PlainMessage<CancelScheduledCallRequest> | undefined, b: CancelScheduledCallRequest | PlainMessage<CancelScheduledCallRequest> | undefined): boolean;
+}
+
+/**
+ * CancelScheduledCallResponse is the response for the CancelScheduledCall method.
+ *
+ * @generated from message homecall.v1alpha.CancelScheduledCallResponse
+ */
+export declare class CancelScheduledCallResponse extends Message<CancelScheduledCallResponse> {
+  /**
+   * The cancelled scheduled call.
+   *
+   * @generated from field: homecall.v1alpha.ScheduledCall scheduled_call = 1;
+   */
+  scheduledCall?: ScheduledCall;
+
+  constructor(data?: PartialMessage<CancelScheduledCallResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.CancelScheduledCallResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CancelScheduledCallResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CancelScheduledCallResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CancelScheduledCallResponse;
+
+  static equals(a: CancelScheduledCallResponse | PlainMessage<CancelScheduledCallResponse> | undefined, b: CancelScheduledCallResponse | PlainMessage<CancelScheduledCallResponse> | undefined): boolean;
+}
+
+/**
+ * WatchScheduledCallsRequest is the request for the WatchScheduledCalls method.
+ *
+ * @generated from message homecall.v1alpha.WatchScheduledCallsRequest
+ */
+export declare class WatchScheduledCallsRequest extends Message<WatchScheduledCallsRequest> {
+  /**
+   * The ID of the tenant to watch scheduled calls in.
+   *
+   * @generated from field: string tenant_id = 1;
+   */
+  tenantId: string;
+
+  constructor(data?: PartialMessage<WatchScheduledCallsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.WatchScheduledCallsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchScheduledCallsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchScheduledCallsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchScheduledCallsRequest;
+
+  static equals(a: WatchScheduledCallsRequest | PlainMessage<WatchScheduledCallsRequest> | undefined, b: WatchScheduledCallsRequest | PlainMessage<WatchScheduledCallsRequest> | undefined): boolean;
+}
+
+/**
+ * WatchScheduledCallsResponse is sent for every event about a scheduled call the caller is assigned to.
+ *
+ * @generated from message homecall.v1alpha.WatchScheduledCallsResponse
+ */
+export declare class WatchScheduledCallsResponse extends Message<WatchScheduledCallsResponse> {
+  /**
+   * The type of the event.
+   *
+   * @generated from field: homecall.v1alpha.ScheduledCallEventType type = 1;
+   */
+  type: ScheduledCallEventType;
+
+  /**
+   * The scheduled call.
+   *
+   * @generated from field: homecall.v1alpha.ScheduledCall scheduled_call = 2;
+   */
+  scheduledCall?: ScheduledCall;
+
+  constructor(data?: PartialMessage<WatchScheduledCallsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.WatchScheduledCallsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchScheduledCallsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchScheduledCallsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchScheduledCallsResponse;
+
+  static equals(a: WatchScheduledCallsResponse | PlainMessage<WatchScheduledCallsResponse> | undefined, b: WatchScheduledCallsResponse | PlainMessage<WatchScheduledCallsResponse> | undefined): boolean;
+}
+
+/**
+ * CreateCallScheduleRequest is the request for the CreateCallSchedule method.
+ *
+ * @generated from message homecall.v1alpha.CreateCallScheduleRequest
+ */
+export declare class CreateCallScheduleRequest extends Message<CreateCallScheduleRequest> {
+  /**
+   * The ID of the device to call.
+   *
+   * @generated from field: string device_id = 1;
+   */
+  deviceId: string;
+
+  /**
+   * The iCalendar RRULE the calls recur by, e.g. "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR".
+   * Only daily or less frequent rules are allowed.
+   *
+   * @generated from field: string rrule = 2;
+   */
+  rrule: string;
+
+  /**
+   * When the first call starts, must be in the future.
+   * Its time of day in the time zone of the device is kept for all calls.
+   *
+   * @generated from field: google.protobuf.Timestamp starts_at = 3;
+   */
+  startsAt?: Timestamp;
+
+  /**
+   * How long each call is planned to last.
+   *
+   * @generated from field: int64 duration_seconds = 4;
+   */
+  durationSeconds: bigint;
+
+  /**
+   * The ID of the tenant member assigned to make the calls.
+   * If empty, the caller is assigned.
+   *
+   * @generated from field: string assigned_member_id = 5;
+   */
+  assignedMemberId: string;
+
+  constructor(data?: PartialMessage<CreateCallScheduleRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.CreateCallScheduleRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateCallScheduleRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateCallScheduleRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateCallScheduleRequest;
+
+  static equals(a: CreateCallScheduleRequest | PlainMessage<CreateCallScheduleRequest> | undefined, b: CreateCallScheduleRequest | PlainMessage<CreateCallScheduleRequest> | undefined): boolean;
+}
+
+/**
+ * CreateCallScheduleResponse is the response for the CreateCallSchedule method.
+ *
+ * @generated from message homecall.v1alpha.CreateCallScheduleResponse
+ */
+export declare class CreateCallScheduleResponse extends Message<CreateCallScheduleResponse> {
+  /**
+   * The new call schedule.
+   *
+   * @generated from field: homecall.v1alpha.CallSchedule call_schedule = 1;
+   */
+  callSchedule?: CallSchedule;
+
+  constructor(data?: PartialMessage<CreateCallScheduleResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.CreateCallScheduleResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateCallScheduleResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateCallScheduleResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateCallScheduleResponse;
+
+  static equals(a: CreateCallScheduleResponse | PlainMessage<CreateCallScheduleResponse> | undefined, b: CreateCallScheduleResponse | PlainMessage<CreateCallScheduleResponse> | undefined): boolean;
+}
+
+/**
+ * ListCallSchedulesRequest is the request for the ListCallSchedules method.
+ *
+ * @generated from message homecall.v1alpha.ListCallSchedulesRequest
+ */
+export declare class ListCallSchedulesRequest extends Message<ListCallSchedulesRequest> {
+  /**
+   * The ID of the tenant to list the call schedules of.
+   *
+   * @generated from field: string tenant_id = 1;
+   */
+  tenantId: string;
+
+  /**
+   * If set, only the call schedules of this device are returned.
+   *
+   * @generated from field: string device_id = 2;
+   */
+  deviceId: string;
+
+  constructor(data?: PartialMessage<ListCallSchedulesRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.ListCallSchedulesRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListCallSchedulesRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListCallSchedulesRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListCallSchedulesRequest;
+
+  static equals(a: ListCallSchedulesRequest | PlainMessage<ListCallSchedulesRequest> | undefined, b: ListCallSchedulesRequest | PlainMessage<ListCallSchedulesRequest> | undefined): boolean;
+}
+
+/**
+ * ListCallSchedulesResponse is the response for the ListCallSchedules method.
+ *
+ * @generated from message homecall.v1alpha.ListCallSchedulesResponse
+ */
+export declare class ListCallSchedulesResponse extends Message<ListCallSchedulesResponse> {
+  /**
+   * The call schedules, oldest first.
+   *
+   * @generated from field: repeated homecall.v1alpha.CallSchedule call_schedules = 1;
+   */
+  callSchedules: CallSchedule[];
+
+  constructor(data?: PartialMessage<ListCallSchedulesResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.ListCallSchedulesResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListCallSchedulesResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListCallSchedulesResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListCallSchedulesResponse;
+
+  static equals(a: ListCallSchedulesResponse | PlainMessage<ListCallSchedulesResponse> | undefined, b: ListCallSchedulesResponse | PlainMessage<ListCallSchedulesResponse> | undefined): boolean;
+}
+
+/**
+ * UpdateCallScheduleRequest is the request for the UpdateCallSchedule method.
+ *
+ * @generated from message homecall.v1alpha.UpdateCallScheduleRequest
+ */
+export declare class UpdateCallScheduleRequest extends Message<UpdateCallScheduleRequest> {
+  /**
+   * The ID of the call schedule.
+   *
+   * @generated from field: string schedule_id = 1;
+   */
+  scheduleId: string;
+
+  /**
+   * The iCalendar RRULE the calls recur by.
+   *
+   * @generated from field: string rrule = 2;
+   */
+  rrule: string;
+
+  /**
+   * When the first call starts, must be in the future.
+   *
+   * @generated from field: google.protobuf.Timestamp starts_at = 3;
+   */
+  startsAt?: Timestamp;
+
+  /**
+   * How long each call is planned to last.
+   *
+   * @generated from field: int64 duration_seconds = 4;
+   */
+  durationSeconds: bigint;
+
+  /**
+   * The ID of the tenant member assigned to make the calls.
+   * If empty, the caller is assigned.
+   *
+   * @generated from field: string assigned_member_id = 5;
+   */
+  assignedMemberId: string;
+
+  constructor(data?: PartialMessage<UpdateCallScheduleRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.UpdateCallScheduleRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateCallScheduleRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateCallScheduleRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateCallScheduleRequest;
+
+  static equals(a: UpdateCallScheduleRequest | PlainMessage<UpdateCallScheduleRequest> | undefined, b: UpdateCallScheduleRequest | PlainMessage<UpdateCallScheduleRequest> | undefined): boolean;
+}
+
+/**
+ * UpdateCallScheduleResponse is the response for the UpdateCallSchedule method.
+ *
+ * @generated from message homecall.v1alpha.UpdateCallScheduleResponse
+ */
+export declare class UpdateCallScheduleResponse extends Message<UpdateCallScheduleResponse> {
+  /**
+   * The updated call schedule.
+   *
+   * @generated from field: homecall.v1alpha.CallSchedule call_schedule = 1;
+   */
+  callSchedule?: CallSchedule;
+
+  constructor(data?: PartialMessage<UpdateCallScheduleResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.UpdateCallScheduleResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateCallScheduleResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateCallScheduleResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateCallScheduleResponse;
+
+  static equals(a: UpdateCallScheduleResponse | PlainMessage<UpdateCallScheduleResponse> | undefined, b: UpdateCallScheduleResponse | PlainMessage<UpdateCallScheduleResponse> | undefined): boolean;
+}
+
+/**
+ * RemoveCallScheduleRequest is the request for the RemoveCallSchedule method.
+ *
+ * @generated from message homecall.v1alpha.RemoveCallScheduleRequest
+ */
+export declare class RemoveCallScheduleRequest extends Message<RemoveCallScheduleRequest> {
+  /**
+   * The ID of the call schedule.
+   *
+   * @generated from field: string schedule_id = 1;
+   */
+  scheduleId: string;
+
+  constructor(data?: PartialMessage<RemoveCallScheduleRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.RemoveCallScheduleRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveCallScheduleRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveCallScheduleRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveCallScheduleRequest;
+
+  static equals(a: RemoveCallScheduleRequest | PlainMessage<RemoveCallScheduleRequest> | undefined, b: RemoveCallScheduleRequest | PlainMessage<RemoveCallScheduleRequest> | undefined): boolean;
+}
+
+/**
+ * RemoveCallScheduleResponse is the response for the RemoveCallSchedule method.
+ *
+ * @generated from message homecall.v1alpha.RemoveCallScheduleResponse
+ */
+export declare class RemoveCallScheduleResponse extends Message<RemoveCallScheduleResponse> {
+  constructor(data?: PartialMessage<RemoveCallScheduleResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.RemoveCallScheduleResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveCallScheduleResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveCallScheduleResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveCallScheduleResponse;
+
+  static equals(a: RemoveCallScheduleResponse | PlainMessage<RemoveCallScheduleResponse> | undefined, b: RemoveCallScheduleResponse | PlainMessage<RemoveCallScheduleResponse> | undefined): boolean;
+}
+
+/**
+ * SkipCallScheduleOccurrenceRequest is the request for the SkipCallScheduleOccurrence method.
+ *
+ * @generated from message homecall.v1alpha.SkipCallScheduleOccurrenceRequest
+ */
+export declare class SkipCallScheduleOccurrenceRequest extends Message<SkipCallScheduleOccurrenceRequest> {
+  /**
+   * The ID of the call schedule.
+   *
+   * @generated from field: string schedule_id = 1;
+   */
+  scheduleId: string;
+
+  /**
+   * When the occurrence to skip starts.
+   *
+   * @generated from field: google.protobuf.Timestamp starts_at = 2;
+   */
+  startsAt?: Timestamp;
+
+  constructor(data?: PartialMessage<SkipCallScheduleOccurrenceRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.SkipCallScheduleOccurrenceRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SkipCallScheduleOccurrenceRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SkipCallScheduleOccurrenceRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SkipCallScheduleOccurrenceRequest;
+
+  static equals(a: SkipCallScheduleOccurrenceRequest | PlainMessage<SkipCallScheduleOccurrenceRequest> | undefined, b: SkipCallScheduleOccurrenceRequest | PlainMessage<SkipCallScheduleOccurrenceRequest> | undefined): boolean;
+}
+
+/**
+ * SkipCallScheduleOccurrenceResponse is the response for the SkipCallScheduleOccurrence method.
+ *
+ * @generated from message homecall.v1alpha.SkipCallScheduleOccurrenceResponse
+ */
+export declare class SkipCallScheduleOccurrenceResponse extends Message<SkipCallScheduleOccurrenceResponse> {
+  constructor(data?: PartialMessage<SkipCallScheduleOccurrenceResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.SkipCallScheduleOccurrenceResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SkipCallScheduleOccurrenceResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SkipCallScheduleOccurrenceResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SkipCallScheduleOccurrenceResponse;
+
+  static equals(a: SkipCallScheduleOccurrenceResponse | PlainMessage<SkipCallScheduleOccurrenceResponse> | undefined, b: SkipCallScheduleOccurrenceResponse | PlainMessage<SkipCallScheduleOccurrenceResponse> | undefined): boolean;
+}
+
+/**
+ * CreateCalendarFeedTokenRequest is the request for the CreateCalendarFeedToken method.
+ *
+ * @generated from message homecall.v1alpha.CreateCalendarFeedTokenRequest
+ */
+export declare class CreateCalendarFeedTokenRequest extends Message<CreateCalendarFeedTokenRequest> {
+  /**
+   * The ID of the tenant to create a feed token in.
+   *
+   * @generated from field: string tenant_id = 1;
+   */
+  tenantId: string;
+
+  constructor(data?: PartialMessage<CreateCalendarFeedTokenRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.CreateCalendarFeedTokenRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateCalendarFeedTokenRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateCalendarFeedTokenRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateCalendarFeedTokenRequest;
+
+  static equals(a: CreateCalendarFeedTokenRequest | PlainMessage<CreateCalendarFeedTokenRequest> | undefined, b: CreateCalendarFeedTokenRequest | PlainMessage<CreateCalendarFeedTokenRequest> | undefined): boolean;
+}
+
+/**
+ * CreateCalendarFeedTokenResponse is the response for the CreateCalendarFeedToken method.
+ *
+ * @generated from message homecall.v1alpha.CreateCalendarFeedTokenResponse
+ */
+export declare class CreateCalendarFeedTokenResponse extends Message<CreateCalendarFeedTokenResponse> {
+  /**
+   * The new token.
+   *
+   * @generated from field: homecall.v1alpha.CalendarFeedToken token = 1;
+   */
+  token?: CalendarFeedToken;
+
+  /**
+   * The URL of the feed, including the token.
+   * Tokens are stored hashed, so the URL is only returned here.
+   *
+   * @generated from field: string feed_url = 2;
+   */
+  feedUrl: string;
+
+  constructor(data?: PartialMessage<CreateCalendarFeedTokenResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.CreateCalendarFeedTokenResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateCalendarFeedTokenResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateCalendarFeedTokenResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateCalendarFeedTokenResponse;
+
+  static equals(a: CreateCalendarFeedTokenResponse | PlainMessage<CreateCalendarFeedTokenResponse> | undefined, b: CreateCalendarFeedTokenResponse | PlainMessage<CreateCalendarFeedTokenResponse> | undefined): boolean;
+}
+
+/**
+ * ListCalendarFeedTokensRequest is the request for the ListCalendarFeedTokens method.
+ *
+ * @generated from message homecall.v1alpha.ListCalendarFeedTokensRequest
+ */
+export declare class ListCalendarFeedTokensRequest extends Message<ListCalendarFeedTokensRequest> {
+  /**
+   * The ID of the tenant to list the feed tokens in.
+   *
+   * @generated from field: string tenant_id = 1;
+   */
+  tenantId: string;
+
+  constructor(data?: PartialMessage<ListCalendarFeedTokensRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.ListCalendarFeedTokensRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListCalendarFeedTokensRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListCalendarFeedTokensRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListCalendarFeedTokensRequest;
+
+  static equals(a: ListCalendarFeedTokensRequest | PlainMessage<ListCalendarFeedTokensRequest> | undefined, b: ListCalendarFeedTokensRequest | PlainMessage<ListCalendarFeedTokensRequest> | undefined): boolean;
+}
+
+/**
+ * ListCalendarFeedTokensResponse is the response for the ListCalendarFeedTokens method.
+ *
+ * @generated from message homecall.v1alpha.ListCalendarFeedTokensResponse
+ */
+export declare class ListCalendarFeedTokensResponse extends Message<ListCalendarFeedTokensResponse> {
+  /**
+   * The tokens of the caller, oldest first.
+   *
+   * @generated from field: repeated homecall.v1alpha.CalendarFeedToken tokens = 1;
+   */
+  tokens: CalendarFeedToken[];
+
+  constructor(data?: PartialMessage<ListCalendarFeedTokensResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.ListCalendarFeedTokensResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListCalendarFeedTokensResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListCalendarFeedTokensResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListCalendarFeedTokensResponse;
+
+  static equals(a: ListCalendarFeedTokensResponse | PlainMessage<ListCalendarFeedTokensResponse> | undefined, b: ListCalendarFeedTokensResponse | PlainMessage<ListCalendarFeedTokensResponse> | undefined): boolean;
+}
+
+/**
+ * RevokeCalendarFeedTokenRequest is the request for the RevokeCalendarFeedToken method.
+ *
+ * @generated from message homecall.v1alpha.RevokeCalendarFeedTokenRequest
+ */
+export declare class RevokeCalendarFeedTokenRequest extends Message<RevokeCalendarFeedTokenRequest> {
+  /**
+   * The ID of the token to revoke.
+   *
+   * @generated from field: string token_id = 1;
+   */
+  tokenId: string;
+
+  constructor(data?: PartialMessage<RevokeCalendarFeedTokenRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.RevokeCalendarFeedTokenRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevokeCalendarFeedTokenRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevokeCalendarFeedTokenRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevokeCalendarFeedTokenRequest;
+
+  static equals(a: RevokeCalendarFeedTokenRequest | PlainMessage<RevokeCalendarFeedTokenRequest> | undefined, b: RevokeCalendarFeedTokenRequest | PlainMessage<RevokeCalendarFeedTokenRequest> | undefined): boolean;
+}
+
+/**
+ * RevokeCalendarFeedTokenResponse is the response for the RevokeCalendarFeedToken method.
+ *
+ * @generated from message homecall.v1alpha.RevokeCalendarFeedTokenResponse
+ */
+export declare class RevokeCalendarFeedTokenResponse extends Message<RevokeCalendarFeedTokenResponse> {
+  constructor(data?: PartialMessage<RevokeCalendarFeedTokenResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.RevokeCalendarFeedTokenResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevokeCalendarFeedTokenResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevokeCalendarFeedTokenResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevokeCalendarFeedTokenResponse;
+
+  static equals(a: RevokeCalendarFeedTokenResponse | PlainMessage<RevokeCalendarFeedTokenResponse> | undefined, b: RevokeCalendarFeedTokenResponse | PlainMessage<RevokeCalendarFeedTokenResponse> | undefined): boolean;
+}
+
+/**
+ * GetAllowedCallersRequest is the request for the GetAllowedCallers method.
+ *
+ * @generated from message homecall.v1alpha.GetAllowedCallersRequest
+ */
+export declare class GetAllowedCallersRequest extends Message<GetAllowedCallersRequest> {
+  /**
+   * The ID of the device.
+   *
+   * @generated from field: string device_id = 1;
+   */
+  deviceId: string;
+
+  constructor(data?: PartialMessage<GetAllowedCallersRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.GetAllowedCallersRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAllowedCallersRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAllowedCallersRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAllowedCallersRequest;
+
+  static equals(a: GetAllowedCallersRequest | PlainMessage<GetAllowedCallersRequest> | undefined, b: GetAllowedCallersRequest | PlainMessage<GetAllowedCallersRequest> | undefined): boolean;
+}
+
+/**
+ * GetAllowedCallersResponse is the response for the GetAllowedCallers method.
+ *
+ * @generated from message homecall.v1alpha.GetAllowedCallersResponse
+ */
+export declare class GetAllowedCallersResponse extends Message<GetAllowedCallersResponse> {
+  /**
+   * Whether only admins and the allowed callers may call the device.
+   * If not, every member with access to the device may call it.
+   *
+   * @generated from field: bool restricted = 1;
+   */
+  restricted: boolean;
+
+  /**
+   * The members and groups allowed to call the device.
+   *
+   * @generated from field: repeated homecall.v1alpha.AllowedCaller callers = 2;
+   */
+  callers: AllowedCaller[];
+
+  constructor(data?: PartialMessage<GetAllowedCallersResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.GetAllowedCallersResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAllowedCallersResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAllowedCallersResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAllowedCallersResponse;
+
+  static equals(a: GetAllowedCallersResponse | PlainMessage<GetAllowedCallersResponse> | undefined, b: GetAllowedCallersResponse | PlainMessage<GetAllowedCallersResponse> | undefined): boolean;
+}
+
+/**
+ * SetAllowedCallersRequest is the request for the SetAllowedCallers method.
+ *
+ * @generated from message homecall.v1alpha.SetAllowedCallersRequest
+ */
+export declare class SetAllowedCallersRequest extends Message<SetAllowedCallersRequest> {
+  /**
+   * The ID of the device.
+   *
+   * @generated from field: string device_id = 1;
+   */
+  deviceId: string;
+
+  /**
+   * Whether only admins and the allowed callers may call the device.
+   *
+   * @generated from field: bool restricted = 2;
+   */
+  restricted: boolean;
+
+  /**
+   * The members and groups allowed to call the device, replacing the current ones.
+   *
+   * @generated from field: repeated homecall.v1alpha.AllowedCaller callers = 3;
+   */
+  callers: AllowedCaller[];
+
+  constructor(data?: PartialMessage<SetAllowedCallersRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.SetAllowedCallersRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetAllowedCallersRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetAllowedCallersRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetAllowedCallersRequest;
+
+  static equals(a: SetAllowedCallersRequest | PlainMessage<SetAllowedCallersRequest> | undefined, b: SetAllowedCallersRequest | PlainMessage<SetAllowedCallersRequest> | undefined): boolean;
+}
+
+/**
+ * SetAllowedCallersResponse is the response for the SetAllowedCallers method.
+ *
+ * @generated from message homecall.v1alpha.SetAllowedCallersResponse
+ */
+export declare class SetAllowedCallersResponse extends Message<SetAllowedCallersResponse> {
+  /**
+   * Whether only admins and the allowed callers may call the device.
+   *
+   * @generated from field: bool restricted = 1;
+   */
+  restricted: boolean;
+
+  /**
+   * The members and groups allowed to call the device.
+   *
+   * @generated from field: repeated homecall.v1alpha.AllowedCaller callers = 2;
+   */
+  callers: AllowedCaller[];
+
+  constructor(data?: PartialMessage<SetAllowedCallersResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.SetAllowedCallersResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetAllowedCallersResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetAllowedCallersResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetAllowedCallersResponse;
+
+  static equals(a: SetAllowedCallersResponse | PlainMessage<SetAllowedCallersResponse> | undefined, b: SetAllowedCallersResponse | PlainMessage<SetAllowedCallersResponse> | undefined): boolean;
+}
+
+/**
+ * ListAllowedCallerEventsRequest is the request for the ListAllowedCallerEvents method.
+ *
+ * @generated from message homecall.v1alpha.ListAllowedCallerEventsRequest
+ */
+export declare class ListAllowedCallerEventsRequest extends Message<ListAllowedCallerEventsRequest> {
+  /**
+   * The ID of the device.
+   *
+   * @generated from field: string device_id = 1;
+   */
+  deviceId: string;
+
+  constructor(data?: PartialMessage<ListAllowedCallerEventsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.ListAllowedCallerEventsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAllowedCallerEventsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAllowedCallerEventsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAllowedCallerEventsRequest;
+
+  static equals(a: ListAllowedCallerEventsRequest | PlainMessage<ListAllowedCallerEventsRequest> | undefined, b: ListAllowedCallerEventsRequest | PlainMessage<ListAllowedCallerEventsRequest> | undefined): boolean;
+}
+
+/**
+ * ListAllowedCallerEventsResponse is the response for the ListAllowedCallerEvents method.
+ *
+ * @generated from message homecall.v1alpha.ListAllowedCallerEventsResponse
+ */
+export declare class ListAllowedCallerEventsResponse extends Message<ListAllowedCallerEventsResponse> {
+  /**
+   * The changes, oldest first.
+   *
+   * @generated from field: repeated homecall.v1alpha.AllowedCallerEvent events = 1;
+   */
+  events: AllowedCallerEvent[];
+
+  constructor(data?: PartialMessage<ListAllowedCallerEventsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.ListAllowedCallerEventsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAllowedCallerEventsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAllowedCallerEventsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAllowedCallerEventsResponse;
+
+  static equals(a: ListAllowedCallerEventsResponse | PlainMessage<ListAllowedCallerEventsResponse> | undefined, b: ListAllowedCallerEventsResponse | PlainMessage<ListAllowedCallerEventsResponse> | undefined): boolean;
+}
+
+/**
+ * WatchMissedCallsRequest is the request for the WatchMissedCalls method.
+ *
+ * @generated from message homecall.v1alpha.WatchMissedCallsRequest
+ */
+export declare class WatchMissedCallsRequest extends Message<WatchMissedCallsRequest> {
+  /**
+   * The ID of the tenant to watch missed calls in.
+   *
+   * @generated from field: string tenant_id = 1;
+   */
+  tenantId: string;
+
+  constructor(data?: PartialMessage<WatchMissedCallsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.WatchMissedCallsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchMissedCallsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchMissedCallsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchMissedCallsRequest;
+
+  static equals(a: WatchMissedCallsRequest | PlainMessage<WatchMissedCallsRequest> | undefined, b: WatchMissedCallsRequest | PlainMessage<WatchMissedCallsRequest> | undefined): boolean;
+}
+
+/**
+ * WatchMissedCallsResponse is sent for every missed call of a call the caller started.
+ *
+ * @generated from message homecall.v1alpha.WatchMissedCallsResponse
+ */
+export declare class WatchMissedCallsResponse extends Message<WatchMissedCallsResponse> {
+  /**
+   * The missed call.
+   *
+   * @generated from field: homecall.v1alpha.MissedCall missed_call = 1;
+   */
+  missedCall?: MissedCall;
+
+  constructor(data?: PartialMessage<WatchMissedCallsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.WatchMissedCallsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchMissedCallsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchMissedCallsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchMissedCallsResponse;
+
+  static equals(a: WatchMissedCallsResponse | PlainMessage<WatchMissedCallsResponse> | undefined, b: WatchMissedCallsResponse | PlainMessage<WatchMissedCallsResponse> | undefined): boolean;
+}
+
+/**
+ * ListCallbackRequestsRequest is the request for the ListCallbackRequests method.
+ *
+ * @generated from message homecall.v1alpha.ListCallbackRequestsRequest
+ */
+export declare class ListCallbackRequestsRequest extends Message<ListCallbackRequestsRequest> {
+  /**
+   * The ID of the tenant.
+   *
+   * @generated from field: string tenant_id = 1;
+   */
+  tenantId: string;
+
+  /**
+   * Whether resolved requests are included, otherwise only open requests are returned.
+   *
+   * @generated from field: bool include_resolved = 2;
+   */
+  includeResolved: boolean;
+
+  constructor(data?: PartialMessage<ListCallbackRequestsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.ListCallbackRequestsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListCallbackRequestsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListCallbackRequestsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListCallbackRequestsRequest;
+
+  static equals(a: ListCallbackRequestsRequest | PlainMessage<ListCallbackRequestsRequest> | undefined, b: ListCallbackRequestsRequest | PlainMessage<ListCallbackRequestsRequest> | undefined): boolean;
+}
+
+/**
+ * ListCallbackRequestsResponse is the response for the ListCallbackRequests method.
+ *
+ * @generated from message homecall.v1alpha.ListCallbackRequestsResponse
+ */
+export declare class ListCallbackRequestsResponse extends Message<ListCallbackRequestsResponse> {
+  /**
+   * The callback requests, oldest first.
+   *
+   * @generated from field: repeated homecall.v1alpha.CallbackRequest callback_requests = 1;
+   */
+  callbackRequests: CallbackRequest[];
+
+  constructor(data?: PartialMessage<ListCallbackRequestsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.ListCallbackRequestsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListCallbackRequestsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListCallbackRequestsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListCallbackRequestsResponse;
+
+  static equals(a: ListCallbackRequestsResponse | PlainMessage<ListCallbackRequestsResponse> | undefined, b: ListCallbackRequestsResponse | PlainMessage<ListCallbackRequestsResponse> | undefined): boolean;
+}
+
+/**
+ * ResolveCallbackRequestRequest is the request for the ResolveCallbackRequest method.
+ *
+ * @generated from message homecall.v1alpha.ResolveCallbackRequestRequest
+ */
+export declare class ResolveCallbackRequestRequest extends Message<ResolveCallbackRequestRequest> {
+  /**
+   * The ID of the callback request.
+   *
+   * @generated from field: string callback_request_id = 1;
+   */
+  callbackRequestId: string;
+
+  constructor(data?: PartialMessage<ResolveCallbackRequestRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.ResolveCallbackRequestRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResolveCallbackRequestRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResolveCallbackRequestRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResolveCallbackRequestRequest;
+
+  static equals(a: ResolveCallbackRequestRequest | PlainMessage<ResolveCallbackRequestRequest> | undefined, b: ResolveCallbackRequestRequest | PlainMessage<ResolveCallbackRequestRequest> | undefined): boolean;
+}
+
+/**
+ * ResolveCallbackRequestResponse is the response for the ResolveCallbackRequest method.
+ *
+ * @generated from message homecall.v1alpha.ResolveCallbackRequestResponse
+ */
+export declare class ResolveCallbackRequestResponse extends Message<ResolveCallbackRequestResponse> {
+  /**
+   * The callback request after it was resolved.
+   *
+   * @generated from field: homecall.v1alpha.CallbackRequest callback_request = 1;
+   */
+  callbackRequest?: CallbackRequest;
+
+  constructor(data?: PartialMessage<ResolveCallbackRequestResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.ResolveCallbackRequestResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResolveCallbackRequestResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResolveCallbackRequestResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResolveCallbackRequestResponse;
+
+  static equals(a: ResolveCallbackRequestResponse | PlainMessage<ResolveCallbackRequestResponse> | undefined, b: ResolveCallbackRequestResponse | PlainMessage<ResolveCallbackRequestResponse> | undefined): boolean;
+}
+
+/**
+ * SendMessageRequest is the request for the SendMessage method.
+ *
+ * @generated from message homecall.v1alpha.SendMessageRequest
+ */
+export declare class SendMessageRequest extends Message<SendMessageRequest> {
+  /**
+   * The ID of the device to send the message to.
+   *
+   * @generated from field: string device_id = 1;
+   */
+  deviceId: string;
+
+  /**
+   * The content of the message.
+   *
+   * @generated from oneof homecall.v1alpha.SendMessageRequest.content
+   */
+  content: {
+    /**
+     * A text message.
+     *
+     * @generated from field: string text = 2;
+     */
+    value: string;
+    case: "text";
+  } | {
+    /**
+     * A JPEG, PNG, GIF or WebP photo.
+     *
+     * @generated from field: bytes image = 3;
+     */
+    value: Uint8Array;
+    case: "image";
+  } | { case: undefined; value?: undefined };
+
+  constructor(data?: PartialMessage<SendMessageRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.SendMessageRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SendMessageRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SendMessageRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SendMessageRequest;
+
+  static equals(a: SendMessageRequest | PlainMessage<SendMessageRequest> | undefined, b: SendMessageRequest | PlainMessage<SendMessageRequest> | undefined): boolean;
+}
+
+/**
+ * SendMessageResponse is the response for the SendMessage method.
+ *
+ * @generated from message homecall.v1alpha.SendMessageResponse
+ */
+export declare class SendMessageResponse extends Message<SendMessageResponse> {
+  /**
+   * The message that was sent.
+   *
+   * @generated from field: homecall.v1alpha.DeviceMessage message = 1;
+   */
+  message?: DeviceMessage;
+
+  constructor(data?: PartialMessage<SendMessageResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.SendMessageResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SendMessageResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SendMessageResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SendMessageResponse;
+
+  static equals(a: SendMessageResponse | PlainMessage<SendMessageResponse> | undefined, b: SendMessageResponse | PlainMessage<SendMessageResponse> | undefined): boolean;
+}
+
+/**
+ * ListDeviceMessagesRequest is the request for the ListDeviceMessages method.
+ *
+ * @generated from message homecall.v1alpha.ListDeviceMessagesRequest
+ */
+export declare class ListDeviceMessagesRequest extends Message<ListDeviceMessagesRequest> {
+  /**
+   * The ID of the device.
+   *
+   * @generated from field: string device_id = 1;
+   */
+  deviceId: string;
+
+  constructor(data?: PartialMessage<ListDeviceMessagesRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.ListDeviceMessagesRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListDeviceMessagesRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListDeviceMessagesRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListDeviceMessagesRequest;
+
+  static equals(a: ListDeviceMessagesRequest | PlainMessage<ListDeviceMessagesRequest> | undefined, b: ListDeviceMessagesRequest | PlainMessage<ListDeviceMessagesRequest> | undefined): boolean;
+}
+
+/**
+ * ListDeviceMessagesResponse is the response for the ListDeviceMessages method.
+ *
+ * @generated from message homecall.v1alpha.ListDeviceMessagesResponse
+ */
+export declare class ListDeviceMessagesResponse extends Message<ListDeviceMessagesResponse> {
+  /**
+   * The messages, newest first, photos are downloaded with GetDeviceMessageImage.
+   *
+   * @generated from field: repeated homecall.v1alpha.DeviceMessage messages = 1;
+   */
+  messages: DeviceMessage[];
+
+  constructor(data?: PartialMessage<ListDeviceMessagesResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.ListDeviceMessagesResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListDeviceMessagesResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListDeviceMessagesResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListDeviceMessagesResponse;
+
+  static equals(a: ListDeviceMessagesResponse | PlainMessage<ListDeviceMessagesResponse> | undefined, b: ListDeviceMessagesResponse | PlainMessage<ListDeviceMessagesResponse> | undefined): boolean;
+}
+
+/**
+ * GetDeviceMessageImageRequest is the request for the GetDeviceMessageImage method.
+ *
+ * @generated from message homecall.v1alpha.GetDeviceMessageImageRequest
+ */
+export declare class GetDeviceMessageImageRequest extends Message<GetDeviceMessageImageRequest> {
+  /**
+   * The ID of the message.
+   *
+   * @generated from field: string message_id = 1;
+   */
+  messageId: string;
+
+  constructor(data?: PartialMessage<GetDeviceMessageImageRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.GetDeviceMessageImageRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetDeviceMessageImageRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetDeviceMessageImageRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetDeviceMessageImageRequest;
+
+  static equals(a: GetDeviceMessageImageRequest | PlainMessage<GetDeviceMessageImageRequest> | undefined, b: GetDeviceMessageImageRequest | PlainMessage<GetDeviceMessageImageRequest> | undefined): boolean;
+}
+
+/**
+ * GetDeviceMessageImageResponse is the response for the GetDeviceMessageImage method.
+ *
+ * @generated from message homecall.v1alpha.GetDeviceMessageImageResponse
+ */
+export declare class GetDeviceMessageImageResponse extends Message<GetDeviceMessageImageResponse> {
+  /**
+   * The image, without its content.
+   *
+   * @generated from field: homecall.v1alpha.MessageImage image = 1;
+   */
+  image?: MessageImage;
+
+  /**
+   * The content of the image.
+   *
+   * @generated from field: bytes data = 2;
+   */
+  data: Uint8Array;
+
+  constructor(data?: PartialMessage<GetDeviceMessageImageResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.GetDeviceMessageImageResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetDeviceMessageImageResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetDeviceMessageImageResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetDeviceMessageImageResponse;
+
+  static equals(a: GetDeviceMessageImageResponse | PlainMessage<GetDeviceMessageImageResponse> | undefined, b: GetDeviceMessageImageResponse | PlainMessage<GetDeviceMessageImageResponse> | undefined): boolean;
+}
+
+/**
+ * CreateAlbumRequest is the request for the CreateAlbum method.
+ *
+ * @generated from message homecall.v1alpha.CreateAlbumRequest
+ */
+export declare class CreateAlbumRequest extends Message<CreateAlbumRequest> {
+  /**
+   * The ID of the tenant to create the album in.
+   *
+   * @generated from field: string tenant_id = 1;
+   */
+  tenantId: string;
+
+  /**
+   * The name of the album.
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * Where the album is shown, one of them is required.
+   *
+   * @generated from oneof homecall.v1alpha.CreateAlbumRequest.target
+   */
+  target: {
+    /**
+     * The ID of the device that shows the album.
+     *
+     * @generated from field: string device_id = 3;
+     */
+    value: string;
+    case: "deviceId";
+  } | {
+    /**
+     * The ID of the device group whose devices show the album.
+     *
+     * @generated from field: string group_id = 4;
+     */
+    value: string;
+    case: "groupId";
+  } | { case: undefined; value?: undefined };
+
+  constructor(data?: PartialMessage<CreateAlbumRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.CreateAlbumRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateAlbumRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateAlbumRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateAlbumRequest;
+
+  static equals(a: CreateAlbumRequest | PlainMessage<CreateAlbumRequest> | undefined, b: CreateAlbumRequest | PlainMessage<CreateAlbumRequest> | undefined): boolean;
+}
+
+/**
+ * CreateAlbumResponse is the response for the CreateAlbum method.
+ *
+ * @generated from message homecall.v1alpha.CreateAlbumResponse
+ */
+export declare class CreateAlbumResponse extends Message<CreateAlbumResponse> {
+  /**
+   * The album that was created.
+   *
+   * @generated from field: homecall.v1alpha.Album album = 1;
+   */
+  album?: Album;
+
+  constructor(data?: PartialMessage<CreateAlbumResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.CreateAlbumResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateAlbumResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateAlbumResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateAlbumResponse;
+
+  static equals(a: CreateAlbumResponse | PlainMessage<CreateAlbumResponse> | undefined, b: CreateAlbumResponse | PlainMessage<CreateAlbumResponse> | undefined): boolean;
+}
+
+/**
+ * ListAlbumsRequest is the request for the ListAlbums method.
+ *
+ * @generated from message homecall.v1alpha.ListAlbumsRequest
+ */
+export declare class ListAlbumsRequest extends Message<ListAlbumsRequest> {
+  /**
+   * The ID of the tenant.
+   *
+   * @generated from field: string tenant_id = 1;
+   */
+  tenantId: string;
+
+  constructor(data?: PartialMessage<ListAlbumsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.ListAlbumsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAlbumsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAlbumsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAlbumsRequest;
+
+  static equals(a: ListAlbumsRequest | PlainMessage<ListAlbumsRequest> | undefined, b: ListAlbumsRequest | PlainMessage<ListAlbumsRequest> | undefined): boolean;
+}
+
+/**
+ * ListAlbumsResponse is the response for the ListAlbums method.
+ *
+ * @generated from message homecall.v1alpha.ListAlbumsResponse
+ */
+export declare class ListAlbumsResponse extends Message<ListAlbumsResponse> {
+  /**
+   * The albums, oldest first.
+   *
+   * @generated from field: repeated homecall.v1alpha.Album albums = 1;
+   */
+  albums: Album[];
+
+  constructor(data?: PartialMessage<ListAlbumsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.ListAlbumsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAlbumsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAlbumsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAlbumsResponse;
+
+  static equals(a: ListAlbumsResponse | PlainMessage<ListAlbumsResponse> | undefined, b: ListAlbumsResponse | PlainMessage<ListAlbumsResponse> | undefined): boolean;
+}
+
+/**
+ * DeleteAlbumRequest is the request for the DeleteAlbum method.
+ *
+ * @generated from message homecall.v1alpha.DeleteAlbumRequest
+ */
+export declare class DeleteAlbumRequest extends Message<DeleteAlbumRequest> {
+  /**
+   * The ID of the album.
+   *
+   * @generated from field: string album_id = 1;
+   */
+  albumId: string;
+
+  constructor(data?: PartialMessage<DeleteAlbumRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.DeleteAlbumRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteAlbumRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteAlbumRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteAlbumRequest;
+
+  static equals(a: DeleteAlbumRequest | PlainMessage<DeleteAlbumRequest> | undefined, b: DeleteAlbumRequest | PlainMessage<DeleteAlbumRequest> | undefined): boolean;
+}
+
+/**
+ * DeleteAlbumResponse is the response for the DeleteAlbum method.
+ *
+ * @generated from message homecall.v1alpha.DeleteAlbumResponse
+ */
+export declare class DeleteAlbumResponse extends Message<DeleteAlbumResponse> {
+  constructor(data?: PartialMessage<DeleteAlbumResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.DeleteAlbumResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteAlbumResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteAlbumResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteAlbumResponse;
+
+  static equals(a: DeleteAlbumResponse | PlainMessage<DeleteAlbumResponse> | undefined, b: DeleteAlbumResponse | PlainMessage<DeleteAlbumResponse> | undefined): boolean;
+}
+
+/**
+ * AddAlbumImageRequest is the request for the AddAlbumImage method.
+ *
+ * @generated from message homecall.v1alpha.AddAlbumImageRequest
+ */
+export declare class AddAlbumImageRequest extends Message<AddAlbumImageRequest> {
+  /**
+   * The ID of the album.
+   *
+   * @generated from field: string album_id = 1;
+   */
+  albumId: string;
+
+  /**
+   * A JPEG, PNG, GIF or WebP photo.
+   *
+   * @generated from field: bytes image = 2;
+   */
+  image: Uint8Array;
+
+  constructor(data?: PartialMessage<AddAlbumImageRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.AddAlbumImageRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddAlbumImageRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddAlbumImageRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddAlbumImageRequest;
+
+  static equals(a: AddAlbumImageRequest | PlainMessage<AddAlbumImageRequest> | undefined, b: AddAlbumImageRequest | PlainMessage<AddAlbumImageRequest> | undefined): boolean;
+}
+
+/**
+ * AddAlbumImageResponse is the response for the AddAlbumImage method.
+ *
+ * @generated from message homecall.v1alpha.AddAlbumImageResponse
+ */
+export declare class AddAlbumImageResponse extends Message<AddAlbumImageResponse> {
+  /**
+   * The image that was added.
+   *
+   * @generated from field: homecall.v1alpha.AlbumImage image = 1;
+   */
+  image?: AlbumImage;
+
+  constructor(data?: PartialMessage<AddAlbumImageResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.AddAlbumImageResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddAlbumImageResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddAlbumImageResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddAlbumImageResponse;
+
+  static equals(a: AddAlbumImageResponse | PlainMessage<AddAlbumImageResponse> | undefined, b: AddAlbumImageResponse | PlainMessage<AddAlbumImageResponse> | undefined): boolean;
+}
+
+/**
+ * RemoveAlbumImageRequest is the request for the RemoveAlbumImage method.
+ *
+ * @generated from message homecall.v1alpha.RemoveAlbumImageRequest
+ */
+export declare class RemoveAlbumImageRequest extends Message<RemoveAlbumImageRequest> {
+  /**
+   * The ID of the image.
+   *
+   * @generated from field: string image_id = 1;
+   */
+  imageId: string;
+
+  constructor(data?: PartialMessage<RemoveAlbumImageRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.RemoveAlbumImageRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveAlbumImageRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveAlbumImageRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveAlbumImageRequest;
+
+  static equals(a: RemoveAlbumImageRequest | PlainMessage<RemoveAlbumImageRequest> | undefined, b: RemoveAlbumImageRequest | PlainMessage<RemoveAlbumImageRequest> | undefined): boolean;
+}
+
+/**
+ * RemoveAlbumImageResponse is the response for the RemoveAlbumImage method.
+ *
+ * @generated from message homecall.v1alpha.RemoveAlbumImageResponse
+ */
+export declare class RemoveAlbumImageResponse extends Message<RemoveAlbumImageResponse> {
+  constructor(data?: PartialMessage<RemoveAlbumImageResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.RemoveAlbumImageResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveAlbumImageResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveAlbumImageResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveAlbumImageResponse;
+
+  static equals(a: RemoveAlbumImageResponse | PlainMessage<RemoveAlbumImageResponse> | undefined, b: RemoveAlbumImageResponse | PlainMessage<RemoveAlbumImageResponse> | undefined): boolean;
 }
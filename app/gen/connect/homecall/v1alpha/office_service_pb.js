@@ -3,8 +3,52 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { proto3 } from "@bufbuild/protobuf";
-import { DeviceSettings } from "./settings_pb.js";
+import { proto3, Timestamp } from "@bufbuild/protobuf";
+import { AutoAnswerPolicy, DeviceSettings, QuietHours } from "./settings_pb.js";
+import { Call, CallParticipant } from "./call_pb.js";
+import { DeviceDiagnosticsReport } from "./diagnostics_pb.js";
+import { DeviceState } from "./device_state_pb.js";
+import { CalendarFeedToken, CallSchedule, ScheduledCall, ScheduledCallEventType } from "./scheduled_call_pb.js";
+import { AllowedCaller, AllowedCallerEvent } from "./allowed_caller_pb.js";
+import { CallbackRequest, MissedCall } from "./missed_call_pb.js";
+import { DeviceMessage, MessageImage } from "./device_message_pb.js";
+import { Album, AlbumImage } from "./album_pb.js";
+
+/**
+ * DeviceEventType represents the type of change to a device.
+ *
+ * @generated from enum homecall.v1alpha.DeviceEventType
+ */
+export const DeviceEventType = /*@__PURE__*/ proto3.makeEnum(
+  "homecall.v1alpha.DeviceEventType",
+  [
+    {no: 0, name: "DEVICE_EVENT_TYPE_UNSPECIFIED", localName: "UNSPECIFIED"},
+    {no: 1, name: "DEVICE_EVENT_TYPE_ADDED", localName: "ADDED"},
+    {no: 2, name: "DEVICE_EVENT_TYPE_REMOVED", localName: "REMOVED"},
+    {no: 3, name: "DEVICE_EVENT_TYPE_RENAMED", localName: "RENAMED"},
+    {no: 4, name: "DEVICE_EVENT_TYPE_ENROLLED", localName: "ENROLLED"},
+    {no: 5, name: "DEVICE_EVENT_TYPE_ONLINE", localName: "ONLINE"},
+    {no: 6, name: "DEVICE_EVENT_TYPE_OFFLINE", localName: "OFFLINE"},
+    {no: 7, name: "DEVICE_EVENT_TYPE_ENROLLMENT_RESET", localName: "ENROLLMENT_RESET"},
+    {no: 8, name: "DEVICE_EVENT_TYPE_DISABLED", localName: "DISABLED"},
+    {no: 9, name: "DEVICE_EVENT_TYPE_ENABLED", localName: "ENABLED"},
+    {no: 10, name: "DEVICE_EVENT_TYPE_GROUP_CHANGED", localName: "GROUP_CHANGED"},
+  ],
+);
+
+/**
+ * DeviceLogStatus represents the status of a requested log.
+ *
+ * @generated from enum homecall.v1alpha.DeviceLogStatus
+ */
+export const DeviceLogStatus = /*@__PURE__*/ proto3.makeEnum(
+  "homecall.v1alpha.DeviceLogStatus",
+  [
+    {no: 0, name: "DEVICE_LOG_STATUS_UNSPECIFIED", localName: "UNSPECIFIED"},
+    {no: 1, name: "DEVICE_LOG_STATUS_REQUESTED", localName: "REQUESTED"},
+    {no: 2, name: "DEVICE_LOG_STATUS_UPLOADED", localName: "UPLOADED"},
+  ],
+);
 
 /**
  * DeviceSettings contains the settings for a device.
@@ -17,6 +61,8 @@ export const CreateDeviceRequest = /*@__PURE__*/ proto3.makeMessageType(
     { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "default_settings", kind: "message", T: DeviceSettings },
     { no: 3, name: "tenant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "group_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "time_zone", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ],
 );
 
@@ -41,6 +87,7 @@ export const ListDevicesRequest = /*@__PURE__*/ proto3.makeMessageType(
   "homecall.v1alpha.ListDevicesRequest",
   () => [
     { no: 1, name: "tenant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "group_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ],
 );
 
@@ -58,6 +105,7 @@ export const ListDevicesResponse = /*@__PURE__*/ proto3.makeMessageType(
 
 /**
  * StartCallRequest is the request for the StartCall method.
+ * At least one device must be set, all devices must belong to the same tenant.
  *
  * @generated from message homecall.v1alpha.StartCallRequest
  */
@@ -65,6 +113,9 @@ export const StartCallRequest = /*@__PURE__*/ proto3.makeMessageType(
   "homecall.v1alpha.StartCallRequest",
   () => [
     { no: 1, name: "device_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "device_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "member_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "override_quiet_hours", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ],
 );
 
@@ -79,6 +130,108 @@ export const StartCallResponse = /*@__PURE__*/ proto3.makeMessageType(
     { no: 1, name: "call_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "jitsi_room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "jitsi_jwt", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "call", kind: "message", T: Call },
+    { no: 5, name: "unreachable_device_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 6, name: "disabled_device_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ],
+);
+
+/**
+ * GetCallRequest is the request for the GetCall method.
+ *
+ * @generated from message homecall.v1alpha.GetCallRequest
+ */
+export const GetCallRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.GetCallRequest",
+  () => [
+    { no: 1, name: "call_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * GetCallResponse is the response for the GetCall method.
+ *
+ * @generated from message homecall.v1alpha.GetCallResponse
+ */
+export const GetCallResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.GetCallResponse",
+  () => [
+    { no: 1, name: "call", kind: "message", T: Call },
+  ],
+);
+
+/**
+ * InviteToCallRequest is the request for the InviteToCall method.
+ *
+ * @generated from message homecall.v1alpha.InviteToCallRequest
+ */
+export const InviteToCallRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.InviteToCallRequest",
+  () => [
+    { no: 1, name: "call_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "member_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * InviteToCallResponse is the response for the InviteToCall method.
+ *
+ * @generated from message homecall.v1alpha.InviteToCallResponse
+ */
+export const InviteToCallResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.InviteToCallResponse",
+  () => [
+    { no: 1, name: "participant", kind: "message", T: CallParticipant },
+  ],
+);
+
+/**
+ * JoinCallRequest is the request for the JoinCall method.
+ *
+ * @generated from message homecall.v1alpha.JoinCallRequest
+ */
+export const JoinCallRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.JoinCallRequest",
+  () => [
+    { no: 1, name: "call_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * JoinCallResponse contains the details to join a call.
+ *
+ * @generated from message homecall.v1alpha.JoinCallResponse
+ */
+export const JoinCallResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.JoinCallResponse",
+  () => [
+    { no: 1, name: "call_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "jitsi_room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "jitsi_jwt", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * WatchCallInvitationsRequest is the request for the WatchCallInvitations method.
+ *
+ * @generated from message homecall.v1alpha.WatchCallInvitationsRequest
+ */
+export const WatchCallInvitationsRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.WatchCallInvitationsRequest",
+  () => [
+    { no: 1, name: "tenant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * WatchCallInvitationsResponse is sent for every call the caller is invited to.
+ *
+ * @generated from message homecall.v1alpha.WatchCallInvitationsResponse
+ */
+export const WatchCallInvitationsResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.WatchCallInvitationsResponse",
+  () => [
+    { no: 1, name: "call", kind: "message", T: Call },
   ],
 );
 
@@ -116,6 +269,10 @@ export const UpdateDeviceRequest = /*@__PURE__*/ proto3.makeMessageType(
   () => [
     { no: 1, name: "device_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "time_zone", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "quiet_hours", kind: "message", T: QuietHours },
+    { no: 5, name: "clear_quiet_hours", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "auto_answer", kind: "message", T: AutoAnswerPolicy },
   ],
 );
 
@@ -155,6 +312,269 @@ export const WaitForEnrollmentResponse = /*@__PURE__*/ proto3.makeMessageType(
   ],
 );
 
+/**
+ * WatchDevicesRequest is the request for the WatchDevices method.
+ *
+ * @generated from message homecall.v1alpha.WatchDevicesRequest
+ */
+export const WatchDevicesRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.WatchDevicesRequest",
+  () => [
+    { no: 1, name: "tenant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * WatchDevicesResponse is the response for the WatchDevices method.
+ *
+ * @generated from message homecall.v1alpha.WatchDevicesResponse
+ */
+export const WatchDevicesResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.WatchDevicesResponse",
+  () => [
+    { no: 1, name: "snapshot", kind: "message", T: DeviceSnapshot, oneof: "event" },
+    { no: 2, name: "device_event", kind: "message", T: DeviceEvent, oneof: "event" },
+  ],
+);
+
+/**
+ * DeviceSnapshot contains all devices of a tenant at a point in time.
+ *
+ * @generated from message homecall.v1alpha.DeviceSnapshot
+ */
+export const DeviceSnapshot = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.DeviceSnapshot",
+  () => [
+    { no: 1, name: "devices", kind: "message", T: Device, repeated: true },
+  ],
+);
+
+/**
+ * DeviceEvent describes a change to a device.
+ *
+ * @generated from message homecall.v1alpha.DeviceEvent
+ */
+export const DeviceEvent = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.DeviceEvent",
+  () => [
+    { no: 1, name: "type", kind: "enum", T: proto3.getEnumType(DeviceEventType) },
+    { no: 2, name: "device", kind: "message", T: Device },
+  ],
+);
+
+/**
+ * GetDeviceDiagnosticsRequest is the request for the GetDeviceDiagnostics method.
+ *
+ * @generated from message homecall.v1alpha.GetDeviceDiagnosticsRequest
+ */
+export const GetDeviceDiagnosticsRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.GetDeviceDiagnosticsRequest",
+  () => [
+    { no: 1, name: "device_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ],
+);
+
+/**
+ * GetDeviceDiagnosticsResponse is the response for the GetDeviceDiagnostics method.
+ *
+ * @generated from message homecall.v1alpha.GetDeviceDiagnosticsResponse
+ */
+export const GetDeviceDiagnosticsResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.GetDeviceDiagnosticsResponse",
+  () => [
+    { no: 1, name: "reports", kind: "message", T: DeviceDiagnosticsReport, repeated: true },
+  ],
+);
+
+/**
+ * RegenerateEnrollmentKeyRequest is the request for the RegenerateEnrollmentKey method.
+ *
+ * @generated from message homecall.v1alpha.RegenerateEnrollmentKeyRequest
+ */
+export const RegenerateEnrollmentKeyRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.RegenerateEnrollmentKeyRequest",
+  () => [
+    { no: 1, name: "device_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * RegenerateEnrollmentKeyResponse is the response for the RegenerateEnrollmentKey method.
+ *
+ * @generated from message homecall.v1alpha.RegenerateEnrollmentKeyResponse
+ */
+export const RegenerateEnrollmentKeyResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.RegenerateEnrollmentKeyResponse",
+  () => [
+    { no: 1, name: "device", kind: "message", T: Device },
+  ],
+);
+
+/**
+ * ResetDeviceEnrollmentRequest is the request for the ResetDeviceEnrollment method.
+ *
+ * @generated from message homecall.v1alpha.ResetDeviceEnrollmentRequest
+ */
+export const ResetDeviceEnrollmentRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.ResetDeviceEnrollmentRequest",
+  () => [
+    { no: 1, name: "device_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "settings", kind: "message", T: DeviceSettings },
+  ],
+);
+
+/**
+ * ResetDeviceEnrollmentResponse is the response for the ResetDeviceEnrollment method.
+ *
+ * @generated from message homecall.v1alpha.ResetDeviceEnrollmentResponse
+ */
+export const ResetDeviceEnrollmentResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.ResetDeviceEnrollmentResponse",
+  () => [
+    { no: 1, name: "device", kind: "message", T: Device },
+  ],
+);
+
+/**
+ * DisableDeviceRequest is the request for the DisableDevice method.
+ *
+ * @generated from message homecall.v1alpha.DisableDeviceRequest
+ */
+export const DisableDeviceRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.DisableDeviceRequest",
+  () => [
+    { no: 1, name: "device_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * DisableDeviceResponse is the response for the DisableDevice method.
+ *
+ * @generated from message homecall.v1alpha.DisableDeviceResponse
+ */
+export const DisableDeviceResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.DisableDeviceResponse",
+  () => [
+    { no: 1, name: "device", kind: "message", T: Device },
+  ],
+);
+
+/**
+ * EnableDeviceRequest is the request for the EnableDevice method.
+ *
+ * @generated from message homecall.v1alpha.EnableDeviceRequest
+ */
+export const EnableDeviceRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.EnableDeviceRequest",
+  () => [
+    { no: 1, name: "device_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * EnableDeviceResponse is the response for the EnableDevice method.
+ *
+ * @generated from message homecall.v1alpha.EnableDeviceResponse
+ */
+export const EnableDeviceResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.EnableDeviceResponse",
+  () => [
+    { no: 1, name: "device", kind: "message", T: Device },
+  ],
+);
+
+/**
+ * RequestDeviceLogsRequest is the request for the RequestDeviceLogs method.
+ *
+ * @generated from message homecall.v1alpha.RequestDeviceLogsRequest
+ */
+export const RequestDeviceLogsRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.RequestDeviceLogsRequest",
+  () => [
+    { no: 1, name: "device_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * RequestDeviceLogsResponse is the response for the RequestDeviceLogs method.
+ *
+ * @generated from message homecall.v1alpha.RequestDeviceLogsResponse
+ */
+export const RequestDeviceLogsResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.RequestDeviceLogsResponse",
+  () => [
+    { no: 1, name: "log", kind: "message", T: DeviceLog },
+  ],
+);
+
+/**
+ * ListDeviceLogsRequest is the request for the ListDeviceLogs method.
+ *
+ * @generated from message homecall.v1alpha.ListDeviceLogsRequest
+ */
+export const ListDeviceLogsRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.ListDeviceLogsRequest",
+  () => [
+    { no: 1, name: "device_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * ListDeviceLogsResponse is the response for the ListDeviceLogs method.
+ *
+ * @generated from message homecall.v1alpha.ListDeviceLogsResponse
+ */
+export const ListDeviceLogsResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.ListDeviceLogsResponse",
+  () => [
+    { no: 1, name: "logs", kind: "message", T: DeviceLog, repeated: true },
+  ],
+);
+
+/**
+ * DownloadDeviceLogRequest is the request for the DownloadDeviceLog method.
+ *
+ * @generated from message homecall.v1alpha.DownloadDeviceLogRequest
+ */
+export const DownloadDeviceLogRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.DownloadDeviceLogRequest",
+  () => [
+    { no: 1, name: "log_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * DownloadDeviceLogResponse is the response for the DownloadDeviceLog method.
+ *
+ * @generated from message homecall.v1alpha.DownloadDeviceLogResponse
+ */
+export const DownloadDeviceLogResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.DownloadDeviceLogResponse",
+  () => [
+    { no: 1, name: "log", kind: "message", T: DeviceLog },
+    { no: 2, name: "content", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ],
+);
+
+/**
+ * DeviceLog represents a log file requested from a device.
+ *
+ * @generated from message homecall.v1alpha.DeviceLog
+ */
+export const DeviceLog = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.DeviceLog",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "device_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "status", kind: "enum", T: proto3.getEnumType(DeviceLogStatus) },
+    { no: 4, name: "requested_at", kind: "message", T: Timestamp },
+    { no: 5, name: "uploaded_at", kind: "message", T: Timestamp },
+    { no: 6, name: "content_encoding", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "size_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ],
+);
+
 /**
  * Device represents a device.
  *
@@ -168,5 +588,825 @@ export const Device = /*@__PURE__*/ proto3.makeMessageType(
     { no: 3, name: "enrollment_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "online", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 5, name: "tenant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "last_seen", kind: "message", T: Timestamp },
+    { no: 7, name: "state", kind: "message", T: DeviceState },
+    { no: 8, name: "enrolled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 9, name: "enrollment_key_expires_at", kind: "message", T: Timestamp },
+    { no: 10, name: "pairing_code", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 11, name: "pairing_code_expires_at", kind: "message", T: Timestamp },
+    { no: 12, name: "enrollment_qr_payload", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 13, name: "disabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 14, name: "disabled_at", kind: "message", T: Timestamp },
+    { no: 15, name: "group_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 16, name: "time_zone", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 17, name: "quiet_hours", kind: "message", T: QuietHours },
+    { no: 18, name: "auto_answer", kind: "message", T: AutoAnswerPolicy },
+  ],
+);
+
+/**
+ * DeviceGroup is a group of devices within a tenant, e.g. a household or a ward.
+ *
+ * @generated from message homecall.v1alpha.DeviceGroup
+ */
+export const DeviceGroup = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.DeviceGroup",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "tenant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * CreateDeviceGroupRequest is the request for the CreateDeviceGroup method.
+ *
+ * @generated from message homecall.v1alpha.CreateDeviceGroupRequest
+ */
+export const CreateDeviceGroupRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.CreateDeviceGroupRequest",
+  () => [
+    { no: 1, name: "tenant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ],
 );
+
+/**
+ * CreateDeviceGroupResponse is the response for the CreateDeviceGroup method.
+ *
+ * @generated from message homecall.v1alpha.CreateDeviceGroupResponse
+ */
+export const CreateDeviceGroupResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.CreateDeviceGroupResponse",
+  () => [
+    { no: 1, name: "group", kind: "message", T: DeviceGroup },
+  ],
+);
+
+/**
+ * ListDeviceGroupsRequest is the request for the ListDeviceGroups method.
+ *
+ * @generated from message homecall.v1alpha.ListDeviceGroupsRequest
+ */
+export const ListDeviceGroupsRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.ListDeviceGroupsRequest",
+  () => [
+    { no: 1, name: "tenant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * ListDeviceGroupsResponse is the response for the ListDeviceGroups method.
+ *
+ * @generated from message homecall.v1alpha.ListDeviceGroupsResponse
+ */
+export const ListDeviceGroupsResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.ListDeviceGroupsResponse",
+  () => [
+    { no: 1, name: "groups", kind: "message", T: DeviceGroup, repeated: true },
+  ],
+);
+
+/**
+ * UpdateDeviceGroupRequest is the request for the UpdateDeviceGroup method.
+ *
+ * @generated from message homecall.v1alpha.UpdateDeviceGroupRequest
+ */
+export const UpdateDeviceGroupRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.UpdateDeviceGroupRequest",
+  () => [
+    { no: 1, name: "group_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * UpdateDeviceGroupResponse is the response for the UpdateDeviceGroup method.
+ *
+ * @generated from message homecall.v1alpha.UpdateDeviceGroupResponse
+ */
+export const UpdateDeviceGroupResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.UpdateDeviceGroupResponse",
+  () => [
+    { no: 1, name: "group", kind: "message", T: DeviceGroup },
+  ],
+);
+
+/**
+ * RemoveDeviceGroupRequest is the request for the RemoveDeviceGroup method.
+ *
+ * @generated from message homecall.v1alpha.RemoveDeviceGroupRequest
+ */
+export const RemoveDeviceGroupRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.RemoveDeviceGroupRequest",
+  () => [
+    { no: 1, name: "group_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * RemoveDeviceGroupResponse is the response for the RemoveDeviceGroup method.
+ *
+ * @generated from message homecall.v1alpha.RemoveDeviceGroupResponse
+ */
+export const RemoveDeviceGroupResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.RemoveDeviceGroupResponse",
+  [],
+);
+
+/**
+ * SetDeviceGroupRequest is the request for the SetDeviceGroup method.
+ *
+ * @generated from message homecall.v1alpha.SetDeviceGroupRequest
+ */
+export const SetDeviceGroupRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.SetDeviceGroupRequest",
+  () => [
+    { no: 1, name: "device_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "group_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * SetDeviceGroupResponse is the response for the SetDeviceGroup method.
+ *
+ * @generated from message homecall.v1alpha.SetDeviceGroupResponse
+ */
+export const SetDeviceGroupResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.SetDeviceGroupResponse",
+  () => [
+    { no: 1, name: "device", kind: "message", T: Device },
+  ],
+);
+
+/**
+ * ScheduleCallRequest is the request for the ScheduleCall method.
+ *
+ * @generated from message homecall.v1alpha.ScheduleCallRequest
+ */
+export const ScheduleCallRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.ScheduleCallRequest",
+  () => [
+    { no: 1, name: "device_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "starts_at", kind: "message", T: Timestamp },
+    { no: 3, name: "duration_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "assigned_member_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * ScheduleCallResponse is the response for the ScheduleCall method.
+ *
+ * @generated from message homecall.v1alpha.ScheduleCallResponse
+ */
+export const ScheduleCallResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.ScheduleCallResponse",
+  () => [
+    { no: 1, name: "scheduled_call", kind: "message", T: ScheduledCall },
+  ],
+);
+
+/**
+ * ListScheduledCallsRequest is the request for the ListScheduledCalls method.
+ *
+ * @generated from message homecall.v1alpha.ListScheduledCallsRequest
+ */
+export const ListScheduledCallsRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.ListScheduledCallsRequest",
+  () => [
+    { no: 1, name: "tenant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "from", kind: "message", T: Timestamp },
+    { no: 3, name: "to", kind: "message", T: Timestamp },
+    { no: 4, name: "device_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "assigned_member_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * ListScheduledCallsResponse is the response for the ListScheduledCalls method.
+ *
+ * @generated from message homecall.v1alpha.ListScheduledCallsResponse
+ */
+export const ListScheduledCallsResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.ListScheduledCallsResponse",
+  () => [
+    { no: 1, name: "scheduled_calls", kind: "message", T: ScheduledCall, repeated: true },
+  ],
+);
+
+/**
+ * UpdateScheduledCallRequest is the request for the UpdateScheduledCall method.
+ *
+ * @generated from message homecall.v1alpha.UpdateScheduledCallRequest
+ */
+export const UpdateScheduledCallRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.UpdateScheduledCallRequest",
+  () => [
+    { no: 1, name: "scheduled_call_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "starts_at", kind: "message", T: Timestamp },
+    { no: 3, name: "duration_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "assigned_member_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * UpdateScheduledCallResponse is the response for the UpdateScheduledCall method.
+ *
+ * @generated from message homecall.v1alpha.UpdateScheduledCallResponse
+ */
+export const UpdateScheduledCallResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.UpdateScheduledCallResponse",
+  () => [
+    { no: 1, name: "scheduled_call", kind: "message", T: ScheduledCall },
+  ],
+);
+
+/**
+ * CancelScheduledCallRequest is the request for the CancelScheduledCall method.
+ *
+ * @generated from message homecall.v1alpha.CancelScheduledCallRequest
+ */
+export const CancelScheduledCallRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.CancelScheduledCallRequest",
+  () => [
+    { no: 1, name: "scheduled_call_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * CancelScheduledCallResponse is the response for the CancelScheduledCall method.
+ *
+ * @generated from message homecall.v1alpha.CancelScheduledCallResponse
+ */
+export const CancelScheduledCallResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.CancelScheduledCallResponse",
+  () => [
+    { no: 1, name: "scheduled_call", kind: "message", T: ScheduledCall },
+  ],
+);
+
+/**
+ * WatchScheduledCallsRequest is the request for the WatchScheduledCalls method.
+ *
+ * @generated from message homecall.v1alpha.WatchScheduledCallsRequest
+ */
+export const WatchScheduledCallsRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.WatchScheduledCallsRequest",
+  () => [
+    { no: 1, name: "tenant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * WatchScheduledCallsResponse is sent for every event about a scheduled call the caller is assigned to.
+ *
+ * @generated from message homecall.v1alpha.WatchScheduledCallsResponse
+ */
+export const WatchScheduledCallsResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.WatchScheduledCallsResponse",
+  () => [
+    { no: 1, name: "type", kind: "enum", T: proto3.getEnumType(ScheduledCallEventType) },
+    { no: 2, name: "scheduled_call", kind: "message", T: ScheduledCall },
+  ],
+);
+
+/**
+ * CreateCallScheduleRequest is the request for the CreateCallSchedule method.
+ *
+ * @generated from message homecall.v1alpha.CreateCallScheduleRequest
+ */
+export const CreateCallScheduleRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.CreateCallScheduleRequest",
+  () => [
+    { no: 1, name: "device_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "rrule", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "starts_at", kind: "message", T: Timestamp },
+    { no: 4, name: "duration_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "assigned_member_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * CreateCallScheduleResponse is the response for the CreateCallSchedule method.
+ *
+ * @generated from message homecall.v1alpha.CreateCallScheduleResponse
+ */
+export const CreateCallScheduleResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.CreateCallScheduleResponse",
+  () => [
+    { no: 1, name: "call_schedule", kind: "message", T: CallSchedule },
+  ],
+);
+
+/**
+ * ListCallSchedulesRequest is the request for the ListCallSchedules method.
+ *
+ * @generated from message homecall.v1alpha.ListCallSchedulesRequest
+ */
+export const ListCallSchedulesRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.ListCallSchedulesRequest",
+  () => [
+    { no: 1, name: "tenant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "device_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * ListCallSchedulesResponse is the response for the ListCallSchedules method.
+ *
+ * @generated from message homecall.v1alpha.ListCallSchedulesResponse
+ */
+export const ListCallSchedulesResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.ListCallSchedulesResponse",
+  () => [
+    { no: 1, name: "call_schedules", kind: "message", T: CallSchedule, repeated: true },
+  ],
+);
+
+/**
+ * UpdateCallScheduleRequest is the request for the UpdateCallSchedule method.
+ *
+ * @generated from message homecall.v1alpha.UpdateCallScheduleRequest
+ */
+export const UpdateCallScheduleRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.UpdateCallScheduleRequest",
+  () => [
+    { no: 1, name: "schedule_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "rrule", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "starts_at", kind: "message", T: Timestamp },
+    { no: 4, name: "duration_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "assigned_member_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * UpdateCallScheduleResponse is the response for the UpdateCallSchedule method.
+ *
+ * @generated from message homecall.v1alpha.UpdateCallScheduleResponse
+ */
+export const UpdateCallScheduleResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.UpdateCallScheduleResponse",
+  () => [
+    { no: 1, name: "call_schedule", kind: "message", T: CallSchedule },
+  ],
+);
+
+/**
+ * RemoveCallScheduleRequest is the request for the RemoveCallSchedule method.
+ *
+ * @generated from message homecall.v1alpha.RemoveCallScheduleRequest
+ */
+export const RemoveCallScheduleRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.RemoveCallScheduleRequest",
+  () => [
+    { no: 1, name: "schedule_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * RemoveCallScheduleResponse is the response for the RemoveCallSchedule method.
+ *
+ * @generated from message homecall.v1alpha.RemoveCallScheduleResponse
+ */
+export const RemoveCallScheduleResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.RemoveCallScheduleResponse",
+  [],
+);
+
+/**
+ * SkipCallScheduleOccurrenceRequest is the request for the SkipCallScheduleOccurrence method.
+ *
+ * @generated from message homecall.v1alpha.SkipCallScheduleOccurrenceRequest
+ */
+export const SkipCallScheduleOccurrenceRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.SkipCallScheduleOccurrenceRequest",
+  () => [
+    { no: 1, name: "schedule_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "starts_at", kind: "message", T: Timestamp },
+  ],
+);
+
+/**
+ * SkipCallScheduleOccurrenceResponse is the response for the SkipCallScheduleOccurrence method.
+ *
+ * @generated from message homecall.v1alpha.SkipCallScheduleOccurrenceResponse
+ */
+export const SkipCallScheduleOccurrenceResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.SkipCallScheduleOccurrenceResponse",
+  [],
+);
+
+/**
+ * CreateCalendarFeedTokenRequest is the request for the CreateCalendarFeedToken method.
+ *
+ * @generated from message homecall.v1alpha.CreateCalendarFeedTokenRequest
+ */
+export const CreateCalendarFeedTokenRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.CreateCalendarFeedTokenRequest",
+  () => [
+    { no: 1, name: "tenant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * CreateCalendarFeedTokenResponse is the response for the CreateCalendarFeedToken method.
+ *
+ * @generated from message homecall.v1alpha.CreateCalendarFeedTokenResponse
+ */
+export const CreateCalendarFeedTokenResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.CreateCalendarFeedTokenResponse",
+  () => [
+    { no: 1, name: "token", kind: "message", T: CalendarFeedToken },
+    { no: 2, name: "feed_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * ListCalendarFeedTokensRequest is the request for the ListCalendarFeedTokens method.
+ *
+ * @generated from message homecall.v1alpha.ListCalendarFeedTokensRequest
+ */
+export const ListCalendarFeedTokensRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.ListCalendarFeedTokensRequest",
+  () => [
+    { no: 1, name: "tenant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * ListCalendarFeedTokensResponse is the response for the ListCalendarFeedTokens method.
+ *
+ * @generated from message homecall.v1alpha.ListCalendarFeedTokensResponse
+ */
+export const ListCalendarFeedTokensResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.ListCalendarFeedTokensResponse",
+  () => [
+    { no: 1, name: "tokens", kind: "message", T: CalendarFeedToken, repeated: true },
+  ],
+);
+
+/**
+ * RevokeCalendarFeedTokenRequest is the request for the RevokeCalendarFeedToken method.
+ *
+ * @generated from message homecall.v1alpha.RevokeCalendarFeedTokenRequest
+ */
+export const RevokeCalendarFeedTokenRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.RevokeCalendarFeedTokenRequest",
+  () => [
+    { no: 1, name: "token_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * RevokeCalendarFeedTokenResponse is the response for the RevokeCalendarFeedToken method.
+ *
+ * @generated from message homecall.v1alpha.RevokeCalendarFeedTokenResponse
+ */
+export const RevokeCalendarFeedTokenResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.RevokeCalendarFeedTokenResponse",
+  [],
+);
+
+/**
+ * GetAllowedCallersRequest is the request for the GetAllowedCallers method.
+ *
+ * @generated from message homecall.v1alpha.GetAllowedCallersRequest
+ */
+export const GetAllowedCallersRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.GetAllowedCallersRequest",
+  () => [
+    { no: 1, name: "device_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * GetAllowedCallersResponse is the response for the GetAllowedCallers method.
+ *
+ * @generated from message homecall.v1alpha.GetAllowedCallersResponse
+ */
+export const GetAllowedCallersResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.GetAllowedCallersResponse",
+  () => [
+    { no: 1, name: "restricted", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "callers", kind: "message", T: AllowedCaller, repeated: true },
+  ],
+);
+
+/**
+ * SetAllowedCallersRequest is the request for the SetAllowedCallers method.
+ *
+ * @generated from message homecall.v1alpha.SetAllowedCallersRequest
+ */
+export const SetAllowedCallersRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.SetAllowedCallersRequest",
+  () => [
+    { no: 1, name: "device_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "restricted", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "callers", kind: "message", T: AllowedCaller, repeated: true },
+  ],
+);
+
+/**
+ * SetAllowedCallersResponse is the response for the SetAllowedCallers method.
+ *
+ * @generated from message homecall.v1alpha.SetAllowedCallersResponse
+ */
+export const SetAllowedCallersResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.SetAllowedCallersResponse",
+  () => [
+    { no: 1, name: "restricted", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "callers", kind: "message", T: AllowedCaller, repeated: true },
+  ],
+);
+
+/**
+ * ListAllowedCallerEventsRequest is the request for the ListAllowedCallerEvents method.
+ *
+ * @generated from message homecall.v1alpha.ListAllowedCallerEventsRequest
+ */
+export const ListAllowedCallerEventsRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.ListAllowedCallerEventsRequest",
+  () => [
+    { no: 1, name: "device_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * ListAllowedCallerEventsResponse is the response for the ListAllowedCallerEvents method.
+ *
+ * @generated from message homecall.v1alpha.ListAllowedCallerEventsResponse
+ */
+export const ListAllowedCallerEventsResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.ListAllowedCallerEventsResponse",
+  () => [
+    { no: 1, name: "events", kind: "message", T: AllowedCallerEvent, repeated: true },
+  ],
+);
+
+/**
+ * WatchMissedCallsRequest is the request for the WatchMissedCalls method.
+ *
+ * @generated from message homecall.v1alpha.WatchMissedCallsRequest
+ */
+export const WatchMissedCallsRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.WatchMissedCallsRequest",
+  () => [
+    { no: 1, name: "tenant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * WatchMissedCallsResponse is sent for every missed call of a call the caller started.
+ *
+ * @generated from message homecall.v1alpha.WatchMissedCallsResponse
+ */
+export const WatchMissedCallsResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.WatchMissedCallsResponse",
+  () => [
+    { no: 1, name: "missed_call", kind: "message", T: MissedCall },
+  ],
+);
+
+/**
+ * ListCallbackRequestsRequest is the request for the ListCallbackRequests method.
+ *
+ * @generated from message homecall.v1alpha.ListCallbackRequestsRequest
+ */
+export const ListCallbackRequestsRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.ListCallbackRequestsRequest",
+  () => [
+    { no: 1, name: "tenant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "include_resolved", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ],
+);
+
+/**
+ * ListCallbackRequestsResponse is the response for the ListCallbackRequests method.
+ *
+ * @generated from message homecall.v1alpha.ListCallbackRequestsResponse
+ */
+export const ListCallbackRequestsResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.ListCallbackRequestsResponse",
+  () => [
+    { no: 1, name: "callback_requests", kind: "message", T: CallbackRequest, repeated: true },
+  ],
+);
+
+/**
+ * ResolveCallbackRequestRequest is the request for the ResolveCallbackRequest method.
+ *
+ * @generated from message homecall.v1alpha.ResolveCallbackRequestRequest
+ */
+export const ResolveCallbackRequestRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.ResolveCallbackRequestRequest",
+  () => [
+    { no: 1, name: "callback_request_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * ResolveCallbackRequestResponse is the response for the ResolveCallbackRequest method.
+ *
+ * @generated from message homecall.v1alpha.ResolveCallbackRequestResponse
+ */
+export const ResolveCallbackRequestResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.ResolveCallbackRequestResponse",
+  () => [
+    { no: 1, name: "callback_request", kind: "message", T: CallbackRequest },
+  ],
+);
+
+/**
+ * SendMessageRequest is the request for the SendMessage method.
+ *
+ * @generated from message homecall.v1alpha.SendMessageRequest
+ */
+export const SendMessageRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.SendMessageRequest",
+  () => [
+    { no: 1, name: "device_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "text", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "content" },
+    { no: 3, name: "image", kind: "scalar", T: 12 /* ScalarType.BYTES */, oneof: "content" },
+  ],
+);
+
+/**
+ * SendMessageResponse is the response for the SendMessage method.
+ *
+ * @generated from message homecall.v1alpha.SendMessageResponse
+ */
+export const SendMessageResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.SendMessageResponse",
+  () => [
+    { no: 1, name: "message", kind: "message", T: DeviceMessage },
+  ],
+);
+
+/**
+ * ListDeviceMessagesRequest is the request for the ListDeviceMessages method.
+ *
+ * @generated from message homecall.v1alpha.ListDeviceMessagesRequest
+ */
+export const ListDeviceMessagesRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.ListDeviceMessagesRequest",
+  () => [
+    { no: 1, name: "device_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * ListDeviceMessagesResponse is the response for the ListDeviceMessages method.
+ *
+ * @generated from message homecall.v1alpha.ListDeviceMessagesResponse
+ */
+export const ListDeviceMessagesResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.ListDeviceMessagesResponse",
+  () => [
+    { no: 1, name: "messages", kind: "message", T: DeviceMessage, repeated: true },
+  ],
+);
+
+/**
+ * GetDeviceMessageImageRequest is the request for the GetDeviceMessageImage method.
+ *
+ * @generated from message homecall.v1alpha.GetDeviceMessageImageRequest
+ */
+export const GetDeviceMessageImageRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.GetDeviceMessageImageRequest",
+  () => [
+    { no: 1, name: "message_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * GetDeviceMessageImageResponse is the response for the GetDeviceMessageImage method.
+ *
+ * @generated from message homecall.v1alpha.GetDeviceMessageImageResponse
+ */
+export const GetDeviceMessageImageResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.GetDeviceMessageImageResponse",
+  () => [
+    { no: 1, name: "image", kind: "message", T: MessageImage },
+    { no: 2, name: "data", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ],
+);
+
+/**
+ * CreateAlbumRequest is the request for the CreateAlbum method.
+ *
+ * @generated from message homecall.v1alpha.CreateAlbumRequest
+ */
+export const CreateAlbumRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.CreateAlbumRequest",
+  () => [
+    { no: 1, name: "tenant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "device_id", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "target" },
+    { no: 4, name: "group_id", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "target" },
+  ],
+);
+
+/**
+ * CreateAlbumResponse is the response for the CreateAlbum method.
+ *
+ * @generated from message homecall.v1alpha.CreateAlbumResponse
+ */
+export const CreateAlbumResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.CreateAlbumResponse",
+  () => [
+    { no: 1, name: "album", kind: "message", T: Album },
+  ],
+);
+
+/**
+ * ListAlbumsRequest is the request for the ListAlbums method.
+ *
+ * @generated from message homecall.v1alpha.ListAlbumsRequest
+ */
+export const ListAlbumsRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.ListAlbumsRequest",
+  () => [
+    { no: 1, name: "tenant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * ListAlbumsResponse is the response for the ListAlbums method.
+ *
+ * @generated from message homecall.v1alpha.ListAlbumsResponse
+ */
+export const ListAlbumsResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.ListAlbumsResponse",
+  () => [
+    { no: 1, name: "albums", kind: "message", T: Album, repeated: true },
+  ],
+);
+
+/**
+ * DeleteAlbumRequest is the request for the DeleteAlbum method.
+ *
+ * @generated from message homecall.v1alpha.DeleteAlbumRequest
+ */
+export const DeleteAlbumRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.DeleteAlbumRequest",
+  () => [
+    { no: 1, name: "album_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * DeleteAlbumResponse is the response for the DeleteAlbum method.
+ *
+ * @generated from message homecall.v1alpha.DeleteAlbumResponse
+ */
+export const DeleteAlbumResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.DeleteAlbumResponse",
+  [],
+);
+
+/**
+ * AddAlbumImageRequest is the request for the AddAlbumImage method.
+ *
+ * @generated from message homecall.v1alpha.AddAlbumImageRequest
+ */
+export const AddAlbumImageRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.AddAlbumImageRequest",
+  () => [
+    { no: 1, name: "album_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "image", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ],
+);
+
+/**
+ * AddAlbumImageResponse is the response for the AddAlbumImage method.
+ *
+ * @generated from message homecall.v1alpha.AddAlbumImageResponse
+ */
+export const AddAlbumImageResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.AddAlbumImageResponse",
+  () => [
+    { no: 1, name: "image", kind: "message", T: AlbumImage },
+  ],
+);
+
+/**
+ * RemoveAlbumImageRequest is the request for the RemoveAlbumImage method.
+ *
+ * @generated from message homecall.v1alpha.RemoveAlbumImageRequest
+ */
+export const RemoveAlbumImageRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.RemoveAlbumImageRequest",
+  () => [
+    { no: 1, name: "image_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * RemoveAlbumImageResponse is the response for the RemoveAlbumImage method.
+ *
+ * @generated from message homecall.v1alpha.RemoveAlbumImageResponse
+ */
+export const RemoveAlbumImageResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.RemoveAlbumImageResponse",
+  [],
+);
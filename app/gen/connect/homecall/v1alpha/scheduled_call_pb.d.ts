@@ -0,0 +1,326 @@
+// @generated by protoc-gen-es v1.8.0
+// @generated from file homecall/v1alpha/scheduled_call.proto (package homecall.v1alpha, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage, Timestamp } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+
+/**
+ * ScheduledCallStatus is the status of a scheduled call.
+ *
+ * @generated from enum homecall.v1alpha.ScheduledCallStatus
+ */
+export declare enum ScheduledCallStatus {
+  /**
+   * @generated from enum value: SCHEDULED_CALL_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The call has not taken place yet.
+   *
+   * @generated from enum value: SCHEDULED_CALL_STATUS_SCHEDULED = 1;
+   */
+  SCHEDULED = 1,
+
+  /**
+   * The device joined a call during the scheduled slot.
+   *
+   * @generated from enum value: SCHEDULED_CALL_STATUS_COMPLETED = 2;
+   */
+  COMPLETED = 2,
+
+  /**
+   * The slot ended without the device joining a call.
+   *
+   * @generated from enum value: SCHEDULED_CALL_STATUS_MISSED = 3;
+   */
+  MISSED = 3,
+
+  /**
+   * The call was cancelled.
+   *
+   * @generated from enum value: SCHEDULED_CALL_STATUS_CANCELLED = 4;
+   */
+  CANCELLED = 4,
+}
+
+/**
+ * ScheduledCallEventType is the type of an event about a scheduled call.
+ *
+ * @generated from enum homecall.v1alpha.ScheduledCallEventType
+ */
+export declare enum ScheduledCallEventType {
+  /**
+   * @generated from enum value: SCHEDULED_CALL_EVENT_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The call starts soon.
+   *
+   * @generated from enum value: SCHEDULED_CALL_EVENT_TYPE_REMINDER = 1;
+   */
+  REMINDER = 1,
+
+  /**
+   * The slot of the call ended without the device joining a call.
+   *
+   * @generated from enum value: SCHEDULED_CALL_EVENT_TYPE_MISSED = 2;
+   */
+  MISSED = 2,
+}
+
+/**
+ * ScheduledCall is a call with a device planned in advance.
+ *
+ * @generated from message homecall.v1alpha.ScheduledCall
+ */
+export declare class ScheduledCall extends Message<ScheduledCall> {
+  /**
+   * The ID of the scheduled call.
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * The ID of the tenant the scheduled call belongs to.
+   *
+   * @generated from field: string tenant_id = 2;
+   */
+  tenantId: string;
+
+  /**
+   * The ID of the device to call.
+   *
+   * @generated from field: string device_id = 3;
+   */
+  deviceId: string;
+
+  /**
+   * The ID of the tenant member assigned to make the call.
+   * Not set if the assigned member was removed from the tenant.
+   *
+   * @generated from field: string assigned_member_id = 4;
+   */
+  assignedMemberId: string;
+
+  /**
+   * The ID of the tenant member who scheduled the call.
+   *
+   * @generated from field: string created_by_member_id = 5;
+   */
+  createdByMemberId: string;
+
+  /**
+   * When the call starts.
+   *
+   * @generated from field: google.protobuf.Timestamp starts_at = 6;
+   */
+  startsAt?: Timestamp;
+
+  /**
+   * How long the call is planned to last.
+   *
+   * @generated from field: int64 duration_seconds = 7;
+   */
+  durationSeconds: bigint;
+
+  /**
+   * The status of the scheduled call.
+   *
+   * @generated from field: homecall.v1alpha.ScheduledCallStatus status = 8;
+   */
+  status: ScheduledCallStatus;
+
+  /**
+   * When the reminders for the call were sent.
+   * Not set if no reminders have been sent yet.
+   *
+   * @generated from field: google.protobuf.Timestamp reminded_at = 9;
+   */
+  remindedAt?: Timestamp;
+
+  /**
+   * When the call was scheduled.
+   *
+   * @generated from field: google.protobuf.Timestamp created_at = 10;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * The ID of the call schedule this call is an occurrence of.
+   * Not set for calls scheduled on their own.
+   *
+   * @generated from field: string schedule_id = 11;
+   */
+  scheduleId: string;
+
+  constructor(data?: PartialMessage<ScheduledCall>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.ScheduledCall";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ScheduledCall;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ScheduledCall;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ScheduledCall;
+
+  static equals(a: ScheduledCall | PlainMessage<ScheduledCall> | undefined, b: ScheduledCall | PlainMessage<ScheduledCall> | undefined): boolean;
+}
+
+/**
+ * CallSchedule is a recurring call with a device, e.g. every weekday at 10:00.
+ * Its occurrences are added as scheduled calls some time ahead.
+ *
+ * @generated from message homecall.v1alpha.CallSchedule
+ */
+export declare class CallSchedule extends Message<CallSchedule> {
+  /**
+   * The ID of the call schedule.
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * The ID of the tenant the call schedule belongs to.
+   *
+   * @generated from field: string tenant_id = 2;
+   */
+  tenantId: string;
+
+  /**
+   * The ID of the device to call.
+   *
+   * @generated from field: string device_id = 3;
+   */
+  deviceId: string;
+
+  /**
+   * The ID of the tenant member assigned to make the calls.
+   * Not set if the assigned member was removed from the tenant.
+   *
+   * @generated from field: string assigned_member_id = 4;
+   */
+  assignedMemberId: string;
+
+  /**
+   * The ID of the tenant member who created the call schedule.
+   *
+   * @generated from field: string created_by_member_id = 5;
+   */
+  createdByMemberId: string;
+
+  /**
+   * The iCalendar RRULE the calls recur by, e.g. "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR".
+   * It is applied on the wall clock of the device, so calls keep their time of day
+   * when daylight saving time starts or ends. UNTIL is a wall clock time as well.
+   *
+   * @generated from field: string rrule = 6;
+   */
+  rrule: string;
+
+  /**
+   * When the first call starts.
+   *
+   * @generated from field: google.protobuf.Timestamp starts_at = 7;
+   */
+  startsAt?: Timestamp;
+
+  /**
+   * How long each call is planned to last.
+   *
+   * @generated from field: int64 duration_seconds = 8;
+   */
+  durationSeconds: bigint;
+
+  /**
+   * The time zone of the device the calls follow.
+   *
+   * @generated from field: string time_zone = 9;
+   */
+  timeZone: string;
+
+  /**
+   * When the call schedule was created.
+   *
+   * @generated from field: google.protobuf.Timestamp created_at = 10;
+   */
+  createdAt?: Timestamp;
+
+  constructor(data?: PartialMessage<CallSchedule>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.CallSchedule";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CallSchedule;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CallSchedule;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CallSchedule;
+
+  static equals(a: CallSchedule | PlainMessage<CallSchedule> | undefined, b: CallSchedule | PlainMessage<CallSchedule> | undefined): boolean;
+}
+
+/**
+ * CalendarFeedToken gives access to the iCalendar feed of the scheduled calls assigned to a tenant member.
+ *
+ * @generated from message homecall.v1alpha.CalendarFeedToken
+ */
+export declare class CalendarFeedToken extends Message<CalendarFeedToken> {
+  /**
+   * The ID of the token.
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * The ID of the tenant the token belongs to.
+   *
+   * @generated from field: string tenant_id = 2;
+   */
+  tenantId: string;
+
+  /**
+   * The ID of the tenant member whose calls are in the feed.
+   *
+   * @generated from field: string member_id = 3;
+   */
+  memberId: string;
+
+  /**
+   * When the token was created.
+   *
+   * @generated from field: google.protobuf.Timestamp created_at = 4;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * When the feed was last fetched with the token.
+   * Not set if it has never been fetched.
+   *
+   * @generated from field: google.protobuf.Timestamp last_used_at = 5;
+   */
+  lastUsedAt?: Timestamp;
+
+  constructor(data?: PartialMessage<CalendarFeedToken>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.CalendarFeedToken";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CalendarFeedToken;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CalendarFeedToken;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CalendarFeedToken;
+
+  static equals(a: CalendarFeedToken | PlainMessage<CalendarFeedToken> | undefined, b: CalendarFeedToken | PlainMessage<CalendarFeedToken> | undefined): boolean;
+}
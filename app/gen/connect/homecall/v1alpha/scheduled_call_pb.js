@@ -0,0 +1,96 @@
+// @generated by protoc-gen-es v1.8.0
+// @generated from file homecall/v1alpha/scheduled_call.proto (package homecall.v1alpha, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { proto3, Timestamp } from "@bufbuild/protobuf";
+
+/**
+ * ScheduledCallStatus is the status of a scheduled call.
+ *
+ * @generated from enum homecall.v1alpha.ScheduledCallStatus
+ */
+export const ScheduledCallStatus = /*@__PURE__*/ proto3.makeEnum(
+  "homecall.v1alpha.ScheduledCallStatus",
+  [
+    {no: 0, name: "SCHEDULED_CALL_STATUS_UNSPECIFIED", localName: "UNSPECIFIED"},
+    {no: 1, name: "SCHEDULED_CALL_STATUS_SCHEDULED", localName: "SCHEDULED"},
+    {no: 2, name: "SCHEDULED_CALL_STATUS_COMPLETED", localName: "COMPLETED"},
+    {no: 3, name: "SCHEDULED_CALL_STATUS_MISSED", localName: "MISSED"},
+    {no: 4, name: "SCHEDULED_CALL_STATUS_CANCELLED", localName: "CANCELLED"},
+  ],
+);
+
+/**
+ * ScheduledCallEventType is the type of an event about a scheduled call.
+ *
+ * @generated from enum homecall.v1alpha.ScheduledCallEventType
+ */
+export const ScheduledCallEventType = /*@__PURE__*/ proto3.makeEnum(
+  "homecall.v1alpha.ScheduledCallEventType",
+  [
+    {no: 0, name: "SCHEDULED_CALL_EVENT_TYPE_UNSPECIFIED", localName: "UNSPECIFIED"},
+    {no: 1, name: "SCHEDULED_CALL_EVENT_TYPE_REMINDER", localName: "REMINDER"},
+    {no: 2, name: "SCHEDULED_CALL_EVENT_TYPE_MISSED", localName: "MISSED"},
+  ],
+);
+
+/**
+ * ScheduledCall is a call with a device planned in advance.
+ *
+ * @generated from message homecall.v1alpha.ScheduledCall
+ */
+export const ScheduledCall = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.ScheduledCall",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "tenant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "device_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "assigned_member_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "created_by_member_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "starts_at", kind: "message", T: Timestamp },
+    { no: 7, name: "duration_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 8, name: "status", kind: "enum", T: proto3.getEnumType(ScheduledCallStatus) },
+    { no: 9, name: "reminded_at", kind: "message", T: Timestamp },
+    { no: 10, name: "created_at", kind: "message", T: Timestamp },
+    { no: 11, name: "schedule_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * CallSchedule is a recurring call with a device, e.g. every weekday at 10:00.
+ * Its occurrences are added as scheduled calls some time ahead.
+ *
+ * @generated from message homecall.v1alpha.CallSchedule
+ */
+export const CallSchedule = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.CallSchedule",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "tenant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "device_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "assigned_member_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "created_by_member_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "rrule", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "starts_at", kind: "message", T: Timestamp },
+    { no: 8, name: "duration_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 9, name: "time_zone", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "created_at", kind: "message", T: Timestamp },
+  ],
+);
+
+/**
+ * CalendarFeedToken gives access to the iCalendar feed of the scheduled calls assigned to a tenant member.
+ *
+ * @generated from message homecall.v1alpha.CalendarFeedToken
+ */
+export const CalendarFeedToken = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.CalendarFeedToken",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "tenant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "member_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "created_at", kind: "message", T: Timestamp },
+    { no: 5, name: "last_used_at", kind: "message", T: Timestamp },
+  ],
+);
@@ -14,6 +14,8 @@ import { Message, proto3 } from "@bufbuild/protobuf";
 export declare class DeviceSettings extends Message<DeviceSettings> {
   /**
    * Whether the device should automatically answer calls.
+   * Only used when creating a device, after that the server decides for every call
+   * whether the device answers it by itself, see GetCallDetailsResponse.
    *
    * @generated from field: bool auto_answer = 1;
    */
@@ -21,11 +23,20 @@ export declare class DeviceSettings extends Message<DeviceSettings> {
 
   /**
    * The number of seconds to wait before automatically answering a call.
+   * Only used when creating a device, like auto_answer.
    *
    * @generated from field: int64 auto_answer_delay_seconds = 2;
    */
   autoAnswerDelaySeconds: bigint;
 
+  /**
+   * The quiet hours of the device, calls should not ring during them.
+   * Not set if the device has no quiet hours.
+   *
+   * @generated from field: homecall.v1alpha.QuietHours quiet_hours = 3;
+   */
+  quietHours?: QuietHours;
+
   constructor(data?: PartialMessage<DeviceSettings>);
 
   static readonly runtime: typeof proto3;
@@ -40,3 +51,107 @@ export declare class DeviceSettings extends Message<DeviceSettings> {
 
   static equals(a: DeviceSettings | PlainMessage<DeviceSettings> | undefined, b: DeviceSettings | PlainMessage<DeviceSettings> | undefined): boolean;
 }
+
+/**
+ * AutoAnswerPolicy decides whether a device answers a call by itself.
+ * The server applies it when a call is started, calls during quiet hours are never answered automatically.
+ *
+ * @generated from message homecall.v1alpha.AutoAnswerPolicy
+ */
+export declare class AutoAnswerPolicy extends Message<AutoAnswerPolicy> {
+  /**
+   * Whether the device may answer calls by itself.
+   *
+   * @generated from field: bool enabled = 1;
+   */
+  enabled: boolean;
+
+  /**
+   * The number of seconds the device rings before it answers, at most 300.
+   *
+   * @generated from field: int64 delay_seconds = 2;
+   */
+  delaySeconds: bigint;
+
+  /**
+   * Whether only calls from the allowed callers of the device are answered automatically.
+   *
+   * @generated from field: bool allowed_callers_only = 3;
+   */
+  allowedCallersOnly: boolean;
+
+  /**
+   * The start of the daily window in which calls are answered automatically, in minutes after midnight
+   * in the time zone of the device. The window wraps around midnight if it ends before it starts.
+   * If start and end are equal, calls are answered all day.
+   *
+   * @generated from field: int32 start_minute = 4;
+   */
+  startMinute: number;
+
+  /**
+   * The end of the daily window in which calls are answered automatically, in minutes after midnight.
+   *
+   * @generated from field: int32 end_minute = 5;
+   */
+  endMinute: number;
+
+  constructor(data?: PartialMessage<AutoAnswerPolicy>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.AutoAnswerPolicy";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AutoAnswerPolicy;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AutoAnswerPolicy;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AutoAnswerPolicy;
+
+  static equals(a: AutoAnswerPolicy | PlainMessage<AutoAnswerPolicy> | undefined, b: AutoAnswerPolicy | PlainMessage<AutoAnswerPolicy> | undefined): boolean;
+}
+
+/**
+ * QuietHours is a daily window during which a device should not be called.
+ * The window wraps around midnight if it ends before it starts, e.g. from 21:00 to 07:00.
+ *
+ * @generated from message homecall.v1alpha.QuietHours
+ */
+export declare class QuietHours extends Message<QuietHours> {
+  /**
+   * The start of the window in minutes after midnight, between 0 and 1439.
+   *
+   * @generated from field: int32 start_minute = 1;
+   */
+  startMinute: number;
+
+  /**
+   * The end of the window in minutes after midnight, between 0 and 1439.
+   * Must differ from the start.
+   *
+   * @generated from field: int32 end_minute = 2;
+   */
+  endMinute: number;
+
+  /**
+   * The IANA time zone the window is in, which is the time zone of the device.
+   * Set by the server, ignored in requests.
+   *
+   * @generated from field: string time_zone = 3;
+   */
+  timeZone: string;
+
+  constructor(data?: PartialMessage<QuietHours>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.QuietHours";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QuietHours;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QuietHours;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QuietHours;
+
+  static equals(a: QuietHours | PlainMessage<QuietHours> | undefined, b: QuietHours | PlainMessage<QuietHours> | undefined): boolean;
+}
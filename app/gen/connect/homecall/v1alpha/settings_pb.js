@@ -15,5 +15,38 @@ export const DeviceSettings = /*@__PURE__*/ proto3.makeMessageType(
   () => [
     { no: 1, name: "auto_answer", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 2, name: "auto_answer_delay_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "quiet_hours", kind: "message", T: QuietHours },
+  ],
+);
+
+/**
+ * AutoAnswerPolicy decides whether a device answers a call by itself.
+ * The server applies it when a call is started, calls during quiet hours are never answered automatically.
+ *
+ * @generated from message homecall.v1alpha.AutoAnswerPolicy
+ */
+export const AutoAnswerPolicy = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.AutoAnswerPolicy",
+  () => [
+    { no: 1, name: "enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "delay_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "allowed_callers_only", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "start_minute", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "end_minute", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ],
+);
+
+/**
+ * QuietHours is a daily window during which a device should not be called.
+ * The window wraps around midnight if it ends before it starts, e.g. from 21:00 to 07:00.
+ *
+ * @generated from message homecall.v1alpha.QuietHours
+ */
+export const QuietHours = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.QuietHours",
+  () => [
+    { no: 1, name: "start_minute", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "end_minute", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "time_zone", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ],
 );
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { AcceptTenantInviteRequest, AcceptTenantInviteResponse, CreateTenantInviteRequest, CreateTenantInviteResponse, CreateTenantRequest, CreateTenantResponse, ListTenantInvitesRequest, ListTenantInvitesResponse, ListTenantMembersRequest, ListTenantMembersResponse, ListTenantsRequest, ListTenantsResponse, RemoveTenantInviteRequest, RemoveTenantInviteResponse, RemoveTenantMemberRequest, RemoveTenantMemberResponse, RemoveTenantRequest, RemoveTenantResponse, UpdateTenantMemberRequest, UpdateTenantMemberResponse } from "./tenant_service_pb.js";
+import { AcceptTenantInviteRequest, AcceptTenantInviteResponse, CreateTenantInviteRequest, CreateTenantInviteResponse, CreateTenantRequest, CreateTenantResponse, ListTenantInvitesRequest, ListTenantInvitesResponse, ListTenantMembersRequest, ListTenantMembersResponse, ListTenantsRequest, ListTenantsResponse, RemoveTenantInviteRequest, RemoveTenantInviteResponse, RemoveTenantMemberRequest, RemoveTenantMemberResponse, RemoveTenantRequest, RemoveTenantResponse, SetTenantMemberDeviceGroupsRequest, SetTenantMemberDeviceGroupsResponse, UpdateTenantMemberRequest, UpdateTenantMemberResponse, UpdateTenantRequest, UpdateTenantResponse } from "./tenant_service_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -37,6 +37,18 @@ export declare const TenantService: {
       readonly O: typeof ListTenantsResponse,
       readonly kind: MethodKind.Unary,
     },
+    /**
+     * UpdateTenant updates the settings of a tenant.
+     * Only admins can update a tenant.
+     *
+     * @generated from rpc homecall.v1alpha.TenantService.UpdateTenant
+     */
+    readonly updateTenant: {
+      readonly name: "UpdateTenant",
+      readonly I: typeof UpdateTenantRequest,
+      readonly O: typeof UpdateTenantResponse,
+      readonly kind: MethodKind.Unary,
+    },
     /**
      * RemoveTenant removes a tenant.
      *
@@ -125,5 +137,18 @@ export declare const TenantService: {
       readonly O: typeof AcceptTenantInviteResponse,
       readonly kind: MethodKind.Unary,
     },
+    /**
+     * SetTenantMemberDeviceGroups scopes a tenant member to device groups.
+     * A scoped member only has access to the devices in its groups, an unscoped member to all devices.
+     * Only admins can scope members.
+     *
+     * @generated from rpc homecall.v1alpha.TenantService.SetTenantMemberDeviceGroups
+     */
+    readonly setTenantMemberDeviceGroups: {
+      readonly name: "SetTenantMemberDeviceGroups",
+      readonly I: typeof SetTenantMemberDeviceGroupsRequest,
+      readonly O: typeof SetTenantMemberDeviceGroupsResponse,
+      readonly kind: MethodKind.Unary,
+    },
   }
 };
@@ -139,6 +139,70 @@ export declare class ListTenantsResponse extends Message<ListTenantsResponse> {
   static equals(a: ListTenantsResponse | PlainMessage<ListTenantsResponse> | undefined, b: ListTenantsResponse | PlainMessage<ListTenantsResponse> | undefined): boolean;
 }
 
+/**
+ * UpdateTenantRequest is the request message for the UpdateTenant method.
+ * Fields that are not set are left unchanged.
+ *
+ * @generated from message homecall.v1alpha.UpdateTenantRequest
+ */
+export declare class UpdateTenantRequest extends Message<UpdateTenantRequest> {
+  /**
+   * The ID of the tenant to update.
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * Whether devices must send an attestation when they enroll.
+   *
+   * @generated from field: optional bool require_device_attestation = 2;
+   */
+  requireDeviceAttestation?: boolean;
+
+  constructor(data?: PartialMessage<UpdateTenantRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.UpdateTenantRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateTenantRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateTenantRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateTenantRequest;
+
+  static equals(a: UpdateTenantRequest | PlainMessage<UpdateTenantRequest> | undefined, b: UpdateTenantRequest | PlainMessage<UpdateTenantRequest> | undefined): boolean;
+}
+
+/**
+ * UpdateTenantResponse is the response message for the UpdateTenant method.
+ *
+ * @generated from message homecall.v1alpha.UpdateTenantResponse
+ */
+export declare class UpdateTenantResponse extends Message<UpdateTenantResponse> {
+  /**
+   * The updated tenant.
+   *
+   * @generated from field: homecall.v1alpha.Tenant tenant = 1;
+   */
+  tenant?: Tenant;
+
+  constructor(data?: PartialMessage<UpdateTenantResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.UpdateTenantResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateTenantResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateTenantResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateTenantResponse;
+
+  static equals(a: UpdateTenantResponse | PlainMessage<UpdateTenantResponse> | undefined, b: UpdateTenantResponse | PlainMessage<UpdateTenantResponse> | undefined): boolean;
+}
+
 /**
  * RemoveTenantRequest is the request message for the RemoveTenant method.
  *
@@ -574,6 +638,70 @@ export declare class AcceptTenantInviteResponse extends Message<AcceptTenantInvi
   static equals(a: AcceptTenantInviteResponse | PlainMessage<AcceptTenantInviteResponse> | undefined, b: AcceptTenantInviteResponse | PlainMessage<AcceptTenantInviteResponse> | undefined): boolean;
 }
 
+/**
+ * SetTenantMemberDeviceGroupsRequest is the request message for the SetTenantMemberDeviceGroups method.
+ *
+ * @generated from message homecall.v1alpha.SetTenantMemberDeviceGroupsRequest
+ */
+export declare class SetTenantMemberDeviceGroupsRequest extends Message<SetTenantMemberDeviceGroupsRequest> {
+  /**
+   * The ID of the tenant member.
+   *
+   * @generated from field: string member_id = 1;
+   */
+  memberId: string;
+
+  /**
+   * Whether the member is scoped to device groups.
+   *
+   * @generated from field: bool device_group_scoped = 2;
+   */
+  deviceGroupScoped: boolean;
+
+  /**
+   * The IDs of the device groups the member has access to.
+   * Ignored if the member is not scoped.
+   *
+   * @generated from field: repeated string device_group_ids = 3;
+   */
+  deviceGroupIds: string[];
+
+  constructor(data?: PartialMessage<SetTenantMemberDeviceGroupsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.SetTenantMemberDeviceGroupsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetTenantMemberDeviceGroupsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetTenantMemberDeviceGroupsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetTenantMemberDeviceGroupsRequest;
+
+  static equals(a: SetTenantMemberDeviceGroupsRequest | PlainMessage<SetTenantMemberDeviceGroupsRequest> | undefined, b: SetTenantMemberDeviceGroupsRequest | PlainMessage<SetTenantMemberDeviceGroupsRequest> | undefined): boolean;
+}
+
+/**
+ * SetTenantMemberDeviceGroupsResponse is the response message for the SetTenantMemberDeviceGroups method.
+ *
+ * @generated from message homecall.v1alpha.SetTenantMemberDeviceGroupsResponse
+ */
+export declare class SetTenantMemberDeviceGroupsResponse extends Message<SetTenantMemberDeviceGroupsResponse> {
+  constructor(data?: PartialMessage<SetTenantMemberDeviceGroupsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "homecall.v1alpha.SetTenantMemberDeviceGroupsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetTenantMemberDeviceGroupsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetTenantMemberDeviceGroupsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetTenantMemberDeviceGroupsResponse;
+
+  static equals(a: SetTenantMemberDeviceGroupsResponse | PlainMessage<SetTenantMemberDeviceGroupsResponse> | undefined, b: SetTenantMemberDeviceGroupsResponse | PlainMessage<SetTenantMemberDeviceGroupsResponse> | undefined): boolean;
+}
+
 /**
  * Tenant represents a tenant.
  *
@@ -601,6 +729,13 @@ export declare class Tenant extends Message<Tenant> {
    */
   maxDevices: bigint;
 
+  /**
+   * Whether devices must send an attestation when they enroll.
+   *
+   * @generated from field: bool require_device_attestation = 4;
+   */
+  requireDeviceAttestation: boolean;
+
   constructor(data?: PartialMessage<Tenant>);
 
   static readonly runtime: typeof proto3;
@@ -664,6 +799,21 @@ export declare class TenantMember extends Message<TenantMember> {
    */
   role: Role;
 
+  /**
+   * Whether the member is scoped to device groups.
+   * A scoped member only has access to the devices in device_group_ids.
+   *
+   * @generated from field: bool device_group_scoped = 7;
+   */
+  deviceGroupScoped: boolean;
+
+  /**
+   * The IDs of the device groups a scoped member has access to.
+   *
+   * @generated from field: repeated string device_group_ids = 8;
+   */
+  deviceGroupIds: string[];
+
   constructor(data?: PartialMessage<TenantMember>);
 
   static readonly runtime: typeof proto3;
@@ -65,6 +65,32 @@ export const ListTenantsResponse = /*@__PURE__*/ proto3.makeMessageType(
   ],
 );
 
+/**
+ * UpdateTenantRequest is the request message for the UpdateTenant method.
+ * Fields that are not set are left unchanged.
+ *
+ * @generated from message homecall.v1alpha.UpdateTenantRequest
+ */
+export const UpdateTenantRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.UpdateTenantRequest",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "require_device_attestation", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+  ],
+);
+
+/**
+ * UpdateTenantResponse is the response message for the UpdateTenant method.
+ *
+ * @generated from message homecall.v1alpha.UpdateTenantResponse
+ */
+export const UpdateTenantResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.UpdateTenantResponse",
+  () => [
+    { no: 1, name: "tenant", kind: "message", T: Tenant },
+  ],
+);
+
 /**
  * RemoveTenantRequest is the request message for the RemoveTenant method.
  *
@@ -250,6 +276,30 @@ export const AcceptTenantInviteResponse = /*@__PURE__*/ proto3.makeMessageType(
   [],
 );
 
+/**
+ * SetTenantMemberDeviceGroupsRequest is the request message for the SetTenantMemberDeviceGroups method.
+ *
+ * @generated from message homecall.v1alpha.SetTenantMemberDeviceGroupsRequest
+ */
+export const SetTenantMemberDeviceGroupsRequest = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.SetTenantMemberDeviceGroupsRequest",
+  () => [
+    { no: 1, name: "member_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "device_group_scoped", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "device_group_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ],
+);
+
+/**
+ * SetTenantMemberDeviceGroupsResponse is the response message for the SetTenantMemberDeviceGroups method.
+ *
+ * @generated from message homecall.v1alpha.SetTenantMemberDeviceGroupsResponse
+ */
+export const SetTenantMemberDeviceGroupsResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "homecall.v1alpha.SetTenantMemberDeviceGroupsResponse",
+  [],
+);
+
 /**
  * Tenant represents a tenant.
  *
@@ -261,6 +311,7 @@ export const Tenant = /*@__PURE__*/ proto3.makeMessageType(
     { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "max_devices", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "require_device_attestation", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ],
 );
 
@@ -278,6 +329,8 @@ export const TenantMember = /*@__PURE__*/ proto3.makeMessageType(
     { no: 4, name: "verified_email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 5, name: "display_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 6, name: "role", kind: "enum", T: proto3.getEnumType(Role) },
+    { no: 7, name: "device_group_scoped", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 8, name: "device_group_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ],
 );
 
@@ -5,9 +5,13 @@ import {getCredentials, storeCredentials, Credentials} from "./credentials";
 const Buffer = require("buffer").Buffer;
 
 export interface AuthContext {
-  deviceToken: string;
   instanceUrl: string;
   deviceId: string;
+  /**
+   * Mints a new device token, the server only accepts every token once
+   * so each request needs its own.
+   */
+  deviceToken: () => Promise<string>;
 }
 
 /**
@@ -30,23 +34,39 @@ export async function setupCredentials(deviceId: string, instanceUrl: string, au
 }
 
 /**
- * Gets the credentials for the device
- * @returns The API token and the api url
+ * Gets the auth context for the device
+ * @returns The api url, the device id and a way to mint device tokens
  */
 export async function getAuthContext(): Promise<AuthContext> {
-  const { privateKey, deviceId, instanceUrl, audience } = await getCredentials();
+  const { deviceId, instanceUrl } = await getCredentials();
+
+  return {
+    instanceUrl,
+    deviceId,
+    deviceToken: createDeviceToken,
+  };
+}
+
+/**
+ * Creates a new signed device token
+ * @returns A JWT that can be used for a single request
+ */
+export async function createDeviceToken(): Promise<string> {
+  const { privateKey, deviceId, audience } = await getCredentials();
 
   const jwtHeader = {
     alg: 'RS256',
     typ: 'JWT'
   };
 
+  // Tokens can only be used once and must not live longer than the server allows
   const jwtPayload = {
     iss: 'homecall-device',
     sub: deviceId,
     aud: audience,
-    exp: Math.floor(Date.now() / 1000) + 60 * 60,
+    exp: Math.floor(Date.now() / 1000) + 5 * 60,
     iat: Math.floor(Date.now() / 1000),
+    jti: `${Date.now().toString(36)}-${Math.random().toString(36).slice(2)}${Math.random().toString(36).slice(2)}`,
   };
 
   const jwtHeaderBase64 = base64url.fromBase64(Buffer.from(JSON.stringify(jwtHeader)).toString('base64'));
@@ -58,13 +78,7 @@ export async function getAuthContext(): Promise<AuthContext> {
   const sanitizedSignature = signature.replace(/\n/g, ''); // RSA library adds newlines to the signature, which is invalid for JWT
   const urlEncodedSignature = base64url.fromBase64(sanitizedSignature);
 
-  const jwt = `${jwtHeaderPayload}.${urlEncodedSignature}`;
-
-  return {
-    deviceToken: jwt,
-    instanceUrl,
-    deviceId,
-  };
+  return `${jwtHeaderPayload}.${urlEncodedSignature}`;
 }
 
 export async function decrypt(message: string): Promise<string> {
@@ -0,0 +1,71 @@
+import {AppState as NativeAppState, AppStateStatus} from "react-native";
+import messaging from "@react-native-firebase/messaging";
+import {deviceClient} from "./api";
+import {AuthContext, getAuthContext, hasCredentials} from "./auth";
+import {AppState} from "../gen/connect/homecall/v1alpha/device_state_pb";
+
+const heartbeatRetryInterval = 30 * 1000 // 30 seconds
+
+/**
+ * Reports to the server that the device is alive and whether the app is in the foreground
+ * @returns The number of milliseconds until the next heartbeat is due
+ */
+export async function sendHeartbeat(authContext: AuthContext, appState: AppStateStatus): Promise<number> {
+  const res = await deviceClient(authContext.instanceUrl).heartbeat({
+    state: {
+      appState: appState === 'active' ? AppState.FOREGROUND : AppState.BACKGROUND,
+    },
+  }, {
+    headers: {
+      Authorization: `Bearer ${await authContext.deviceToken()}`,
+    }
+  });
+  return Number(res.nextHeartbeatSeconds) * 1000;
+}
+
+/**
+ * Sends heartbeats for as long as the app runs, in the foreground as well as in the background.
+ * A heartbeat is also sent whenever the app moves between the foreground and the background.
+ * @returns A function that stops the heartbeats
+ */
+export function startHeartbeats(authContext: AuthContext): () => void {
+  let timeout: ReturnType<typeof setTimeout> | undefined;
+  let stopped = false;
+
+  const beat = async () => {
+    let next = heartbeatRetryInterval;
+    try {
+      next = await sendHeartbeat(authContext, NativeAppState.currentState);
+    } catch (e) {
+      console.error('Failed to send heartbeat', e);
+    }
+    if (stopped) {
+      return;
+    }
+    clearTimeout(timeout);
+    timeout = setTimeout(beat, next);
+  }
+
+  // Fire and forget
+  beat();
+  const subscription = NativeAppState.addEventListener('change', beat);
+
+  return () => {
+    stopped = true;
+    clearTimeout(timeout);
+    subscription.remove();
+  }
+}
+
+/**
+ * Sends a heartbeat whenever a push notification wakes up the app in the background,
+ * as timers can not be relied on once the OS has suspended the app.
+ */
+export function registerBackgroundHeartbeats() {
+  messaging().setBackgroundMessageHandler(async () => {
+    if (!await hasCredentials()) {
+      return;
+    }
+    await sendHeartbeat(await getAuthContext(), 'background');
+  });
+}
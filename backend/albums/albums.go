@@ -0,0 +1,135 @@
+package albums
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	. "github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sidus.io/home-call/gen/connect/homecall/v1alpha"
+	"sidus.io/home-call/gen/jetdb/public/model"
+	. "sidus.io/home-call/gen/jetdb/public/table"
+	"sidus.io/home-call/util"
+)
+
+// Row is an album together with the public IDs it refers to.
+type Row struct {
+	model.Album
+	model.Tenant
+	Device      *model.Device
+	DeviceGroup *model.DeviceGroup
+}
+
+// Select selects the albums matching the condition into Row values, oldest first.
+func Select(condition BoolExpression) SelectStatement {
+	return SELECT(
+		Album.AllColumns,
+		Tenant.TenantID,
+		Device.ID,
+		Device.DeviceID,
+		Device.GroupID,
+		DeviceGroup.ID,
+		DeviceGroup.GroupID,
+	).FROM(
+		Album.
+			INNER_JOIN(Tenant, Tenant.ID.EQ(Album.TenantID)).
+			LEFT_JOIN(Device, Device.ID.EQ(Album.DeviceID)).
+			LEFT_JOIN(DeviceGroup, DeviceGroup.ID.EQ(Album.DeviceGroupID)),
+	).WHERE(condition).ORDER_BY(Album.CreatedAt.ASC(), Album.ID.ASC())
+}
+
+// ShownOn matches the albums shown on a device, directly or through its group.
+func ShownOn(deviceId int32) BoolExpression {
+	return Album.DeviceID.EQ(Int32(deviceId)).OR(Album.DeviceGroupID.IN(
+		SELECT(Device.GroupID).FROM(Device).WHERE(Device.ID.EQ(Int32(deviceId))),
+	))
+}
+
+// Load returns the albums matching the condition with their images.
+func Load(ctx context.Context, db util.DB, condition BoolExpression) ([]*homecallv1alpha.Album, error) {
+	var rows []Row
+	err := Select(condition).QueryContext(ctx, db, &rows)
+	if err != nil && !errors.Is(err, qrm.ErrNoRows) {
+		return nil, fmt.Errorf("failed to query albums: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	albumIds := make([]Expression, len(rows))
+	for i, row := range rows {
+		albumIds[i] = Int32(row.Album.ID)
+	}
+	var images []model.AlbumImage
+	err = SELECT(AlbumImage.AllColumns).FROM(AlbumImage).
+		WHERE(AlbumImage.AlbumID.IN(albumIds...)).
+		ORDER_BY(AlbumImage.CreatedAt.ASC(), AlbumImage.ID.ASC()).
+		QueryContext(ctx, db, &images)
+	if err != nil && !errors.Is(err, qrm.ErrNoRows) {
+		return nil, fmt.Errorf("failed to query album images: %w", err)
+	}
+	imagesByAlbum := make(map[int32][]*homecallv1alpha.AlbumImage)
+	for _, image := range images {
+		imagesByAlbum[image.AlbumID] = append(imagesByAlbum[image.AlbumID], ImageToProto(image))
+	}
+
+	albums := make([]*homecallv1alpha.Album, len(rows))
+	for i, row := range rows {
+		albums[i] = ToProto(row, imagesByAlbum[row.Album.ID])
+	}
+	return albums, nil
+}
+
+// ToProto converts an album.
+func ToProto(row Row, images []*homecallv1alpha.AlbumImage) *homecallv1alpha.Album {
+	album := &homecallv1alpha.Album{
+		Id:        row.Album.AlbumID,
+		TenantId:  row.Tenant.TenantID,
+		Name:      row.Album.Name,
+		Images:    images,
+		CreatedAt: timestamppb.New(row.Album.CreatedAt),
+	}
+	if row.Device != nil {
+		album.Target = &homecallv1alpha.Album_DeviceId{DeviceId: row.Device.DeviceID}
+	}
+	if row.DeviceGroup != nil {
+		album.Target = &homecallv1alpha.Album_GroupId{GroupId: row.DeviceGroup.GroupID}
+	}
+	return album
+}
+
+// ImageToProto converts an album image.
+func ImageToProto(image model.AlbumImage) *homecallv1alpha.AlbumImage {
+	albumImage := &homecallv1alpha.AlbumImage{
+		Id:          image.ImageID,
+		ContentType: image.ContentType,
+		SizeBytes:   image.SizeBytes,
+		Etag:        image.Etag,
+		CreatedAt:   timestamppb.New(image.CreatedAt),
+	}
+	if image.UploadedBy != nil {
+		albumImage.UploadedByMemberId = *image.UploadedBy
+	}
+	return albumImage
+}
+
+// ContentETag returns the hex encoded SHA-256 of content.
+func ContentETag(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// ManifestETag returns an ETag that changes whenever the albums or their images do.
+func ManifestETag(albums []*homecallv1alpha.Album) (string, error) {
+	manifest, err := proto.MarshalOptions{Deterministic: true}.Marshal(&homecallv1alpha.SyncContentResponse{
+		Albums: albums,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal manifest: %w", err)
+	}
+	return ContentETag(manifest), nil
+}
@@ -1,5 +1,7 @@
 package app
 
+import "time"
+
 type Config struct {
 	DBHost                   string `envconfig:"DB_HOST" default:"localhost"`
 	DBPort                   string `envconfig:"DB_PORT" default:"8036"`
@@ -10,6 +12,10 @@ type Config struct {
 	DBInstanceConnectionName string `envconfig:"DB_INSTANCE_CONNECTION_NAME" required:"false"`
 
 	Port string `envconfig:"PORT" default:"8080"`
+	// The address metrics are served on, e.g. "localhost:9090".
+	// Metrics are not authenticated, so this must not be reachable from the internet.
+	// Metrics are disabled when not set.
+	MetricsAddr string `envconfig:"METRICS_ADDR" required:"false"`
 
 	JitsiAppId   string `envconfig:"JITSI_APP_ID" required:"false"`
 	JitsiKeyId   string `envconfig:"JITSI_KEY_ID" required:"false"`
@@ -23,6 +29,78 @@ type Config struct {
 	AuthIssuer   string `envconfig:"AUTH_ISSUER" default:"https://homecall.eu.auth0.com/"`
 	AuthAudience string `envconfig:"AUTH_AUDIENCE" default:"https://office-api.homecall.sidus.io"`
 
+	// Enrollment
+	// How long the enrollment key of a new device can be used.
+	EnrollmentKeyTTL time.Duration `envconfig:"ENROLLMENT_KEY_TTL" default:"168h"`
+	// The URL devices use to reach this instance, included in enrollment QR codes.
+	PublicURL string `envconfig:"PUBLIC_URL" default:"http://localhost:8080"`
+	// The key used to sign enrollment QR codes.
+	// If not set a random key is generated, which invalidates QR codes on restart.
+	EnrollmentSigningKey string `envconfig:"ENROLLMENT_SIGNING_KEY" required:"false"`
+	// The key used to hash pairing codes, shared by all replicas.
+	// If not set a random key is generated, which invalidates pairing codes on restart.
+	PairingCodeSecret string `envconfig:"PAIRING_CODE_SECRET" required:"false"`
+	// How long pairing codes can be used and how many failed attempts are allowed.
+	PairingCodeTTL                        time.Duration `envconfig:"PAIRING_CODE_TTL" default:"15m"`
+	PairingCodeAttemptWindow              time.Duration `envconfig:"PAIRING_CODE_ATTEMPT_WINDOW" default:"15m"`
+	PairingCodeMaxFailedAttemptsPerSource int           `envconfig:"PAIRING_CODE_MAX_FAILED_ATTEMPTS_PER_SOURCE" default:"10"`
+	PairingCodeMaxFailedAttempts          int           `envconfig:"PAIRING_CODE_MAX_FAILED_ATTEMPTS" default:"100"`
+	// The header a load balancer puts the client address in, e.g. X-Forwarded-For, and the number of proxies
+	// that append to it. Failed attempts are counted per client address, which is the address of the
+	// connection when no header is set, so this has to be set when running behind a load balancer.
+	PairingCodeSourceHeader   string `envconfig:"PAIRING_CODE_SOURCE_HEADER" required:"false"`
+	PairingCodeTrustedProxies int    `envconfig:"PAIRING_CODE_TRUSTED_PROXIES" default:"1"`
+
+	// Device attestation
+	// PEM files with the root certificates statements must chain to,
+	// attestation on a platform is unavailable when its roots are not configured.
+	PlayIntegrityRootsFile    string   `envconfig:"PLAY_INTEGRITY_ROOTS_FILE" required:"false"`
+	PlayIntegrityPackageNames []string `envconfig:"PLAY_INTEGRITY_PACKAGE_NAMES" required:"false"`
+	AppAttestRootsFile        string   `envconfig:"APP_ATTEST_ROOTS_FILE" required:"false"`
+	// App ids are "<team id>.<bundle id>".
+	AppAttestAppIDs           []string `envconfig:"APP_ATTEST_APP_IDS" required:"false"`
+	AppAttestAllowDevelopment bool     `envconfig:"APP_ATTEST_ALLOW_DEVELOPMENT" default:"false"`
+
+	// Device tokens
+	// The longest lifetime (exp - iat) accepted for tokens signed by devices.
+	DeviceTokenMaxLifetime time.Duration `envconfig:"DEVICE_TOKEN_MAX_LIFETIME" default:"10m"`
+
+	// Presence
+	// How long a device is considered online after its last heartbeat,
+	// depending on whether the app was in the foreground or background.
+	PresenceForegroundTimeout time.Duration `envconfig:"PRESENCE_FOREGROUND_TIMEOUT" default:"2m"`
+	PresenceBackgroundTimeout time.Duration `envconfig:"PRESENCE_BACKGROUND_TIMEOUT" default:"40m"`
+	// How often streams of device events check whether devices went offline.
+	DeviceWatchInterval time.Duration `envconfig:"DEVICE_WATCH_INTERVAL" default:"15s"`
+
+	// Diagnostics
+	// How long diagnostics reports from devices are kept.
+	DiagnosticsRetention time.Duration `envconfig:"DIAGNOSTICS_RETENTION" default:"720h"`
+
+	// Device logs
+	// The maximum size of an uploaded (compressed) log file and how long logs are kept.
+	DeviceLogMaxBytes  int64         `envconfig:"DEVICE_LOG_MAX_BYTES" default:"10485760"`
+	DeviceLogRetention time.Duration `envconfig:"DEVICE_LOG_RETENTION" default:"168h"`
+
+	// Scheduled calls
+	// How often scheduled calls are processed and how long before a scheduled call starts
+	// the device and the assigned member are reminded.
+	SchedulerInterval         time.Duration `envconfig:"SCHEDULER_INTERVAL" default:"30s"`
+	ScheduledCallReminderLead time.Duration `envconfig:"SCHEDULED_CALL_REMINDER_LEAD" default:"10m"`
+
+	// Messages
+	// The maximum size of a photo sent to a device.
+	MessageImageMaxBytes int64 `envconfig:"MESSAGE_IMAGE_MAX_BYTES" default:"2097152"`
+
+	// Albums
+	// The directory album images are stored in and the maximum size of an album image.
+	BlobStoreDir       string `envconfig:"BLOB_STORE_DIR" default:"data/blobs"`
+	AlbumImageMaxBytes int64  `envconfig:"ALBUM_IMAGE_MAX_BYTES" default:"10485760"`
+
+	// Missed calls
+	// How long a device has to join a call before it is counted as missed.
+	MissedCallTimeout time.Duration `envconfig:"MISSED_CALL_TIMEOUT" default:"1m"`
+
 	// Notifications
 	FirebaseProjectId    string `envconfig:"FIREBASE_PROJECT_ID" required:"false"`
 	MockNotificationsDir string `envconfig:"MOCK_NOTIFICATIONS_DIR" required:"false"`
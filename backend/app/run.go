@@ -4,6 +4,8 @@ import (
 	"connectrpc.com/connect"
 	"context"
 	"database/sql"
+	"errors"
+	"expvar"
 	"fmt"
 	"github.com/golang-jwt/jwt/v5"
 	"golang.org/x/net/http2"
@@ -13,6 +15,11 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"sidus.io/home-call/attestation"
+	"sidus.io/home-call/blobstore"
+	"sidus.io/home-call/blobstore/filesystemblobstore"
+	"sidus.io/home-call/calendar"
+	"sidus.io/home-call/enrollment"
 	"sidus.io/home-call/gen/connect/homecall/v1alpha/homecallv1alphaconnect"
 	"sidus.io/home-call/jitsi"
 	"sidus.io/home-call/messaging"
@@ -22,6 +29,8 @@ import (
 	"sidus.io/home-call/notifications/firebasenotifications"
 	"sidus.io/home-call/notifications/lognotifications"
 	"sidus.io/home-call/postgresdb"
+	"sidus.io/home-call/presence"
+	"sidus.io/home-call/scheduler"
 	"sidus.io/home-call/services/auth"
 	"sidus.io/home-call/services/deviceapi"
 	"sidus.io/home-call/services/officeapi"
@@ -106,12 +115,51 @@ func Run(ctx context.Context, logger *slog.Logger, cfg Config) error {
 		return fmt.Errorf("failed to setup notification service: %w", err)
 	}
 
+	// Blob store
+	blobStore, err := setupBlobStore(cfg, logger)
+	if err != nil {
+		return fmt.Errorf("failed to setup blob store: %w", err)
+	}
+
+	// Presence
+	presenceModel := presence.NewModel(presence.Config{
+		ForegroundTimeout: cfg.PresenceForegroundTimeout,
+		BackgroundTimeout: cfg.PresenceBackgroundTimeout,
+	})
+
+	// Enrollment
+	enrollmentIssuer, err := setupEnrollmentIssuer(cfg, logger)
+	if err != nil {
+		return fmt.Errorf("failed to setup enrollment issuer: %w", err)
+	}
+	pairingLimits := enrollment.PairingLimits{
+		MaxFailedAttemptsPerSource: cfg.PairingCodeMaxFailedAttemptsPerSource,
+		MaxFailedAttempts:          cfg.PairingCodeMaxFailedAttempts,
+		Window:                     cfg.PairingCodeAttemptWindow,
+		SourceHeader:               cfg.PairingCodeSourceHeader,
+		TrustedProxies:             cfg.PairingCodeTrustedProxies,
+	}
+	attestationVerifier, err := setupAttestationVerifier(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to setup attestation verifier: %w", err)
+	}
+
 	// Service layer
 	tenantService := tenantapi.NewService(db, logger.With("component", "tenantapi"), 2)
-	deviceService := deviceapi.NewService(db, broker, logger.With("component", "deviceapi"))
-	officeService := officeapi.NewService(db, broker, jitsiApp, logger.With("component", "officeapi"), tenantService, notificationService)
+	deviceService := deviceapi.NewService(db, broker, presenceModel, cfg.DeviceLogMaxBytes, enrollmentIssuer, pairingLimits, attestationVerifier, blobStore, logger.With("component", "deviceapi"))
+	calendarFeed := calendar.NewFeed(db, cfg.PublicURL, logger.With("component", "calendar"))
+	officeService := officeapi.NewService(db, broker, jitsiApp, logger.With("component", "officeapi"), tenantService, notificationService, presenceModel, cfg.DeviceWatchInterval, enrollmentIssuer, calendarFeed, cfg.MessageImageMaxBytes, blobStore, cfg.AlbumImageMaxBytes)
 	logger.Info("service layer created")
 
+	// Scheduler
+	callScheduler := scheduler.New(db, broker, notificationService, scheduler.Config{
+		Interval:             cfg.SchedulerInterval,
+		ReminderLead:         cfg.ScheduledCallReminderLead,
+		MissedCallTimeout:    cfg.MissedCallTimeout,
+		DiagnosticsRetention: cfg.DiagnosticsRetention,
+		DeviceLogRetention:   cfg.DeviceLogRetention,
+	}, logger.With("component", "scheduler"))
+
 	// Auth interceptor
 	authIssuerUrl, err := url.Parse(cfg.AuthIssuer)
 	if err != nil {
@@ -121,9 +169,10 @@ func Run(ctx context.Context, logger *slog.Logger, cfg Config) error {
 	if err != nil {
 		return fmt.Errorf("failed to create auth interceptor: %w", err)
 	}
+	deviceAuthInterceptor := auth.NewDeviceAuthInterceptor(db, cfg.DeviceTokenMaxLifetime, logger.With("component", "deviceauth"))
 
 	// Http server
-	httpServer, err := setupHttpServer(logger, cfg, deviceService, officeService, tenantService, authInterceptor)
+	httpServer, err := setupHttpServer(logger, cfg, deviceService, officeService, tenantService, calendarFeed, authInterceptor, deviceAuthInterceptor)
 	if err != nil {
 		return fmt.Errorf("failed to setup http server: %w", err)
 	}
@@ -142,6 +191,15 @@ func Run(ctx context.Context, logger *slog.Logger, cfg Config) error {
 	<-broker.Started()
 	logger.Info("broker started")
 
+	eg.Go(func() error {
+		logger.Info("starting scheduler")
+		err := callScheduler.Run(ctx)
+		if err != nil {
+			return fmt.Errorf("scheduler exited: %w", err)
+		}
+		return nil
+	})
+
 	eg.Go(func() error {
 		logger.Info("listening on port", "port", cfg.Port)
 		err := util.ListenAndServe(
@@ -155,6 +213,21 @@ func Run(ctx context.Context, logger *slog.Logger, cfg Config) error {
 		return nil
 	})
 
+	if cfg.MetricsAddr != "" {
+		eg.Go(func() error {
+			logger.Info("serving metrics", "addr", cfg.MetricsAddr)
+			err := util.ListenAndServe(
+				ctx,
+				setupMetricsServer(cfg),
+				15*time.Second,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to serve metrics: %w", err)
+			}
+			return nil
+		})
+	}
+
 	err = eg.Wait()
 	if err != nil {
 		return fmt.Errorf("application crashed: %w", err)
@@ -184,6 +257,20 @@ func setupNotificationService(cfg Config, logger *slog.Logger) (notifications.Se
 	}
 }
 
+func setupBlobStore(cfg Config, logger *slog.Logger) (blobstore.Store, error) {
+	switch {
+	case cfg.BlobStoreDir != "":
+		logger.Info("using filesystem blob store", "directory", cfg.BlobStoreDir)
+		store, err := filesystemblobstore.NewStore(cfg.BlobStoreDir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create filesystem blob store: %w", err)
+		}
+		return store, nil
+	default:
+		return nil, errors.New("no blob store configured")
+	}
+}
+
 func setupJitsiApp(cfg Config) (*jitsi.App, error) {
 	jitsiKeyData := []byte(cfg.JitsiKeyRaw)
 	if len(jitsiKeyData) == 0 {
@@ -200,16 +287,76 @@ func setupJitsiApp(cfg Config) (*jitsi.App, error) {
 	return jitsi.NewApp(cfg.JitsiAppId, cfg.JitsiKeyId, jitsiKey), nil
 }
 
+func setupAttestationVerifier(cfg Config) (*attestation.Verifier, error) {
+	attestationCfg := attestation.Config{
+		PlayIntegrityPackageNames: cfg.PlayIntegrityPackageNames,
+		AppAttestAppIDs:           cfg.AppAttestAppIDs,
+		AppAttestAllowDevelopment: cfg.AppAttestAllowDevelopment,
+	}
+	if cfg.PlayIntegrityRootsFile != "" {
+		rootsData, err := os.ReadFile(cfg.PlayIntegrityRootsFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read play integrity roots file: %w", err)
+		}
+		attestationCfg.PlayIntegrityRoots, err = attestation.ParseRoots(rootsData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse play integrity roots: %w", err)
+		}
+	}
+	if cfg.AppAttestRootsFile != "" {
+		rootsData, err := os.ReadFile(cfg.AppAttestRootsFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read app attest roots file: %w", err)
+		}
+		attestationCfg.AppAttestRoots, err = attestation.ParseRoots(rootsData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse app attest roots: %w", err)
+		}
+	}
+	return attestation.NewVerifier(attestationCfg), nil
+}
+
+func setupEnrollmentIssuer(cfg Config, logger *slog.Logger) (*enrollment.Issuer, error) {
+	signingKey := []byte(cfg.EnrollmentSigningKey)
+	if len(signingKey) == 0 {
+		logger.Warn("no enrollment signing key configured, generating a random one")
+		randomKey, err := util.RandomString(64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate enrollment signing key: %w", err)
+		}
+		signingKey = []byte(randomKey)
+	}
+	pairingCodeSecret := []byte(cfg.PairingCodeSecret)
+	if len(pairingCodeSecret) == 0 {
+		logger.Warn("no pairing code secret configured, generating a random one")
+		randomKey, err := util.RandomString(64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate pairing code secret: %w", err)
+		}
+		pairingCodeSecret = []byte(randomKey)
+	}
+	return enrollment.NewIssuer(enrollment.Config{
+		PublicURL:         cfg.PublicURL,
+		SigningKey:        signingKey,
+		KeyTTL:            cfg.EnrollmentKeyTTL,
+		PairingCodeTTL:    cfg.PairingCodeTTL,
+		PairingCodeSecret: pairingCodeSecret,
+	}), nil
+}
+
 func setupHttpServer(
 	logger *slog.Logger,
 	cfg Config,
 	deviceService *deviceapi.Service,
 	officeService *officeapi.Service,
 	tenantService *tenantapi.Service,
+	calendarFeed *calendar.Feed,
 	authInterceptor *auth.AuthInterceptor,
+	deviceAuthInterceptor *auth.DeviceAuthInterceptor,
 ) (*http.Server, error) {
 	deviceInterceptors := []connect.Interceptor{
 		newContextInterceptor(),
+		deviceAuthInterceptor,
 	}
 	officeInterceptors := []connect.Interceptor{
 		newContextInterceptor(),
@@ -230,6 +377,9 @@ func setupHttpServer(
 		connect.WithInterceptors(officeInterceptors...),
 	))
 
+	// Calendar feeds, authenticated by the token in the path
+	mux.Handle(calendar.Path, calendarFeed)
+
 	// TODO: Grpc health checks
 	// Readiness probe
 	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
@@ -241,12 +391,42 @@ func setupHttpServer(
 	})
 
 	server := &http.Server{
-		Handler: http.MaxBytesHandler(h2c.NewHandler(mux, &http2.Server{}), 1<<20 /* 1mb */),
-		Addr:    fmt.Sprintf(":%s", cfg.Port),
+		Handler: h2c.NewHandler(maxBytesHandler(mux, 1<<20 /* 1mb */, map[string]int64{
+			// Log uploads are streamed in chunks and limited by the device service,
+			// leave some room for the framing of the messages.
+			homecallv1alphaconnect.DeviceServiceUploadLogsProcedure: cfg.DeviceLogMaxBytes + 1<<20,
+			// Photos are sent in a single message, base64 encoded by JSON clients.
+			homecallv1alphaconnect.OfficeServiceSendMessageProcedure:   cfg.MessageImageMaxBytes*4/3 + 1<<20,
+			homecallv1alphaconnect.OfficeServiceAddAlbumImageProcedure: cfg.AlbumImageMaxBytes*4/3 + 1<<20,
+		}), &http2.Server{}),
+		Addr: fmt.Sprintf(":%s", cfg.Port),
 	}
 	return server, nil
 }
 
+// setupMetricsServer returns the server for the metrics, which is kept apart from the public server
+// since the metrics are not authenticated and expose the command line and memory statistics.
+func setupMetricsServer(cfg Config) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("/debug/vars", expvar.Handler())
+	return &http.Server{
+		Handler: mux,
+		Addr:    cfg.MetricsAddr,
+	}
+}
+
+// maxBytesHandler limits the size of request bodies to limit,
+// except for the paths in overrides which get their own limit.
+func maxBytesHandler(h http.Handler, limit int64, overrides map[string]int64) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := limit
+		if override, ok := overrides[r.URL.Path]; ok {
+			n = override
+		}
+		http.MaxBytesHandler(h, n).ServeHTTP(w, r)
+	})
+}
+
 type contextInterceptor struct{}
 
 func newContextInterceptor() *contextInterceptor {
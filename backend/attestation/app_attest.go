@@ -0,0 +1,181 @@
+package attestation
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+var (
+	// appAttestNonceOID is the extension of the credential certificate that contains the nonce.
+	appAttestNonceOID = asn1.ObjectIdentifier{1, 2, 840, 113635, 100, 8, 2}
+
+	appAttestAAGUIDProduction  = []byte("appattest\x00\x00\x00\x00\x00\x00\x00")
+	appAttestAAGUIDDevelopment = []byte("appattestdevelop")
+)
+
+// appAttestNonce is the content of the nonce extension.
+type appAttestNonce struct {
+	Nonce []byte `asn1:"tag:1,explicit"`
+}
+
+// VerifyAppAttest verifies an App Attest attestation object for the key identified by keyID.
+// The client data hash the attestation was created with must be the SHA-256 hash of clientData.
+func (v *Verifier) VerifyAppAttest(attestationObject []byte, keyID []byte, clientData []byte) error {
+	if v.cfg.AppAttestRoots == nil {
+		return ErrNotConfigured
+	}
+
+	decoded, err := decodeCBOR(attestationObject)
+	if err != nil {
+		return fmt.Errorf("failed to decode attestation object: %w", err)
+	}
+	object, ok := decoded.(map[string]interface{})
+	if !ok {
+		return errors.New("attestation object is not a map")
+	}
+	if format, _ := object["fmt"].(string); format != "apple-appattest" {
+		return fmt.Errorf("unexpected format %q", format)
+	}
+	authData, ok := object["authData"].([]byte)
+	if !ok {
+		return errors.New("missing authData")
+	}
+	statement, ok := object["attStmt"].(map[string]interface{})
+	if !ok {
+		return errors.New("missing attStmt")
+	}
+	rawChain, ok := statement["x5c"].([]interface{})
+	if !ok || len(rawChain) == 0 {
+		return errors.New("missing x5c")
+	}
+
+	// The credential certificate must chain to a trusted root
+	var chain []*x509.Certificate
+	for _, rawCert := range rawChain {
+		der, ok := rawCert.([]byte)
+		if !ok {
+			return errors.New("invalid x5c")
+		}
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return fmt.Errorf("failed to parse certificate: %w", err)
+		}
+		chain = append(chain, cert)
+	}
+	credentialCert := chain[0]
+	intermediates := x509.NewCertPool()
+	for _, cert := range chain[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err = credentialCert.Verify(x509.VerifyOptions{
+		Roots:         v.cfg.AppAttestRoots,
+		Intermediates: intermediates,
+		CurrentTime:   v.now(),
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to verify certificate chain: %w", err)
+	}
+
+	// The nonce binds the attestation to the authenticator data and the client data
+	nonceInput := append(append([]byte{}, authData...), clientDataHash(clientData)...)
+	expectedNonce := sha256.Sum256(nonceInput)
+	nonce, err := credentialCertNonce(credentialCert)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(nonce, expectedNonce[:]) {
+		return errors.New("nonce does not match")
+	}
+
+	// The key id is the hash of the attested public key
+	publicKey, ok := credentialCert.PublicKey.(*ecdsa.PublicKey)
+	if !ok {
+		return errors.New("credential certificate does not contain an ECDSA key")
+	}
+	ecdhKey, err := publicKey.ECDH()
+	if err != nil {
+		return fmt.Errorf("invalid credential key: %w", err)
+	}
+	publicKeyHash := sha256.Sum256(ecdhKey.Bytes())
+	if !bytes.Equal(publicKeyHash[:], keyID) {
+		return errors.New("key id does not match")
+	}
+
+	return v.checkAppAttestAuthData(authData, keyID)
+}
+
+// checkAppAttestAuthData checks the authenticator data of a new App Attest key.
+func (v *Verifier) checkAppAttestAuthData(authData []byte, keyID []byte) error {
+	// rpIdHash (32) | flags (1) | counter (4) | aaguid (16) | credentialIdLength (2) | credentialId | ...
+	if len(authData) < 55 {
+		return errors.New("authData is too short")
+	}
+	rpIDHash := authData[:32]
+	flags := authData[32]
+	counter := binary.BigEndian.Uint32(authData[33:37])
+	aaguid := authData[37:53]
+	credentialIDLength := int(binary.BigEndian.Uint16(authData[53:55]))
+	if len(authData) < 55+credentialIDLength {
+		return errors.New("authData is too short")
+	}
+	credentialID := authData[55 : 55+credentialIDLength]
+
+	allowed := false
+	for _, appID := range v.cfg.AppAttestAppIDs {
+		appIDHash := sha256.Sum256([]byte(appID))
+		if bytes.Equal(rpIDHash, appIDHash[:]) {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return errors.New("app id is not allowed")
+	}
+
+	if flags&0x40 == 0 {
+		return errors.New("missing attested credential data")
+	}
+	if counter != 0 {
+		return errors.New("counter of a new key must be zero")
+	}
+
+	switch {
+	case bytes.Equal(aaguid, appAttestAAGUIDProduction):
+	case bytes.Equal(aaguid, appAttestAAGUIDDevelopment):
+		if !v.cfg.AppAttestAllowDevelopment {
+			return errors.New("attestation is from the development environment")
+		}
+	default:
+		return errors.New("unexpected aaguid")
+	}
+
+	if !bytes.Equal(credentialID, keyID) {
+		return errors.New("credential id does not match")
+	}
+	return nil
+}
+
+func credentialCertNonce(cert *x509.Certificate) ([]byte, error) {
+	for _, extension := range cert.Extensions {
+		if !extension.Id.Equal(appAttestNonceOID) {
+			continue
+		}
+		var nonce appAttestNonce
+		rest, err := asn1.Unmarshal(extension.Value, &nonce)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse nonce: %w", err)
+		}
+		if len(rest) != 0 {
+			return nil, errors.New("trailing data after nonce")
+		}
+		return nonce.Nonce, nil
+	}
+	return nil, errors.New("credential certificate has no nonce")
+}
@@ -0,0 +1,87 @@
+// Package attestation verifies statements from the platform that vouch for the app and device that enrolls,
+// Play Integrity verdicts on Android and App Attest attestations on iOS.
+// Statements are verified offline against configured root certificates.
+package attestation
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Type is the kind of statement a device was attested with.
+type Type string
+
+const (
+	TypePlayIntegrity Type = "play_integrity"
+	TypeAppAttest     Type = "app_attest"
+)
+
+// ErrNotConfigured is returned when a statement is verified for a platform without configured roots.
+var ErrNotConfigured = errors.New("attestation is not configured for this platform")
+
+// statementMaxAge is how old a statement may be when it is verified.
+const statementMaxAge = 10 * time.Minute
+
+type Config struct {
+	// PlayIntegrityRoots are the certificates the signing chain of Play Integrity verdicts must chain to.
+	PlayIntegrityRoots *x509.CertPool
+	// PlayIntegrityPackageNames are the package names of the Android apps that may enroll.
+	PlayIntegrityPackageNames []string
+
+	// AppAttestRoots are the certificates the chain of App Attest attestations must chain to.
+	AppAttestRoots *x509.CertPool
+	// AppAttestAppIDs are the app ids ("<team id>.<bundle id>") of the iOS apps that may enroll.
+	AppAttestAppIDs []string
+	// AppAttestAllowDevelopment accepts attestations made in the App Attest development environment.
+	AppAttestAllowDevelopment bool
+}
+
+// Verifier verifies attestation statements, it is safe for concurrent use.
+type Verifier struct {
+	cfg Config
+	now func() time.Time
+}
+
+func NewVerifier(cfg Config) *Verifier {
+	return &Verifier{
+		cfg: cfg,
+		now: time.Now,
+	}
+}
+
+// ParseRoots parses one or more PEM encoded certificates into a pool of roots.
+func ParseRoots(pemBytes []byte) (*x509.CertPool, error) {
+	pool := x509.NewCertPool()
+	count := 0
+	for {
+		var block *pem.Block
+		block, pemBytes = pem.Decode(pemBytes)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			return nil, fmt.Errorf("unexpected PEM block %q", block.Type)
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse certificate: %w", err)
+		}
+		pool.AddCert(cert)
+		count++
+	}
+	if count == 0 {
+		return nil, errors.New("no certificates found")
+	}
+	return pool, nil
+}
+
+// clientDataHash is the hash statements are bound to,
+// so a statement can not be reused to enroll another key.
+func clientDataHash(clientData []byte) []byte {
+	hash := sha256.Sum256(clientData)
+	return hash[:]
+}
@@ -0,0 +1,145 @@
+package attestation
+
+import (
+	"encoding/base64"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// The samples in testdata are recorded with testdata/generate.go at this time.
+var statementTime = time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+func readTestData(t *testing.T, name string) []byte {
+	t.Helper()
+	content, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	return content
+}
+
+func testVerifier(t *testing.T) *Verifier {
+	t.Helper()
+	playIntegrityRoots, err := ParseRoots(readTestData(t, "play_integrity_root.pem"))
+	require.NoError(t, err)
+	appAttestRoots, err := ParseRoots(readTestData(t, "app_attest_root.pem"))
+	require.NoError(t, err)
+
+	verifier := NewVerifier(Config{
+		PlayIntegrityRoots:        playIntegrityRoots,
+		PlayIntegrityPackageNames: []string{"io.sidus.homecall"},
+		AppAttestRoots:            appAttestRoots,
+		AppAttestAppIDs:           []string{"ABCDE12345.io.sidus.homecall"},
+	})
+	verifier.now = func() time.Time { return statementTime.Add(time.Minute) }
+	return verifier
+}
+
+func TestVerifyPlayIntegrity(t *testing.T) {
+	token := string(readTestData(t, "play_integrity.jws"))
+	clientData := readTestData(t, "device_key.pem")
+
+	t.Run("valid", func(t *testing.T) {
+		err := testVerifier(t).VerifyPlayIntegrity(token, clientData)
+		require.NoError(t, err)
+	})
+
+	t.Run("other client data", func(t *testing.T) {
+		err := testVerifier(t).VerifyPlayIntegrity(token, []byte("another key"))
+		require.ErrorContains(t, err, "nonce does not match")
+	})
+
+	t.Run("package not allowed", func(t *testing.T) {
+		verifier := testVerifier(t)
+		verifier.cfg.PlayIntegrityPackageNames = []string{"io.sidus.other"}
+		err := verifier.VerifyPlayIntegrity(token, clientData)
+		require.ErrorContains(t, err, "not allowed")
+	})
+
+	t.Run("untrusted root", func(t *testing.T) {
+		verifier := testVerifier(t)
+		verifier.cfg.PlayIntegrityRoots = verifier.cfg.AppAttestRoots
+		err := verifier.VerifyPlayIntegrity(token, clientData)
+		require.ErrorContains(t, err, "certificate chain")
+	})
+
+	t.Run("tampered payload", func(t *testing.T) {
+		parts := strings.Split(token, ".")
+		payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+		require.NoError(t, err)
+		payload = []byte(strings.Replace(string(payload), "PLAY_RECOGNIZED", "UNEVALUATED", 1))
+		parts[1] = base64.RawURLEncoding.EncodeToString(payload)
+
+		err = testVerifier(t).VerifyPlayIntegrity(strings.Join(parts, "."), clientData)
+		require.ErrorContains(t, err, "signature")
+	})
+
+	t.Run("old verdict", func(t *testing.T) {
+		verifier := testVerifier(t)
+		verifier.now = func() time.Time { return statementTime.Add(time.Hour) }
+		err := verifier.VerifyPlayIntegrity(token, clientData)
+		require.ErrorContains(t, err, "not recent")
+	})
+
+	t.Run("not configured", func(t *testing.T) {
+		err := NewVerifier(Config{}).VerifyPlayIntegrity(token, clientData)
+		require.ErrorIs(t, err, ErrNotConfigured)
+	})
+}
+
+func TestVerifyAppAttest(t *testing.T) {
+	attestationObject := readTestData(t, "app_attest.cbor")
+	keyID, err := base64.StdEncoding.DecodeString(string(readTestData(t, "app_attest_key_id.txt")))
+	require.NoError(t, err)
+	clientData := readTestData(t, "device_key.pem")
+
+	t.Run("valid", func(t *testing.T) {
+		err := testVerifier(t).VerifyAppAttest(attestationObject, keyID, clientData)
+		require.NoError(t, err)
+	})
+
+	t.Run("other client data", func(t *testing.T) {
+		err := testVerifier(t).VerifyAppAttest(attestationObject, keyID, []byte("another key"))
+		require.ErrorContains(t, err, "nonce does not match")
+	})
+
+	t.Run("other key id", func(t *testing.T) {
+		otherKeyID := append([]byte{}, keyID...)
+		otherKeyID[0] ^= 0xff
+		err := testVerifier(t).VerifyAppAttest(attestationObject, otherKeyID, clientData)
+		require.ErrorContains(t, err, "key id does not match")
+	})
+
+	t.Run("app id not allowed", func(t *testing.T) {
+		verifier := testVerifier(t)
+		verifier.cfg.AppAttestAppIDs = []string{"ABCDE12345.io.sidus.other"}
+		err := verifier.VerifyAppAttest(attestationObject, keyID, clientData)
+		require.ErrorContains(t, err, "app id is not allowed")
+	})
+
+	t.Run("untrusted root", func(t *testing.T) {
+		verifier := testVerifier(t)
+		verifier.cfg.AppAttestRoots = verifier.cfg.PlayIntegrityRoots
+		err := verifier.VerifyAppAttest(attestationObject, keyID, clientData)
+		require.ErrorContains(t, err, "certificate chain")
+	})
+
+	t.Run("expired certificates", func(t *testing.T) {
+		verifier := testVerifier(t)
+		verifier.now = func() time.Time { return statementTime.AddDate(20, 0, 0) }
+		err := verifier.VerifyAppAttest(attestationObject, keyID, clientData)
+		require.ErrorContains(t, err, "certificate chain")
+	})
+
+	t.Run("truncated object", func(t *testing.T) {
+		err := testVerifier(t).VerifyAppAttest(attestationObject[:len(attestationObject)-1], keyID, clientData)
+		require.ErrorContains(t, err, "failed to decode")
+	})
+
+	t.Run("not configured", func(t *testing.T) {
+		err := NewVerifier(Config{}).VerifyAppAttest(attestationObject, keyID, clientData)
+		require.ErrorIs(t, err, ErrNotConfigured)
+	})
+}
@@ -0,0 +1,131 @@
+package attestation
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// maxCBORDepth limits nesting so hostile input can not exhaust the stack.
+const maxCBORDepth = 16
+
+// decodeCBOR decodes the subset of CBOR used by attestation objects:
+// integers, byte and text strings, arrays and maps with text keys, all with definite lengths.
+// Integers are returned as int64, byte strings as []byte, maps as map[string]interface{}.
+func decodeCBOR(data []byte) (interface{}, error) {
+	value, rest, err := decodeCBORItem(data, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) != 0 {
+		return nil, errors.New("trailing data after CBOR item")
+	}
+	return value, nil
+}
+
+func decodeCBORItem(data []byte, depth int) (interface{}, []byte, error) {
+	if depth > maxCBORDepth {
+		return nil, nil, errors.New("CBOR nesting is too deep")
+	}
+	if len(data) == 0 {
+		return nil, nil, errors.New("unexpected end of CBOR data")
+	}
+	majorType := data[0] >> 5
+	argument, data, err := decodeCBORArgument(data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch majorType {
+	case 0:
+		if argument > 1<<63-1 {
+			return nil, nil, errors.New("CBOR integer overflows")
+		}
+		return int64(argument), data, nil
+	case 1:
+		if argument > 1<<63-1 {
+			return nil, nil, errors.New("CBOR integer overflows")
+		}
+		return -1 - int64(argument), data, nil
+	case 2, 3:
+		if argument > uint64(len(data)) {
+			return nil, nil, errors.New("unexpected end of CBOR data")
+		}
+		content := data[:argument]
+		if majorType == 3 {
+			return string(content), data[argument:], nil
+		}
+		return append([]byte{}, content...), data[argument:], nil
+	case 4:
+		// Every item is at least one byte, which bounds the allocation
+		if argument > uint64(len(data)) {
+			return nil, nil, errors.New("unexpected end of CBOR data")
+		}
+		items := make([]interface{}, 0, argument)
+		for i := uint64(0); i < argument; i++ {
+			var item interface{}
+			item, data, err = decodeCBORItem(data, depth+1)
+			if err != nil {
+				return nil, nil, err
+			}
+			items = append(items, item)
+		}
+		return items, data, nil
+	case 5:
+		if argument > uint64(len(data)) {
+			return nil, nil, errors.New("unexpected end of CBOR data")
+		}
+		items := make(map[string]interface{}, argument)
+		for i := uint64(0); i < argument; i++ {
+			var key, value interface{}
+			key, data, err = decodeCBORItem(data, depth+1)
+			if err != nil {
+				return nil, nil, err
+			}
+			textKey, ok := key.(string)
+			if !ok {
+				return nil, nil, errors.New("CBOR map key is not a text string")
+			}
+			value, data, err = decodeCBORItem(data, depth+1)
+			if err != nil {
+				return nil, nil, err
+			}
+			items[textKey] = value
+		}
+		return items, data, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported CBOR major type %d", majorType)
+	}
+}
+
+// decodeCBORArgument decodes the argument of the initial byte of an item.
+func decodeCBORArgument(data []byte) (uint64, []byte, error) {
+	additional := data[0] & 0x1f
+	data = data[1:]
+	switch {
+	case additional < 24:
+		return uint64(additional), data, nil
+	case additional == 24:
+		if len(data) < 1 {
+			return 0, nil, errors.New("unexpected end of CBOR data")
+		}
+		return uint64(data[0]), data[1:], nil
+	case additional == 25:
+		if len(data) < 2 {
+			return 0, nil, errors.New("unexpected end of CBOR data")
+		}
+		return uint64(binary.BigEndian.Uint16(data)), data[2:], nil
+	case additional == 26:
+		if len(data) < 4 {
+			return 0, nil, errors.New("unexpected end of CBOR data")
+		}
+		return uint64(binary.BigEndian.Uint32(data)), data[4:], nil
+	case additional == 27:
+		if len(data) < 8 {
+			return 0, nil, errors.New("unexpected end of CBOR data")
+		}
+		return binary.BigEndian.Uint64(data), data[8:], nil
+	default:
+		return 0, nil, errors.New("indefinite length CBOR items are not supported")
+	}
+}
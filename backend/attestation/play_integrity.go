@@ -0,0 +1,103 @@
+package attestation
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	jose "gopkg.in/go-jose/go-jose.v2"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	playRecognized       = "PLAY_RECOGNIZED"
+	meetsDeviceIntegrity = "MEETS_DEVICE_INTEGRITY"
+)
+
+// playIntegrityVerdict contains the parts of a Play Integrity verdict that are checked.
+type playIntegrityVerdict struct {
+	RequestDetails struct {
+		RequestPackageName string `json:"requestPackageName"`
+		Nonce              string `json:"nonce"`
+		TimestampMillis    string `json:"timestampMillis"`
+	} `json:"requestDetails"`
+	AppIntegrity struct {
+		AppRecognitionVerdict string `json:"appRecognitionVerdict"`
+		PackageName           string `json:"packageName"`
+	} `json:"appIntegrity"`
+	DeviceIntegrity struct {
+		DeviceRecognitionVerdict []string `json:"deviceRecognitionVerdict"`
+	} `json:"deviceIntegrity"`
+}
+
+// VerifyPlayIntegrity verifies a decrypted Play Integrity verdict.
+// The verdict is a JWS carrying its signing certificate chain in the x5c header,
+// its nonce must be the base64url encoded SHA-256 hash of clientData.
+func (v *Verifier) VerifyPlayIntegrity(token string, clientData []byte) error {
+	if v.cfg.PlayIntegrityRoots == nil {
+		return ErrNotConfigured
+	}
+
+	jws, err := jose.ParseSigned(token)
+	if err != nil {
+		return fmt.Errorf("failed to parse verdict: %w", err)
+	}
+	if len(jws.Signatures) != 1 {
+		return errors.New("verdict must have exactly one signature")
+	}
+
+	now := v.now()
+	chains, err := jws.Signatures[0].Protected.Certificates(x509.VerifyOptions{
+		Roots:       v.cfg.PlayIntegrityRoots,
+		CurrentTime: now,
+		KeyUsages:   []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to verify certificate chain: %w", err)
+	}
+	payload, err := jws.Verify(chains[0][0].PublicKey)
+	if err != nil {
+		return fmt.Errorf("failed to verify signature: %w", err)
+	}
+
+	var verdict playIntegrityVerdict
+	err = json.Unmarshal(payload, &verdict)
+	if err != nil {
+		return fmt.Errorf("failed to parse verdict: %w", err)
+	}
+
+	if !slices.Contains(v.cfg.PlayIntegrityPackageNames, verdict.RequestDetails.RequestPackageName) ||
+		verdict.AppIntegrity.PackageName != verdict.RequestDetails.RequestPackageName {
+		return fmt.Errorf("package %q is not allowed", verdict.RequestDetails.RequestPackageName)
+	}
+
+	nonce, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(verdict.RequestDetails.Nonce, "="))
+	if err != nil {
+		return fmt.Errorf("failed to decode nonce: %w", err)
+	}
+	if string(nonce) != string(clientDataHash(clientData)) {
+		return errors.New("nonce does not match")
+	}
+
+	timestampMillis, err := strconv.ParseInt(verdict.RequestDetails.TimestampMillis, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse timestamp: %w", err)
+	}
+	age := now.Sub(time.UnixMilli(timestampMillis))
+	if age > statementMaxAge || age < -time.Minute {
+		return errors.New("verdict is not recent")
+	}
+
+	if verdict.AppIntegrity.AppRecognitionVerdict != playRecognized {
+		return fmt.Errorf("app is not recognized: %s", verdict.AppIntegrity.AppRecognitionVerdict)
+	}
+	if !slices.Contains(verdict.DeviceIntegrity.DeviceRecognitionVerdict, meetsDeviceIntegrity) {
+		return errors.New("device does not meet device integrity")
+	}
+
+	return nil
+}
@@ -0,0 +1,257 @@
+//go:build ignore
+
+// generate records the sample statements used by the attestation tests.
+// The statements are signed by throwaway roots instead of the Google and Apple ones,
+// but otherwise have the same structure as the statements sent by devices.
+//
+// Run with: go run ./attestation/testdata/generate.go
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	jose "gopkg.in/go-jose/go-jose.v2"
+	"log"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+var (
+	dir = filepath.Join("attestation", "testdata")
+
+	// statementTime is when the statements were made, the tests verify them shortly after.
+	statementTime = time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	notBefore     = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	notAfter      = time.Date(2036, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	packageName = "io.sidus.homecall"
+	appID       = "ABCDE12345.io.sidus.homecall"
+)
+
+func main() {
+	deviceKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	check(err)
+	deviceKeyDER, err := x509.MarshalPKIXPublicKey(&deviceKey.PublicKey)
+	check(err)
+	devicePublicKey := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: deviceKeyDER})
+	write("device_key.pem", devicePublicKey)
+	clientDataHash := sha256.Sum256(devicePublicKey)
+
+	generatePlayIntegrity(clientDataHash[:])
+	generateAppAttest(clientDataHash[:])
+}
+
+func generatePlayIntegrity(clientDataHash []byte) {
+	root, rootKey := certificate("Test Play Integrity Root", nil, nil, true, nil)
+	write("play_integrity_root.pem", pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: root.Raw}))
+	leaf, leafKey := certificate("Test Play Integrity Signer", root, rootKey, false, nil)
+
+	verdict := map[string]interface{}{
+		"requestDetails": map[string]interface{}{
+			"requestPackageName": packageName,
+			"nonce":              base64.RawURLEncoding.EncodeToString(clientDataHash),
+			"timestampMillis":    strconv.FormatInt(statementTime.UnixMilli(), 10),
+		},
+		"appIntegrity": map[string]interface{}{
+			"appRecognitionVerdict": "PLAY_RECOGNIZED",
+			"packageName":           packageName,
+			"versionCode":           "42",
+		},
+		"deviceIntegrity": map[string]interface{}{
+			"deviceRecognitionVerdict": []string{"MEETS_DEVICE_INTEGRITY"},
+		},
+		"accountDetails": map[string]interface{}{
+			"appLicensingVerdict": "LICENSED",
+		},
+	}
+	payload, err := json.Marshal(verdict)
+	check(err)
+
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.ES256, Key: leafKey},
+		(&jose.SignerOptions{}).WithHeader("x5c", []string{
+			base64.StdEncoding.EncodeToString(leaf.Raw),
+		}),
+	)
+	check(err)
+	jws, err := signer.Sign(payload)
+	check(err)
+	token, err := jws.CompactSerialize()
+	check(err)
+	write("play_integrity.jws", []byte(token))
+}
+
+func generateAppAttest(clientDataHash []byte) {
+	root, rootKey := certificate("Test App Attestation Root CA", nil, nil, true, nil)
+	write("app_attest_root.pem", pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: root.Raw}))
+	intermediate, intermediateKey := certificate("Test App Attestation CA 1", root, rootKey, true, nil)
+
+	credentialKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	check(err)
+	ecdhKey, err := credentialKey.PublicKey.ECDH()
+	check(err)
+	keyID := sha256.Sum256(ecdhKey.Bytes())
+	write("app_attest_key_id.txt", []byte(base64.StdEncoding.EncodeToString(keyID[:])))
+
+	// Authenticator data with the attested credential
+	rpIDHash := sha256.Sum256([]byte(appID))
+	authData := append([]byte{}, rpIDHash[:]...)
+	authData = append(authData, 0x41)
+	authData = binary.BigEndian.AppendUint32(authData, 0)
+	authData = append(authData, []byte("appattest\x00\x00\x00\x00\x00\x00\x00")...)
+	authData = binary.BigEndian.AppendUint16(authData, uint16(len(keyID)))
+	authData = append(authData, keyID[:]...)
+	authData = append(authData, encodeCBOR(coseKey(&credentialKey.PublicKey))...)
+
+	nonce := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash...))
+	nonceExtension, err := asn1.Marshal(struct {
+		Nonce []byte `asn1:"tag:1,explicit"`
+	}{Nonce: nonce[:]})
+	check(err)
+	credentialCert, _ := certificate(fmt.Sprintf("%x", keyID), intermediate, intermediateKey, false, &pkix.Extension{
+		Id:    asn1.ObjectIdentifier{1, 2, 840, 113635, 100, 8, 2},
+		Value: nonceExtension,
+	}, credentialKey)
+
+	attestationObject := cborMap{
+		{"fmt", "apple-appattest"},
+		{"attStmt", cborMap{
+			{"x5c", []interface{}{credentialCert.Raw, intermediate.Raw}},
+			{"receipt", []byte("receipt")},
+		}},
+		{"authData", authData},
+	}
+	write("app_attest.cbor", encodeCBOR(attestationObject))
+}
+
+// certificate creates a certificate signed by parent, or a self signed one if parent is nil.
+func certificate(name string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey, ca bool, extension *pkix.Extension, key ...*ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	var privateKey *ecdsa.PrivateKey
+	if len(key) > 0 {
+		privateKey = key[0]
+	} else {
+		var err error
+		privateKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		check(err)
+	}
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	check(err)
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		BasicConstraintsValid: true,
+		IsCA:                  ca,
+	}
+	if ca {
+		template.KeyUsage = x509.KeyUsageCertSign
+	} else {
+		template.KeyUsage = x509.KeyUsageDigitalSignature
+	}
+	if extension != nil {
+		template.ExtraExtensions = []pkix.Extension{*extension}
+	}
+	if parent == nil {
+		parent = template
+		parentKey = privateKey
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &privateKey.PublicKey, parentKey)
+	check(err)
+	cert, err := x509.ParseCertificate(der)
+	check(err)
+	return cert, privateKey
+}
+
+func coseKey(publicKey *ecdsa.PublicKey) cborIntMap {
+	return cborIntMap{
+		{1, int64(2)},
+		{3, int64(-7)},
+		{-1, int64(1)},
+		{-2, publicKey.X.FillBytes(make([]byte, 32))},
+		{-3, publicKey.Y.FillBytes(make([]byte, 32))},
+	}
+}
+
+type cborMap []struct {
+	key   string
+	value interface{}
+}
+
+type cborIntMap []struct {
+	key   int64
+	value interface{}
+}
+
+func encodeCBOR(value interface{}) []byte {
+	switch v := value.(type) {
+	case int64:
+		if v < 0 {
+			return cborHead(1, uint64(-1-v))
+		}
+		return cborHead(0, uint64(v))
+	case []byte:
+		return append(cborHead(2, uint64(len(v))), v...)
+	case string:
+		return append(cborHead(3, uint64(len(v))), v...)
+	case []interface{}:
+		out := cborHead(4, uint64(len(v)))
+		for _, item := range v {
+			out = append(out, encodeCBOR(item)...)
+		}
+		return out
+	case cborMap:
+		out := cborHead(5, uint64(len(v)))
+		for _, item := range v {
+			out = append(out, encodeCBOR(item.key)...)
+			out = append(out, encodeCBOR(item.value)...)
+		}
+		return out
+	case cborIntMap:
+		out := cborHead(5, uint64(len(v)))
+		for _, item := range v {
+			out = append(out, encodeCBOR(item.key)...)
+			out = append(out, encodeCBOR(item.value)...)
+		}
+		return out
+	default:
+		log.Fatalf("unsupported CBOR value %T", value)
+		return nil
+	}
+}
+
+func cborHead(majorType byte, argument uint64) []byte {
+	switch {
+	case argument < 24:
+		return []byte{majorType<<5 | byte(argument)}
+	case argument <= 0xff:
+		return []byte{majorType<<5 | 24, byte(argument)}
+	case argument <= 0xffff:
+		return binary.BigEndian.AppendUint16([]byte{majorType<<5 | 25}, uint16(argument))
+	default:
+		return binary.BigEndian.AppendUint32([]byte{majorType<<5 | 26}, uint32(argument))
+	}
+}
+
+func write(name string, content []byte) {
+	check(os.WriteFile(filepath.Join(dir, name), content, 0o644))
+}
+
+func check(err error) {
+	if err != nil {
+		log.Fatal(err)
+	}
+}
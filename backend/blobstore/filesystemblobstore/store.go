@@ -0,0 +1,98 @@
+package filesystemblobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sidus.io/home-call/blobstore"
+	"strings"
+)
+
+var _ blobstore.Store = (*Store)(nil)
+
+// Store keeps blobs as files in a directory on the local filesystem.
+type Store struct {
+	directory string
+}
+
+func NewStore(directory string) (*Store, error) {
+	err := os.MkdirAll(directory, 0755)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	return &Store{
+		directory: directory,
+	}, nil
+}
+
+func (s *Store) Put(ctx context.Context, key string, content io.Reader) error {
+	fileName, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so that readers never see a partial blob
+	file, err := os.CreateTemp(s.directory, ".upload-*")
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+	defer os.Remove(file.Name())
+
+	_, err = io.Copy(file, content)
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write blob: %w", err)
+	}
+	err = file.Close()
+	if err != nil {
+		return fmt.Errorf("failed to close file: %w", err)
+	}
+
+	err = os.Rename(file.Name(), fileName)
+	if err != nil {
+		return fmt.Errorf("failed to move blob into place: %w", err)
+	}
+	return nil
+}
+
+func (s *Store) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	fileName, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(fileName)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, blobstore.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to open blob: %w", err)
+	}
+	return file, nil
+}
+
+func (s *Store) Delete(ctx context.Context, key string) error {
+	fileName, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(fileName)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to remove blob: %w", err)
+	}
+	return nil
+}
+
+// path returns the file of a key, keys must not point outside of the directory.
+func (s *Store) path(key string) (string, error) {
+	if key == "" || strings.HasPrefix(key, ".") || strings.ContainsAny(key, `/\`) {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+	return filepath.Join(s.directory, key), nil
+}
@@ -0,0 +1,20 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrNotFound is returned when no blob is stored under a key.
+var ErrNotFound = errors.New("blob not found")
+
+// Store stores the content of uploaded files, such as album images, by key.
+type Store interface {
+	// Put stores the content under a key, replacing any content stored under it.
+	Put(ctx context.Context, key string, content io.Reader) error
+	// Get returns the content stored under a key, or ErrNotFound.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes the content stored under a key, removing a missing key is not an error.
+	Delete(ctx context.Context, key string) error
+}
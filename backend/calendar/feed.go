@@ -0,0 +1,146 @@
+package calendar
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	. "github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"log/slog"
+	"net/http"
+	"sidus.io/home-call/gen/jetdb/public/model"
+	. "sidus.io/home-call/gen/jetdb/public/table"
+	"sidus.io/home-call/util"
+	"strings"
+	"time"
+)
+
+// Path is the path the feeds are served under, followed by the token and ".ics".
+const Path = "/calendar/"
+
+// feedHistory is how long past scheduled calls stay in the feeds.
+const feedHistory = 30 * 24 * time.Hour
+
+// Feed serves the scheduled calls assigned to tenant members as iCalendar feeds,
+// authenticated by feed tokens in the URL since calendar apps can not send other credentials.
+type Feed struct {
+	db        *sql.DB
+	publicURL string
+	logger    *slog.Logger
+}
+
+func NewFeed(db *sql.DB, publicURL string, logger *slog.Logger) *Feed {
+	return &Feed{
+		db:        db,
+		publicURL: strings.TrimSuffix(publicURL, "/"),
+		logger:    logger,
+	}
+}
+
+// URL returns the URL of the feed for a token.
+func (f *Feed) URL(token string) string {
+	return f.publicURL + Path + token + ".ics"
+}
+
+func (f *Feed) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	token, ok := strings.CutPrefix(r.URL.Path, Path)
+	if ok {
+		token, ok = strings.CutSuffix(token, ".ics")
+	}
+	if !ok || token == "" {
+		http.NotFound(w, r)
+		return
+	}
+
+	ctx := r.Context()
+	now := time.Now().UTC()
+
+	var dbToken struct {
+		model.CalendarFeedToken
+		model.Tenant
+	}
+	err := SELECT(
+		CalendarFeedToken.ID,
+		CalendarFeedToken.MemberID,
+		Tenant.Name,
+	).FROM(
+		CalendarFeedToken.
+			INNER_JOIN(UserTenant, UserTenant.MemberID.EQ(CalendarFeedToken.MemberID)).
+			INNER_JOIN(Tenant, Tenant.ID.EQ(UserTenant.TenantID)),
+	).WHERE(
+		CalendarFeedToken.TokenHash.EQ(String(util.HashSecret(token))),
+	).LIMIT(1).QueryContext(ctx, f.db, &dbToken)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			http.NotFound(w, r)
+			return
+		}
+		f.serverError(w, fmt.Errorf("failed to query calendar feed token: %w", err))
+		return
+	}
+
+	_, err = CalendarFeedToken.UPDATE().
+		SET(CalendarFeedToken.LastUsedAt.SET(TimestampT(now))).
+		WHERE(CalendarFeedToken.ID.EQ(Int32(dbToken.CalendarFeedToken.ID))).
+		ExecContext(ctx, f.db)
+	if err != nil {
+		f.serverError(w, fmt.Errorf("failed to update calendar feed token: %w", err))
+		return
+	}
+
+	var dbScheduledCalls []struct {
+		model.ScheduledCall
+		model.Device
+	}
+	err = SELECT(
+		ScheduledCall.ScheduledCallID,
+		ScheduledCall.StartsAt,
+		ScheduledCall.DurationSeconds,
+		ScheduledCall.Status,
+		Device.Name,
+	).FROM(
+		ScheduledCall.INNER_JOIN(Device, Device.ID.EQ(ScheduledCall.DeviceID)),
+	).WHERE(
+		ScheduledCall.AssignedMemberID.EQ(String(dbToken.CalendarFeedToken.MemberID)).
+			AND(ScheduledCall.StartsAt.GT_EQ(TimestampT(now.Add(-feedHistory)))),
+	).ORDER_BY(ScheduledCall.StartsAt.ASC(), ScheduledCall.ID.ASC()).QueryContext(ctx, f.db, &dbScheduledCalls)
+	if err != nil && !errors.Is(err, qrm.ErrNoRows) {
+		f.serverError(w, fmt.Errorf("failed to query scheduled calls: %w", err))
+		return
+	}
+
+	events := make([]Event, len(dbScheduledCalls))
+	for i, dbScheduledCall := range dbScheduledCalls {
+		events[i] = Event{
+			UID:       dbScheduledCall.ScheduledCall.ScheduledCallID + "@homecall",
+			Summary:   "Samtal med " + dbScheduledCall.Device.Name,
+			Start:     dbScheduledCall.ScheduledCall.StartsAt,
+			End:       dbScheduledCall.ScheduledCall.StartsAt.Add(time.Duration(dbScheduledCall.ScheduledCall.DurationSeconds) * time.Second),
+			Cancelled: dbScheduledCall.ScheduledCall.Status == model.ScheduledCallStatus_Cancelled,
+		}
+		if dbScheduledCall.ScheduledCall.Status == model.ScheduledCallStatus_Missed {
+			events[i].Description = "Samtalet blev inte av"
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Cache-Control", "private, no-cache")
+	if r.Method == http.MethodHead {
+		return
+	}
+	err = Write(w, "Planerade samtal – "+dbToken.Tenant.Name, events, now)
+	if err != nil {
+		f.logger.Error("failed to write calendar feed", "error", err)
+	}
+}
+
+func (f *Feed) serverError(w http.ResponseWriter, err error) {
+	f.logger.Error("failed to serve calendar feed", "error", err)
+	http.Error(w, "internal server error", http.StatusInternalServerError)
+}
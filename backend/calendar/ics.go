@@ -0,0 +1,100 @@
+package calendar
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Event is a single event of an iCalendar feed.
+type Event struct {
+	// UID identifies the event across fetches of the feed.
+	UID         string
+	Summary     string
+	Description string
+	Start       time.Time
+	End         time.Time
+	Cancelled   bool
+}
+
+// maxLineLength is the length in octets after which lines are folded, see RFC 5545 section 3.1.
+const maxLineLength = 75
+
+// Write renders events as an iCalendar (RFC 5545) calendar.
+// All times are written in UTC, which calendar apps show in the time zone of the user.
+func Write(w io.Writer, name string, events []Event, now time.Time) error {
+	bw := bufio.NewWriter(w)
+	writeLine := func(name string, value string) {
+		writeFolded(bw, name+":"+value)
+	}
+
+	writeLine("BEGIN", "VCALENDAR")
+	writeLine("VERSION", "2.0")
+	writeLine("PRODID", "-//Sidus//Homecall//SV")
+	writeLine("CALSCALE", "GREGORIAN")
+	writeLine("METHOD", "PUBLISH")
+	writeLine("X-WR-CALNAME", escapeText(name))
+	for _, event := range events {
+		writeLine("BEGIN", "VEVENT")
+		writeLine("UID", escapeText(event.UID))
+		writeLine("DTSTAMP", formatTime(now))
+		writeLine("DTSTART", formatTime(event.Start))
+		writeLine("DTEND", formatTime(event.End))
+		writeLine("SUMMARY", escapeText(event.Summary))
+		if event.Description != "" {
+			writeLine("DESCRIPTION", escapeText(event.Description))
+		}
+		if event.Cancelled {
+			writeLine("STATUS", "CANCELLED")
+		} else {
+			writeLine("STATUS", "CONFIRMED")
+		}
+		writeLine("END", "VEVENT")
+	}
+	writeLine("END", "VCALENDAR")
+
+	err := bw.Flush()
+	if err != nil {
+		return fmt.Errorf("failed to write calendar: %w", err)
+	}
+	return nil
+}
+
+// formatTime formats a time as an iCalendar date-time in UTC.
+func formatTime(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
+
+// escapeText escapes a TEXT value, see RFC 5545 section 3.3.11.
+func escapeText(value string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	).Replace(value)
+}
+
+// writeFolded writes a content line terminated by CRLF, folding it so that no line is longer than
+// maxLineLength octets without splitting UTF-8 characters.
+func writeFolded(w *bufio.Writer, line string) {
+	limit := maxLineLength
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		_, _ = w.WriteString(line[:cut])
+		_, _ = w.WriteString("\r\n ")
+		line = line[cut:]
+		// Continuation lines start with a space
+		limit = maxLineLength - 1
+	}
+	_, _ = w.WriteString(line)
+	_, _ = w.WriteString("\r\n")
+}
@@ -0,0 +1,45 @@
+package calls
+
+import (
+	"connectrpc.com/connect"
+	"errors"
+	"fmt"
+	"sidus.io/home-call/gen/connect/homecall/v1alpha"
+	"sidus.io/home-call/gen/jetdb/public/model"
+	"time"
+)
+
+// MaxAutoAnswerDelay is the longest a device may ring before answering by itself.
+const MaxAutoAnswerDelay = 5 * time.Minute
+
+// ValidateAutoAnswerPolicy checks the delay and daily window of an auto-answer policy.
+func ValidateAutoAnswerPolicy(policy *homecallv1alpha.AutoAnswerPolicy) error {
+	if policy.GetDelaySeconds() < 0 || policy.GetDelaySeconds() > int64(MaxAutoAnswerDelay.Seconds()) {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("auto-answer delay must be between 0 and %d seconds", int64(MaxAutoAnswerDelay.Seconds())))
+	}
+	start, end := policy.GetStartMinute(), policy.GetEndMinute()
+	if start < 0 || start >= minutesPerDay || end < 0 || end >= minutesPerDay {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("auto-answer hours must be between 00:00 and 23:59"))
+	}
+	return nil
+}
+
+// AutoAnswerPolicyToProto returns the auto-answer policy of a device.
+func AutoAnswerPolicyToProto(device model.Device) *homecallv1alpha.AutoAnswerPolicy {
+	return &homecallv1alpha.AutoAnswerPolicy{
+		Enabled:            device.AutoAnswer,
+		DelaySeconds:       int64(device.AutoAnswerDelaySeconds),
+		AllowedCallersOnly: device.AutoAnswerAllowedCallersOnly,
+		StartMinute:        int32(device.AutoAnswerStart),
+		EndMinute:          int32(device.AutoAnswerEnd),
+	}
+}
+
+// AutoAnswerHours returns whether a time is within the daily window of an auto-answer policy,
+// on the wall clock of the device.
+func AutoAnswerHours(policy *homecallv1alpha.AutoAnswerPolicy, timeZone string, t time.Time) (bool, error) {
+	if policy.GetStartMinute() == policy.GetEndMinute() {
+		return true, nil
+	}
+	return inDailyWindow(policy.GetStartMinute(), policy.GetEndMinute(), timeZone, t)
+}
@@ -0,0 +1,114 @@
+package calls
+
+import (
+	. "github.com/go-jet/jet/v2/postgres"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sidus.io/home-call/gen/connect/homecall/v1alpha"
+	"sidus.io/home-call/gen/jetdb/public/model"
+	. "sidus.io/home-call/gen/jetdb/public/table"
+)
+
+// MissedCallRow is a missed call together with the public IDs of its call and device.
+type MissedCallRow struct {
+	model.MissedCall
+	model.Call
+	model.Device
+}
+
+// SelectMissedCalls selects the missed calls matching the condition into MissedCallRow values.
+func SelectMissedCalls(condition BoolExpression) SelectStatement {
+	return SELECT(
+		MissedCall.AllColumns,
+		Call.CallID,
+		Call.CreatedBy,
+		Device.DeviceID,
+	).FROM(
+		MissedCall.
+			INNER_JOIN(Device, Device.ID.EQ(MissedCall.DeviceID)).
+			INNER_JOIN(CallParticipant, CallParticipant.ID.EQ(MissedCall.ParticipantID)).
+			INNER_JOIN(Call, Call.ID.EQ(CallParticipant.CallID)),
+	).WHERE(condition)
+}
+
+// MissedCallToProto converts a missed call.
+func MissedCallToProto(row MissedCallRow) *homecallv1alpha.MissedCall {
+	missedCall := &homecallv1alpha.MissedCall{
+		Id:                row.MissedCall.MissedCallID,
+		CallId:            row.Call.CallID,
+		DeviceId:          row.Device.DeviceID,
+		CallerDisplayName: row.MissedCall.CallerDisplayName,
+		Reason:            missedCallReasonToProto(row.MissedCall.Reason),
+		CreatedAt:         timestamppb.New(row.MissedCall.CreatedAt),
+	}
+	if row.Call.CreatedBy != nil {
+		missedCall.CallerMemberId = *row.Call.CreatedBy
+	}
+	if row.MissedCall.DismissedAt != nil {
+		missedCall.DismissedAt = timestamppb.New(*row.MissedCall.DismissedAt)
+	}
+	return missedCall
+}
+
+func missedCallReasonToProto(reason model.MissedCallReason) homecallv1alpha.MissedCallReason {
+	switch reason {
+	case model.MissedCallReason_Timeout:
+		return homecallv1alpha.MissedCallReason_MISSED_CALL_REASON_TIMEOUT
+	case model.MissedCallReason_Declined:
+		return homecallv1alpha.MissedCallReason_MISSED_CALL_REASON_DECLINED
+	default:
+		return homecallv1alpha.MissedCallReason_MISSED_CALL_REASON_UNSPECIFIED
+	}
+}
+
+// CallbackRequestRow is a callback request together with the public IDs it refers to
+// and the missed call it was requested from, if any.
+type CallbackRequestRow struct {
+	model.CallbackRequest
+	model.Tenant
+	model.Device
+	MissedCall *model.MissedCall
+	Call       *model.Call
+}
+
+// SelectCallbackRequests selects the callback requests matching the condition into CallbackRequestRow values.
+func SelectCallbackRequests(condition BoolExpression) SelectStatement {
+	return SELECT(
+		CallbackRequest.AllColumns,
+		Tenant.TenantID,
+		Device.DeviceID,
+		MissedCall.AllColumns,
+		Call.CallID,
+		Call.CreatedBy,
+	).FROM(
+		CallbackRequest.
+			INNER_JOIN(Tenant, Tenant.ID.EQ(CallbackRequest.TenantID)).
+			INNER_JOIN(Device, Device.ID.EQ(CallbackRequest.DeviceID)).
+			LEFT_JOIN(MissedCall, MissedCall.ID.EQ(CallbackRequest.MissedCallID)).
+			LEFT_JOIN(CallParticipant, CallParticipant.ID.EQ(MissedCall.ParticipantID)).
+			LEFT_JOIN(Call, Call.ID.EQ(CallParticipant.CallID)),
+	).WHERE(condition)
+}
+
+// CallbackRequestToProto converts a callback request.
+func CallbackRequestToProto(row CallbackRequestRow) *homecallv1alpha.CallbackRequest {
+	callbackRequest := &homecallv1alpha.CallbackRequest{
+		Id:        row.CallbackRequest.CallbackRequestID,
+		TenantId:  row.Tenant.TenantID,
+		DeviceId:  row.Device.DeviceID,
+		CreatedAt: timestamppb.New(row.CallbackRequest.CreatedAt),
+	}
+	if row.MissedCall != nil && row.Call != nil {
+		callbackRequest.MissedCall = MissedCallToProto(MissedCallRow{
+			MissedCall: *row.MissedCall,
+			Call:       *row.Call,
+			Device:     row.Device,
+		})
+	}
+	if row.CallbackRequest.ResolvedAt != nil {
+		callbackRequest.ResolvedAt = timestamppb.New(*row.CallbackRequest.ResolvedAt)
+	}
+	if row.CallbackRequest.ResolvedBy != nil {
+		callbackRequest.ResolvedByMemberId = *row.CallbackRequest.ResolvedBy
+	}
+	return callbackRequest
+}
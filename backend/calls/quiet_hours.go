@@ -0,0 +1,61 @@
+package calls
+
+import (
+	"connectrpc.com/connect"
+	"errors"
+	"fmt"
+	"sidus.io/home-call/gen/connect/homecall/v1alpha"
+	"sidus.io/home-call/gen/jetdb/public/model"
+	"time"
+)
+
+const minutesPerDay = 24 * 60
+
+// ValidateQuietHours checks that the window of quiet hours is within a day and not empty.
+func ValidateQuietHours(quietHours *homecallv1alpha.QuietHours) error {
+	start, end := quietHours.GetStartMinute(), quietHours.GetEndMinute()
+	if start < 0 || start >= minutesPerDay || end < 0 || end >= minutesPerDay {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("quiet hours must be between 00:00 and 23:59"))
+	}
+	if start == end {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("quiet hours must not start and end at the same time"))
+	}
+	return nil
+}
+
+// QuietHoursToProto returns the quiet hours of a device, or nil if it has none.
+func QuietHoursToProto(device model.Device) *homecallv1alpha.QuietHours {
+	if device.QuietHoursStart == nil || device.QuietHoursEnd == nil {
+		return nil
+	}
+	return &homecallv1alpha.QuietHours{
+		StartMinute: int32(*device.QuietHoursStart),
+		EndMinute:   int32(*device.QuietHoursEnd),
+		TimeZone:    device.TimeZone,
+	}
+}
+
+// InQuietHours returns whether a time is within quiet hours, on the wall clock of their time zone.
+// Devices without quiet hours, i.e. nil, are never in them.
+func InQuietHours(quietHours *homecallv1alpha.QuietHours, t time.Time) (bool, error) {
+	if quietHours == nil {
+		return false, nil
+	}
+	return inDailyWindow(quietHours.GetStartMinute(), quietHours.GetEndMinute(), quietHours.GetTimeZone(), t)
+}
+
+// inDailyWindow returns whether a time is within a daily window of minutes after midnight in a time zone.
+// The window wraps around midnight if it ends before it starts.
+func inDailyWindow(start int32, end int32, timeZone string, t time.Time) (bool, error) {
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return false, fmt.Errorf("failed to load time zone: %w", err)
+	}
+	local := t.In(location)
+	minute := int32(local.Hour()*60 + local.Minute())
+	if start < end {
+		return minute >= start && minute < end, nil
+	}
+	// The window wraps around midnight
+	return minute >= start || minute < end, nil
+}
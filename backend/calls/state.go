@@ -0,0 +1,123 @@
+package calls
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	. "github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"sidus.io/home-call/gen/connect/homecall/v1alpha"
+	"sidus.io/home-call/gen/jetdb/public/enum"
+	"sidus.io/home-call/gen/jetdb/public/model"
+	. "sidus.io/home-call/gen/jetdb/public/table"
+	"sidus.io/home-call/util"
+	"time"
+)
+
+// MaxAge is how long after it was started a call can be joined.
+const MaxAge = time.Hour
+
+// Joinable matches calls that were started recently enough to be joined.
+func Joinable() BoolExpression {
+	return Call.CreatedAt.GT(CAST(NOW()).AS_TIMESTAMP().SUB(INTERVALd(MaxAge)))
+}
+
+// UpdateParticipantState sets the join state of the participant of a call matching the condition
+// and records it as a participant event.
+func UpdateParticipantState(ctx context.Context, db *sql.DB, callId string, participant BoolExpression, state homecallv1alpha.CallParticipantState) error {
+	callCondition := CallParticipant.CallID.IN(
+		SELECT(Call.ID).FROM(Call).WHERE(Call.CallID.EQ(String(callId)).AND(Joinable())),
+	)
+
+	var current model.CallParticipant
+	err := SELECT(CallParticipant.ID, CallParticipant.ParticipantID, CallParticipant.State).
+		FROM(CallParticipant).
+		WHERE(callCondition.AND(participant)).
+		LIMIT(1).QueryContext(ctx, db, &current)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return connect.NewError(connect.CodeNotFound, errors.New("call not found"))
+		}
+		return fmt.Errorf("failed to query call participant: %w", err)
+	}
+
+	now := TimestampT(time.Now().UTC())
+	var updateStmt UpdateStatement
+	var newState model.CallParticipantState
+	switch state {
+	case homecallv1alpha.CallParticipantState_CALL_PARTICIPANT_STATE_JOINED:
+		newState = model.CallParticipantState_Joined
+		updateStmt = CallParticipant.UPDATE().SET(
+			CallParticipant.State.SET(enum.CallParticipantState.Joined),
+			CallParticipant.JoinedAt.SET(TimestampExp(COALESCE(CallParticipant.JoinedAt, now))),
+		)
+	case homecallv1alpha.CallParticipantState_CALL_PARTICIPANT_STATE_LEFT:
+		if current.State != model.CallParticipantState_Joined {
+			return connect.NewError(connect.CodeFailedPrecondition, errors.New("not in the call"))
+		}
+		newState = model.CallParticipantState_Left
+		updateStmt = CallParticipant.UPDATE().SET(
+			CallParticipant.State.SET(enum.CallParticipantState.Left),
+			CallParticipant.LeftAt.SET(now),
+		)
+	case homecallv1alpha.CallParticipantState_CALL_PARTICIPANT_STATE_DECLINED:
+		if current.State != model.CallParticipantState_Invited && current.State != model.CallParticipantState_Declined {
+			return connect.NewError(connect.CodeFailedPrecondition, errors.New("can not decline a call after joining it"))
+		}
+		newState = model.CallParticipantState_Declined
+		updateStmt = CallParticipant.UPDATE().SET(
+			CallParticipant.State.SET(enum.CallParticipantState.Declined),
+		)
+	default:
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("state must be joined, left or declined"))
+	}
+
+	return util.WithTransaction(db, func(tx util.DB) error {
+		_, err := updateStmt.WHERE(CallParticipant.ID.EQ(Int32(current.ID))).ExecContext(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("failed to update call participant: %w", err)
+		}
+		return RecordParticipantEvent(ctx, tx, current.ParticipantID, newState, "")
+	})
+}
+
+// RecordParticipantEvent adds an event to the history of a call participant,
+// invitedBy is the member ID of the inviting member and only used for invitations.
+func RecordParticipantEvent(ctx context.Context, db util.DB, participantId string, state model.CallParticipantState, invitedBy string) error {
+	var invitedByExpression Expression = NULL
+	if invitedBy != "" {
+		invitedByExpression = String(invitedBy)
+	}
+
+	_, err := CallParticipantEvent.INSERT(
+		CallParticipantEvent.ParticipantID,
+		CallParticipantEvent.State,
+		CallParticipantEvent.InvitedBy,
+	).VALUES(
+		SELECT(CallParticipant.ID).FROM(CallParticipant).WHERE(CallParticipant.ParticipantID.EQ(String(participantId))),
+		NewEnumValue(state.String()),
+		invitedByExpression,
+	).ExecContext(ctx, db)
+	if err != nil {
+		return fmt.Errorf("failed to insert call participant event: %w", err)
+	}
+	return nil
+}
+
+// StateToProto converts the join state of a participant.
+func StateToProto(state model.CallParticipantState) homecallv1alpha.CallParticipantState {
+	switch state {
+	case model.CallParticipantState_Invited:
+		return homecallv1alpha.CallParticipantState_CALL_PARTICIPANT_STATE_INVITED
+	case model.CallParticipantState_Joined:
+		return homecallv1alpha.CallParticipantState_CALL_PARTICIPANT_STATE_JOINED
+	case model.CallParticipantState_Left:
+		return homecallv1alpha.CallParticipantState_CALL_PARTICIPANT_STATE_LEFT
+	case model.CallParticipantState_Declined:
+		return homecallv1alpha.CallParticipantState_CALL_PARTICIPANT_STATE_DECLINED
+	default:
+		return homecallv1alpha.CallParticipantState_CALL_PARTICIPANT_STATE_UNSPECIFIED
+	}
+}
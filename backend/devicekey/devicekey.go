@@ -0,0 +1,111 @@
+package devicekey
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"github.com/golang-jwt/jwt/v5"
+	"strings"
+)
+
+// Algorithm is the kind of key a device uses to sign its tokens.
+type Algorithm string
+
+const (
+	AlgorithmRSA       Algorithm = "rsa"
+	AlgorithmECDSAP256 Algorithm = "ecdsa_p256"
+	AlgorithmECDSAP384 Algorithm = "ecdsa_p384"
+	AlgorithmEd25519   Algorithm = "ed25519"
+)
+
+const (
+	// MinRSABits is the smallest RSA key size that is accepted.
+	MinRSABits = 2048
+	// MaxRSABits is the largest RSA key size that is accepted,
+	// larger keys make verifying every request needlessly expensive.
+	MaxRSABits = 8192
+)
+
+// Key is a validated device public key.
+type Key struct {
+	Algorithm Algorithm
+	PublicKey crypto.PublicKey
+}
+
+// Parse parses and validates a PEM encoded public key.
+// RSA keys can be encoded as PKCS #1 ("RSA PUBLIC KEY") or PKIX ("PUBLIC KEY"),
+// all other keys must be PKIX encoded.
+func Parse(pemData string) (*Key, error) {
+	if strings.TrimSpace(pemData) == "" {
+		return nil, errors.New("missing public key")
+	}
+	block, rest := pem.Decode([]byte(pemData))
+	if block == nil {
+		return nil, errors.New("no PEM encoded key found")
+	}
+	if len(bytes.TrimSpace(rest)) > 0 {
+		return nil, errors.New("unexpected data after the PEM encoded key")
+	}
+
+	var publicKey crypto.PublicKey
+	var err error
+	switch block.Type {
+	case "RSA PUBLIC KEY":
+		publicKey, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	case "PUBLIC KEY":
+		publicKey, err = x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type %q", block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse public key: %w", err)
+	}
+
+	switch publicKey := publicKey.(type) {
+	case *rsa.PublicKey:
+		if publicKey.N.BitLen() < MinRSABits || publicKey.N.BitLen() > MaxRSABits {
+			return nil, fmt.Errorf("rsa keys must be between %d and %d bits, got %d", MinRSABits, MaxRSABits, publicKey.N.BitLen())
+		}
+		return &Key{Algorithm: AlgorithmRSA, PublicKey: publicKey}, nil
+	case *ecdsa.PublicKey:
+		switch publicKey.Curve {
+		case elliptic.P256():
+			return &Key{Algorithm: AlgorithmECDSAP256, PublicKey: publicKey}, nil
+		case elliptic.P384():
+			return &Key{Algorithm: AlgorithmECDSAP384, PublicKey: publicKey}, nil
+		default:
+			return nil, fmt.Errorf("unsupported ecdsa curve %s", publicKey.Curve.Params().Name)
+		}
+	case ed25519.PublicKey:
+		return &Key{Algorithm: AlgorithmEd25519, PublicKey: publicKey}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", publicKey)
+	}
+}
+
+// SigningMethods returns the JWT algorithms that can be verified with the key.
+func (k *Key) SigningMethods() []string {
+	switch k.Algorithm {
+	case AlgorithmRSA:
+		return []string{
+			jwt.SigningMethodRS256.Alg(),
+			jwt.SigningMethodRS384.Alg(),
+			jwt.SigningMethodRS512.Alg(),
+		}
+	case AlgorithmECDSAP256:
+		return []string{jwt.SigningMethodES256.Alg()}
+	case AlgorithmECDSAP384:
+		return []string{jwt.SigningMethodES384.Alg()}
+	case AlgorithmEd25519:
+		return []string{jwt.SigningMethodEdDSA.Alg()}
+	default:
+		return nil
+	}
+}
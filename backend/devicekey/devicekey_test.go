@@ -0,0 +1,109 @@
+package devicekey
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"math/big"
+	"testing"
+)
+
+// rsaPublicKey returns an RSA public key with a modulus of the given size.
+// Only the public key is parsed, so a random modulus avoids generating large keys.
+func rsaPublicKey(t *testing.T, bits int) *rsa.PublicKey {
+	t.Helper()
+	n, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), uint(bits)))
+	require.NoError(t, err)
+	n.SetBit(n, bits-1, 1)
+	n.SetBit(n, 0, 1)
+	return &rsa.PublicKey{N: n, E: 65537}
+}
+
+func ecdsaPublicKey(t *testing.T, curve elliptic.Curve) *ecdsa.PublicKey {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(curve, rand.Reader)
+	require.NoError(t, err)
+	return &key.PublicKey
+}
+
+func ed25519PublicKey(t *testing.T) ed25519.PublicKey {
+	t.Helper()
+	publicKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	return publicKey
+}
+
+func pkcs1PEM(publicKey *rsa.PublicKey) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "RSA PUBLIC KEY", Bytes: x509.MarshalPKCS1PublicKey(publicKey)}))
+}
+
+func pkixPEM(t *testing.T, publicKey crypto.PublicKey) string {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name           string
+		pem            string
+		algorithm      Algorithm
+		signingMethods []string
+	}{
+		{"rsa 2048 pkcs1", pkcs1PEM(rsaPublicKey(t, 2048)), AlgorithmRSA, []string{"RS256", "RS384", "RS512"}},
+		{"rsa 2048 pkix", pkixPEM(t, rsaPublicKey(t, 2048)), AlgorithmRSA, []string{"RS256", "RS384", "RS512"}},
+		{"rsa 4096 pkcs1", pkcs1PEM(rsaPublicKey(t, 4096)), AlgorithmRSA, []string{"RS256", "RS384", "RS512"}},
+		{"rsa 4096 pkix", pkixPEM(t, rsaPublicKey(t, 4096)), AlgorithmRSA, []string{"RS256", "RS384", "RS512"}},
+		{"rsa 8192 pkcs1", pkcs1PEM(rsaPublicKey(t, 8192)), AlgorithmRSA, []string{"RS256", "RS384", "RS512"}},
+		{"rsa 8192 pkix", pkixPEM(t, rsaPublicKey(t, 8192)), AlgorithmRSA, []string{"RS256", "RS384", "RS512"}},
+		{"ecdsa p256", pkixPEM(t, ecdsaPublicKey(t, elliptic.P256())), AlgorithmECDSAP256, []string{"ES256"}},
+		{"ecdsa p384", pkixPEM(t, ecdsaPublicKey(t, elliptic.P384())), AlgorithmECDSAP384, []string{"ES384"}},
+		{"ed25519", pkixPEM(t, ed25519PublicKey(t)), AlgorithmEd25519, []string{"EdDSA"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := Parse(tt.pem)
+			require.NoError(t, err)
+			assert.Equal(t, tt.algorithm, key.Algorithm)
+			assert.Equal(t, tt.signingMethods, key.SigningMethods())
+		})
+	}
+}
+
+func TestParseRejects(t *testing.T) {
+	valid := pkixPEM(t, ecdsaPublicKey(t, elliptic.P256()))
+
+	tests := []struct {
+		name string
+		pem  string
+		err  string
+	}{
+		{"empty", "", "missing public key"},
+		{"whitespace", " \n", "missing public key"},
+		{"not pem", "not a key", "no PEM encoded key found"},
+		{"truncated pem", valid[:len(valid)/2], "no PEM encoded key found"},
+		{"invalid der", string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: []byte("not a key")})), "failed to parse public key"},
+		{"unsupported block type", string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("not a key")})), "unsupported PEM block type"},
+		{"trailing data", valid + "trailing", "unexpected data after the PEM encoded key"},
+		{"two keys", valid + valid, "unexpected data after the PEM encoded key"},
+		{"rsa 1024 pkcs1", pkcs1PEM(rsaPublicKey(t, 1024)), "rsa keys must be between 2048 and 8192 bits"},
+		{"rsa 1024 pkix", pkixPEM(t, rsaPublicKey(t, 1024)), "rsa keys must be between 2048 and 8192 bits"},
+		{"rsa 8200 pkix", pkixPEM(t, rsaPublicKey(t, 8200)), "rsa keys must be between 2048 and 8192 bits"},
+		{"ecdsa p224", pkixPEM(t, ecdsaPublicKey(t, elliptic.P224())), "unsupported ecdsa curve P-224"},
+		{"ecdsa p521", pkixPEM(t, ecdsaPublicKey(t, elliptic.P521())), "unsupported ecdsa curve P-521"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.pem)
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
@@ -0,0 +1,131 @@
+// Package devicemessage stores the text and photo messages sent to devices between calls.
+// The content of photos is kept in the blob store.
+package devicemessage
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"errors"
+	"fmt"
+	. "github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"io"
+	"net/http"
+	"sidus.io/home-call/blobstore"
+	"sidus.io/home-call/gen/connect/homecall/v1alpha"
+	"sidus.io/home-call/gen/jetdb/public/model"
+	. "sidus.io/home-call/gen/jetdb/public/table"
+	"sidus.io/home-call/util"
+	"slices"
+)
+
+const (
+	// MaxTextLength is the maximum number of characters in a text message.
+	MaxTextLength = 1000
+	// MaxPerDevice is how many messages are kept per device, older messages are removed.
+	MaxPerDevice = 20
+)
+
+// imageContentTypes are the types of images devices can show.
+var imageContentTypes = []string{"image/jpeg", "image/png", "image/gif", "image/webp"}
+
+// ImageContentType returns the content type of an image, or false if devices can not show it.
+func ImageContentType(image []byte) (string, bool) {
+	contentType := http.DetectContentType(image)
+	return contentType, slices.Contains(imageContentTypes, contentType)
+}
+
+// Row is a message together with the public ID of its device.
+type Row struct {
+	model.DeviceMessage
+	model.Device
+}
+
+// Select selects the messages matching the condition into Row values, newest first.
+func Select(condition BoolExpression) SelectStatement {
+	return SELECT(
+		DeviceMessage.AllColumns,
+		Device.DeviceID,
+	).FROM(
+		DeviceMessage.INNER_JOIN(Device, Device.ID.EQ(DeviceMessage.DeviceID)),
+	).WHERE(condition).ORDER_BY(DeviceMessage.CreatedAt.DESC(), DeviceMessage.ID.DESC())
+}
+
+// Prune removes the oldest messages of a device beyond MaxPerDevice.
+// It returns the blob keys of the removed photos, which should be deleted once the transaction commits.
+func Prune(ctx context.Context, db util.DB, deviceId int32) ([]string, error) {
+	var removed []model.DeviceMessage
+	err := DeviceMessage.DELETE().WHERE(
+		DeviceMessage.DeviceID.EQ(Int32(deviceId)).AND(DeviceMessage.ID.NOT_IN(
+			SELECT(DeviceMessage.ID).FROM(DeviceMessage).
+				WHERE(DeviceMessage.DeviceID.EQ(Int32(deviceId))).
+				ORDER_BY(DeviceMessage.ID.DESC()).
+				LIMIT(MaxPerDevice),
+		)),
+	).RETURNING(DeviceMessage.ImageBlobKey).QueryContext(ctx, db, &removed)
+	if err != nil && !errors.Is(err, qrm.ErrNoRows) {
+		return nil, fmt.Errorf("failed to remove old messages: %w", err)
+	}
+
+	var blobKeys []string
+	for _, message := range removed {
+		if message.ImageBlobKey != nil {
+			blobKeys = append(blobKeys, *message.ImageBlobKey)
+		}
+	}
+	return blobKeys, nil
+}
+
+// ReadImage returns the photo of a message together with its content.
+func ReadImage(ctx context.Context, store blobstore.Store, message model.DeviceMessage) (*homecallv1alpha.MessageImage, []byte, error) {
+	image := imageToProto(message)
+	if image == nil {
+		return nil, nil, connect.NewError(connect.CodeNotFound, errors.New("message has no image"))
+	}
+
+	content, err := store.Get(ctx, *message.ImageBlobKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get image content: %w", err)
+	}
+	defer content.Close()
+	data, err := io.ReadAll(content)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read image content: %w", err)
+	}
+	return image, data, nil
+}
+
+// ToProto converts a message.
+func ToProto(row Row) *homecallv1alpha.DeviceMessage {
+	message := &homecallv1alpha.DeviceMessage{
+		Id:                row.DeviceMessage.MessageID,
+		DeviceId:          row.Device.DeviceID,
+		SenderDisplayName: row.DeviceMessage.SenderDisplayName,
+		CreatedAt:         timestamppb.New(row.DeviceMessage.CreatedAt),
+	}
+	if row.DeviceMessage.SenderMemberID != nil {
+		message.SenderMemberId = *row.DeviceMessage.SenderMemberID
+	}
+	if row.DeviceMessage.Text != nil {
+		message.Content = &homecallv1alpha.DeviceMessage_Text{Text: *row.DeviceMessage.Text}
+	}
+	if image := imageToProto(row.DeviceMessage); image != nil {
+		message.Content = &homecallv1alpha.DeviceMessage_Image{Image: image}
+	}
+	if row.DeviceMessage.ReadAt != nil {
+		message.ReadAt = timestamppb.New(*row.DeviceMessage.ReadAt)
+	}
+	return message
+}
+
+// imageToProto converts the photo of a message, without its content, or returns nil if it has none.
+func imageToProto(message model.DeviceMessage) *homecallv1alpha.MessageImage {
+	if message.ImageBlobKey == nil || message.ImageContentType == nil || message.ImageSizeBytes == nil {
+		return nil
+	}
+	return &homecallv1alpha.MessageImage{
+		ContentType: *message.ImageContentType,
+		SizeBytes:   *message.ImageSizeBytes,
+	}
+}
@@ -0,0 +1,162 @@
+package enrollment
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/golang-jwt/jwt/v5"
+	"math/big"
+	"sidus.io/home-call/util"
+	"time"
+)
+
+const (
+	enrollmentKeyLength = 64
+	pairingCodeDigits   = 8
+	qrPayloadAudience   = "homecall-enrollment"
+)
+
+// Config holds the settings used when issuing enrollment credentials.
+type Config struct {
+	// PublicURL is the URL devices use to reach this instance, it is included in the QR payload.
+	PublicURL string
+	// SigningKey is used to sign the QR payloads.
+	SigningKey []byte
+	// KeyTTL is how long an enrollment key and its QR payload can be used.
+	KeyTTL time.Duration
+	// PairingCodeTTL is how long a pairing code can be used.
+	// Pairing codes are easy to guess compared to keys, so this should be short.
+	PairingCodeTTL time.Duration
+	// PairingCodeSecret keys the hashes of pairing codes.
+	// There are few enough pairing codes to reverse plain hashes of them, so they are hashed with an HMAC.
+	PairingCodeSecret []byte
+}
+
+// PairingLimits limits how many failed pairing code attempts are allowed within a window
+// to protect the short codes from brute-force attacks.
+type PairingLimits struct {
+	// MaxFailedAttemptsPerSource is the number of failed attempts allowed from a single source.
+	MaxFailedAttemptsPerSource int
+	// MaxFailedAttempts is the number of failed attempts from all sources together after which
+	// a source may only fail once, so that legitimate clients can still pair during a distributed attack.
+	MaxFailedAttempts int
+	// Window is the period the failed attempts are counted over.
+	Window time.Duration
+	// SourceHeader is the header trusted proxies put the client address in, e.g. X-Forwarded-For.
+	// Behind a load balancer every request comes from the balancer, so the header has to be used to tell clients apart.
+	// The address of the connection is used when it is empty.
+	SourceHeader string
+	// TrustedProxies is the number of proxies that append the address they received a request from to SourceHeader.
+	// Addresses before the ones they added are set by the client and can not be trusted.
+	TrustedProxies int
+}
+
+// Credentials are the secrets that allow a device to enroll.
+// Only the hashes are stored, the secrets themselves are shown once to the admin.
+type Credentials struct {
+	Key                  string
+	KeyHash              string
+	KeyExpiresAt         time.Time
+	PairingCode          string
+	PairingCodeHash      string
+	PairingCodeExpiresAt time.Time
+	QRPayload            string
+}
+
+type qrClaims struct {
+	jwt.RegisteredClaims
+	// EnrollmentKey is the enrollment key of the device.
+	EnrollmentKey string `json:"key"`
+}
+
+type Issuer struct {
+	cfg Config
+}
+
+func NewIssuer(cfg Config) *Issuer {
+	return &Issuer{
+		cfg: cfg,
+	}
+}
+
+// Issue creates new enrollment credentials for a device.
+func (i *Issuer) Issue(deviceId string) (Credentials, error) {
+	now := time.Now().UTC()
+
+	key, err := util.RandomString(enrollmentKeyLength)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("failed to generate enrollment key: %w", err)
+	}
+
+	pairingCode, err := randomDigits(pairingCodeDigits)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("failed to generate pairing code: %w", err)
+	}
+
+	keyExpiresAt := now.Add(i.cfg.KeyTTL)
+	qrPayload, err := jwt.NewWithClaims(jwt.SigningMethodHS256, qrClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    i.cfg.PublicURL,
+			Subject:   deviceId,
+			Audience:  jwt.ClaimStrings{qrPayloadAudience},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(keyExpiresAt),
+		},
+		EnrollmentKey: key,
+	}).SignedString(i.cfg.SigningKey)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("failed to sign qr payload: %w", err)
+	}
+
+	return Credentials{
+		Key:                  key,
+		KeyHash:              util.HashSecret(key),
+		KeyExpiresAt:         keyExpiresAt,
+		PairingCode:          pairingCode,
+		PairingCodeHash:      i.HashPairingCode(pairingCode),
+		PairingCodeExpiresAt: now.Add(i.cfg.PairingCodeTTL),
+		QRPayload:            qrPayload,
+	}, nil
+}
+
+// HashPairingCode returns the hex encoded HMAC-SHA256 of a pairing code, which is what is stored for it.
+func (i *Issuer) HashPairingCode(pairingCode string) string {
+	mac := hmac.New(sha256.New, i.cfg.PairingCodeSecret)
+	mac.Write([]byte(pairingCode))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// KeyFromQRPayload verifies a QR payload issued by this instance and returns the enrollment key in it.
+func (i *Issuer) KeyFromQRPayload(payload string) (string, error) {
+	claims := qrClaims{}
+	_, err := jwt.ParseWithClaims(
+		payload,
+		&claims,
+		func(token *jwt.Token) (interface{}, error) {
+			return i.cfg.SigningKey, nil
+		},
+		jwt.WithAudience(qrPayloadAudience),
+		jwt.WithIssuer(i.cfg.PublicURL),
+		jwt.WithExpirationRequired(),
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+	)
+	if err != nil {
+		return "", fmt.Errorf("invalid qr payload: %w", err)
+	}
+	if claims.EnrollmentKey == "" {
+		return "", errors.New("qr payload has no enrollment key")
+	}
+	return claims.EnrollmentKey, nil
+}
+
+func randomDigits(n int) (string, error) {
+	max := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+	value, err := rand.Int(rand.Reader, max)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%0*d", n, value), nil
+}
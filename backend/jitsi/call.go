@@ -7,16 +7,18 @@ type Call struct {
 	app      *App
 }
 
-func (c *Call) OfficeJWT(displayName string) (string, error) {
-	token, err := c.app.jitsiJWT(c.roomName, displayName, "office")
+// OfficeJWT creates a token for an office participant, participantID identifies the participant in the room.
+func (c *Call) OfficeJWT(participantID string, displayName string) (string, error) {
+	token, err := c.app.jitsiJWT(c.roomName, displayName, participantID)
 	if err != nil {
 		return "", fmt.Errorf("failed to create office JWT: %w", err)
 	}
 	return token, nil
 }
 
-func (c *Call) DeviceJWT(displayName string) (string, error) {
-	token, err := c.app.jitsiJWT(c.roomName, displayName, "device")
+// DeviceJWT creates a token for a device participant, participantID identifies the participant in the room.
+func (c *Call) DeviceJWT(participantID string, displayName string) (string, error) {
+	token, err := c.app.jitsiJWT(c.roomName, displayName, participantID)
 	if err != nil {
 		return "", fmt.Errorf("failed to create device JWT: %w", err)
 	}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/golang-jwt/jwt/v5"
 	"sidus.io/home-call/util"
+	"strings"
 	"time"
 )
 
@@ -39,6 +40,19 @@ func (s *App) NewCall() (*Call, error) {
 	}, nil
 }
 
+// ExistingCall returns the call of an existing room, roomID is the name returned by Call.RoomName.
+func (s *App) ExistingCall(roomID string) (*Call, error) {
+	roomName, ok := strings.CutPrefix(roomID, s.appId+"/")
+	if !ok || roomName == "" {
+		return nil, fmt.Errorf("room %q does not belong to the app", roomID)
+	}
+
+	return &Call{
+		app:      s,
+		roomName: roomName,
+	}, nil
+}
+
 func (s *App) jitsiJWT(
 	roomName,
 	userName,
@@ -11,8 +11,11 @@ import (
 )
 
 const (
-	callsTopic       = "homecall.calls"
-	enrollmentsTopic = "homecall.enrollments"
+	callsTopic          = "homecall.calls"
+	scheduledCallsTopic = "homecall.scheduled-calls"
+	enrollmentsTopic    = "homecall.enrollments"
+	deviceEventsTopic   = "homecall.device-events"
+	missedCallsTopic    = "homecall.missed-calls"
 )
 
 type pubSub interface {
@@ -35,25 +38,51 @@ func NewBroker(logger *slog.Logger) (*Broker, error) {
 	}
 	callBroadcaster.AddSubscription(callsTopic)
 
+	scheduledCallBroadcaster, err := gochannel.NewFanOut(baseChannel, wLogger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create scheduled call broadcaster: %w", err)
+	}
+	scheduledCallBroadcaster.AddSubscription(scheduledCallsTopic)
+
 	enrollmentBroadcaster, err := gochannel.NewFanOut(baseChannel, wLogger)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create enrollment broadcaster: %w", err)
 	}
 	enrollmentBroadcaster.AddSubscription(enrollmentsTopic)
 
+	deviceEventBroadcaster, err := gochannel.NewFanOut(baseChannel, wLogger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create device event broadcaster: %w", err)
+	}
+	deviceEventBroadcaster.AddSubscription(deviceEventsTopic)
+
+	missedCallBroadcaster, err := gochannel.NewFanOut(baseChannel, wLogger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create missed call broadcaster: %w", err)
+	}
+	missedCallBroadcaster.AddSubscription(missedCallsTopic)
+
 	return &Broker{
-		baseChannel:           baseChannel,
-		callBroadcaster:       callBroadcaster,
-		enrollmentBroadcaster: enrollmentBroadcaster,
-		started:               make(chan struct{}),
+		baseChannel:              baseChannel,
+		callBroadcaster:          callBroadcaster,
+		scheduledCallBroadcaster: scheduledCallBroadcaster,
+		enrollmentBroadcaster:    enrollmentBroadcaster,
+		deviceEventBroadcaster:   deviceEventBroadcaster,
+		missedCallBroadcaster:    missedCallBroadcaster,
+		logger:                   logger,
+		started:                  make(chan struct{}),
 	}, nil
 }
 
 type Broker struct {
-	baseChannel           pubSub
-	callBroadcaster       *gochannel.FanOut
-	enrollmentBroadcaster *gochannel.FanOut
-	started               chan struct{}
+	baseChannel              pubSub
+	callBroadcaster          *gochannel.FanOut
+	scheduledCallBroadcaster *gochannel.FanOut
+	enrollmentBroadcaster    *gochannel.FanOut
+	deviceEventBroadcaster   *gochannel.FanOut
+	missedCallBroadcaster    *gochannel.FanOut
+	logger                   *slog.Logger
+	started                  chan struct{}
 }
 
 func (b *Broker) Run(ctx context.Context) error {
@@ -67,6 +96,14 @@ func (b *Broker) Run(ctx context.Context) error {
 		return nil
 	})
 
+	eg.Go(func() error {
+		err := b.scheduledCallBroadcaster.Run(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to run scheduled call broadcaster: %w", err)
+		}
+		return nil
+	})
+
 	eg.Go(func() error {
 		err := b.enrollmentBroadcaster.Run(ctx)
 		if err != nil {
@@ -75,9 +112,28 @@ func (b *Broker) Run(ctx context.Context) error {
 		return nil
 	})
 
+	eg.Go(func() error {
+		err := b.deviceEventBroadcaster.Run(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to run device event broadcaster: %w", err)
+		}
+		return nil
+	})
+
+	eg.Go(func() error {
+		err := b.missedCallBroadcaster.Run(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to run missed call broadcaster: %w", err)
+		}
+		return nil
+	})
+
 	eg.Go(func() error {
 		<-b.callBroadcaster.Running()
+		<-b.scheduledCallBroadcaster.Running()
 		<-b.enrollmentBroadcaster.Running()
+		<-b.deviceEventBroadcaster.Running()
+		<-b.missedCallBroadcaster.Running()
 		close(b.started)
 		return nil
 	})
@@ -91,11 +147,26 @@ func (b *Broker) Run(ctx context.Context) error {
 
 func (b *Broker) Close() error {
 
-	err := b.enrollmentBroadcaster.Close()
+	err := b.missedCallBroadcaster.Close()
+	if err != nil {
+		return fmt.Errorf("failed to close missed-call-broadcaster: %w", err)
+	}
+
+	err = b.deviceEventBroadcaster.Close()
+	if err != nil {
+		return fmt.Errorf("failed to close device-event-broadcaster: %w", err)
+	}
+
+	err = b.enrollmentBroadcaster.Close()
 	if err != nil {
 		return fmt.Errorf("failed to close enrollment-broadcaster: %w", err)
 	}
 
+	err = b.scheduledCallBroadcaster.Close()
+	if err != nil {
+		return fmt.Errorf("failed to close scheduled-call-broadcaster: %w", err)
+	}
+
 	err = b.callBroadcaster.Close()
 	if err != nil {
 		return fmt.Errorf("failed to close call-broadcaster: %w", err)
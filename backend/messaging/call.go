@@ -1,8 +1,58 @@
 package messaging
 
-type Call struct {
-	DeviceID    string
-	ID          string
-	JitsiJwt    string
-	JitsiRoomId string
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/message"
+)
+
+// CallInvitation is published when a tenant member is invited to a call.
+type CallInvitation struct {
+	CallID   string
+	TenantID string
+	MemberID string
+}
+
+func (b *Broker) PublishCallInvitation(invitation CallInvitation) error {
+	payload, err := json.Marshal(invitation)
+	if err != nil {
+		return fmt.Errorf("failed to marshal call invitation: %w", err)
+	}
+	return b.baseChannel.Publish(callsTopic, message.NewMessage(watermill.NewULID(), payload))
+}
+
+// SubscribeToCallInvitations returns a channel with the call invitations for a member of a tenant.
+// The channel is closed when the context is done.
+func (b *Broker) SubscribeToCallInvitations(ctx context.Context, tenantID string, memberID string) (<-chan CallInvitation, error) {
+	messages, err := b.callBroadcaster.Subscribe(ctx, callsTopic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to subscribe to call invitations: %w", err)
+	}
+
+	invitations := make(chan CallInvitation)
+	go func() {
+		defer close(invitations)
+		for msg := range messages {
+			var invitation CallInvitation
+			err := json.Unmarshal(msg.Payload, &invitation)
+			msg.Ack()
+			if err != nil {
+				b.logger.Error("failed to unmarshal call invitation", "error", err)
+				continue
+			}
+
+			if invitation.TenantID != tenantID || invitation.MemberID != memberID {
+				continue
+			}
+
+			select {
+			case invitations <- invitation:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return invitations, nil
 }
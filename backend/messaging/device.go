@@ -0,0 +1,73 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/message"
+)
+
+type DeviceEventType string
+
+const (
+	DeviceEventAdded           DeviceEventType = "added"
+	DeviceEventRemoved         DeviceEventType = "removed"
+	DeviceEventRenamed         DeviceEventType = "renamed"
+	DeviceEventEnrolled        DeviceEventType = "enrolled"
+	DeviceEventEnrollmentReset DeviceEventType = "enrollment-reset"
+	DeviceEventHeartbeat       DeviceEventType = "heartbeat"
+	DeviceEventDisabled        DeviceEventType = "disabled"
+	DeviceEventEnabled         DeviceEventType = "enabled"
+	DeviceEventGroupChanged    DeviceEventType = "group-changed"
+)
+
+// DeviceEvent is published whenever something happens to a device that
+// should be reflected in the office.
+type DeviceEvent struct {
+	Type     DeviceEventType
+	TenantID string
+	DeviceID string
+}
+
+func (b *Broker) PublishDeviceEvent(event DeviceEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal device event: %w", err)
+	}
+	return b.baseChannel.Publish(deviceEventsTopic, message.NewMessage(watermill.NewULID(), payload))
+}
+
+// SubscribeToDeviceEvents returns a channel with all device events for the given tenant.
+// The channel is closed when the context is done.
+func (b *Broker) SubscribeToDeviceEvents(ctx context.Context, tenantID string) (<-chan DeviceEvent, error) {
+	messages, err := b.deviceEventBroadcaster.Subscribe(ctx, deviceEventsTopic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to subscribe to device events: %w", err)
+	}
+
+	events := make(chan DeviceEvent)
+	go func() {
+		defer close(events)
+		for msg := range messages {
+			var event DeviceEvent
+			err := json.Unmarshal(msg.Payload, &event)
+			msg.Ack()
+			if err != nil {
+				b.logger.Error("failed to unmarshal device event", "error", err)
+				continue
+			}
+
+			if event.TenantID != tenantID {
+				continue
+			}
+
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return events, nil
+}
@@ -0,0 +1,58 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/message"
+)
+
+// MissedCallEvent is published for the member that started a call when a device did not answer it.
+type MissedCallEvent struct {
+	MissedCallID string
+	TenantID     string
+	MemberID     string
+}
+
+func (b *Broker) PublishMissedCall(event MissedCallEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal missed call event: %w", err)
+	}
+	return b.baseChannel.Publish(missedCallsTopic, message.NewMessage(watermill.NewULID(), payload))
+}
+
+// SubscribeToMissedCalls returns a channel with the missed call events for a member of a tenant.
+// The channel is closed when the context is done.
+func (b *Broker) SubscribeToMissedCalls(ctx context.Context, tenantID string, memberID string) (<-chan MissedCallEvent, error) {
+	messages, err := b.missedCallBroadcaster.Subscribe(ctx, missedCallsTopic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to subscribe to missed call events: %w", err)
+	}
+
+	events := make(chan MissedCallEvent)
+	go func() {
+		defer close(events)
+		for msg := range messages {
+			var event MissedCallEvent
+			err := json.Unmarshal(msg.Payload, &event)
+			msg.Ack()
+			if err != nil {
+				b.logger.Error("failed to unmarshal missed call event", "error", err)
+				continue
+			}
+
+			if event.TenantID != tenantID || event.MemberID != memberID {
+				continue
+			}
+
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return events, nil
+}
@@ -0,0 +1,67 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/message"
+)
+
+type ScheduledCallEventType string
+
+const (
+	ScheduledCallEventReminder ScheduledCallEventType = "reminder"
+	ScheduledCallEventMissed   ScheduledCallEventType = "missed"
+)
+
+// ScheduledCallEvent is published for the member assigned to a scheduled call
+// when the call starts soon or its slot was missed.
+type ScheduledCallEvent struct {
+	Type            ScheduledCallEventType
+	ScheduledCallID string
+	TenantID        string
+	MemberID        string
+}
+
+func (b *Broker) PublishScheduledCallEvent(event ScheduledCallEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal scheduled call event: %w", err)
+	}
+	return b.baseChannel.Publish(scheduledCallsTopic, message.NewMessage(watermill.NewULID(), payload))
+}
+
+// SubscribeToScheduledCallEvents returns a channel with the scheduled call events for a member of a tenant.
+// The channel is closed when the context is done.
+func (b *Broker) SubscribeToScheduledCallEvents(ctx context.Context, tenantID string, memberID string) (<-chan ScheduledCallEvent, error) {
+	messages, err := b.scheduledCallBroadcaster.Subscribe(ctx, scheduledCallsTopic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to subscribe to scheduled call events: %w", err)
+	}
+
+	events := make(chan ScheduledCallEvent)
+	go func() {
+		defer close(events)
+		for msg := range messages {
+			var event ScheduledCallEvent
+			err := json.Unmarshal(msg.Payload, &event)
+			msg.Ack()
+			if err != nil {
+				b.logger.Error("failed to unmarshal scheduled call event", "error", err)
+				continue
+			}
+
+			if event.TenantID != tenantID || event.MemberID != memberID {
+				continue
+			}
+
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return events, nil
+}
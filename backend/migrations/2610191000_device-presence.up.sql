@@ -0,0 +1,11 @@
+CREATE TYPE device_app_state AS ENUM ('unknown', 'foreground', 'background');
+CREATE TYPE device_network_type AS ENUM ('unknown', 'none', 'wifi', 'cellular', 'ethernet');
+
+CREATE TABLE device_presence (
+    device_id integer references device(id) ON DELETE CASCADE PRIMARY KEY,
+    last_seen_at TIMESTAMP NOT NULL,
+    app_state device_app_state NOT NULL,
+    battery_level integer NULL,
+    battery_charging boolean NULL,
+    network_type device_network_type NOT NULL
+);
@@ -0,0 +1,8 @@
+CREATE TABLE device_diagnostics (
+    id SERIAL PRIMARY KEY,
+    device_id integer references device(id) ON DELETE CASCADE NOT NULL,
+    report pg_catalog.jsonb NOT NULL,
+    reported_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX device_diagnostics_device_id_reported_at_idx ON device_diagnostics (device_id, reported_at);
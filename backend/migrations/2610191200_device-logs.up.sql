@@ -0,0 +1,15 @@
+CREATE TYPE device_log_status AS ENUM ('requested', 'uploaded');
+
+CREATE TABLE device_log (
+    id SERIAL PRIMARY KEY,
+    log_id VARCHAR(255) NOT NULL UNIQUE,
+    device_id integer references device(id) ON DELETE CASCADE NOT NULL,
+    status device_log_status NOT NULL,
+    requested_at TIMESTAMP NOT NULL,
+    uploaded_at TIMESTAMP NULL,
+    content_encoding VARCHAR(255) NULL,
+    size_bytes bigint NULL,
+    content bytea NULL
+);
+
+CREATE INDEX device_log_device_id_idx ON device_log (device_id);
@@ -0,0 +1,13 @@
+-- Enrollment keys are stored hashed and expire
+ALTER TABLE enrollment ADD COLUMN key_hash VARCHAR(64) NULL;
+ALTER TABLE enrollment ADD COLUMN expires_at TIMESTAMP NULL;
+
+-- Existing keys get a fresh week before they expire
+UPDATE enrollment SET
+    key_hash = encode(sha256(key::bytea), 'hex'),
+    expires_at = (now() at time zone 'utc') + interval '7 days';
+
+ALTER TABLE enrollment ALTER COLUMN key_hash SET NOT NULL;
+ALTER TABLE enrollment ALTER COLUMN expires_at SET NOT NULL;
+ALTER TABLE enrollment ADD CONSTRAINT enrollment_key_hash_key UNIQUE (key_hash);
+ALTER TABLE enrollment DROP COLUMN key;
@@ -0,0 +1,14 @@
+-- Short pairing codes as an alternative to typing the enrollment key
+ALTER TABLE enrollment ADD COLUMN pairing_code_hash VARCHAR(64) NULL;
+ALTER TABLE enrollment ADD COLUMN pairing_code_expires_at TIMESTAMP NULL;
+
+CREATE UNIQUE INDEX enrollment_pairing_code_hash_idx ON enrollment (pairing_code_hash);
+
+-- Failed pairing code attempts, used to rate limit guessing
+CREATE TABLE pairing_code_attempt (
+    id SERIAL PRIMARY KEY,
+    source VARCHAR(255) NOT NULL,
+    attempted_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX pairing_code_attempt_attempted_at_idx ON pairing_code_attempt (attempted_at);
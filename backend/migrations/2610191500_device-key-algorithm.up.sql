@@ -0,0 +1,6 @@
+CREATE TYPE device_key_algorithm AS ENUM ('rsa', 'ecdsa_p256', 'ecdsa_p384', 'ed25519');
+
+ALTER TABLE device ADD COLUMN public_key_algorithm device_key_algorithm NULL;
+
+-- Only RSA keys were supported before
+UPDATE device SET public_key_algorithm = 'rsa' WHERE public_key IS NOT NULL;
@@ -0,0 +1,9 @@
+-- Token IDs (jti) of device tokens that have been used, kept until the tokens expire
+CREATE TABLE device_token_use (
+    device_id integer references device(id) ON DELETE CASCADE NOT NULL,
+    token_id VARCHAR(255) NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (device_id, token_id)
+);
+
+CREATE INDEX device_token_use_expires_at_idx ON device_token_use (expires_at);
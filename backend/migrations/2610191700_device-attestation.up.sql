@@ -0,0 +1,7 @@
+CREATE TYPE device_attestation_type AS ENUM ('play_integrity', 'app_attest');
+
+-- Tenants can require devices to prove they run the genuine app on a genuine device when enrolling
+ALTER TABLE tenant ADD COLUMN require_device_attestation BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE device ADD COLUMN attestation_type device_attestation_type NULL;
+ALTER TABLE device ADD COLUMN attested_at TIMESTAMP NULL;
@@ -0,0 +1,2 @@
+-- Disabled devices are kept with their history but can not authenticate or receive calls
+ALTER TABLE device ADD COLUMN disabled_at TIMESTAMP NULL;
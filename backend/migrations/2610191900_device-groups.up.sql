@@ -0,0 +1,20 @@
+-- Device groups structure the devices of a tenant, e.g. by household or ward
+CREATE TABLE device_group (
+  id SERIAL PRIMARY KEY,
+  group_id VARCHAR(255) NOT NULL UNIQUE,
+  tenant_id integer NOT NULL references tenant(id) ON DELETE CASCADE,
+  name VARCHAR(255) NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE device ADD COLUMN group_id integer NULL references device_group(id) ON DELETE SET NULL;
+
+-- Scoped members only have access to the devices in their groups.
+-- The flag is separate from the groups so that removing a group never widens the access of a member.
+ALTER TABLE user_tenant ADD COLUMN device_group_scoped BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE member_device_group (
+  member_id VARCHAR(255) NOT NULL references user_tenant(member_id) ON DELETE CASCADE,
+  device_group_id integer NOT NULL references device_group(id) ON DELETE CASCADE,
+  PRIMARY KEY (member_id, device_group_id)
+);
@@ -0,0 +1,44 @@
+-- A call can have several device and office participants sharing one jitsi room,
+-- each with its own token and join state
+CREATE TYPE call_participant_state AS ENUM ('invited', 'joined', 'left', 'declined');
+
+CREATE TABLE call (
+  id SERIAL PRIMARY KEY,
+  call_id VARCHAR(255) NOT NULL UNIQUE,
+  tenant_id integer NOT NULL references tenant(id) ON DELETE CASCADE,
+  jitsi_room_id VARCHAR(255) NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE call_participant (
+  id SERIAL PRIMARY KEY,
+  participant_id VARCHAR(255) NOT NULL UNIQUE,
+  call_id integer NOT NULL references call(id) ON DELETE CASCADE,
+  device_id integer NULL references device(id) ON DELETE CASCADE,
+  member_id VARCHAR(255) NULL references user_tenant(member_id) ON DELETE CASCADE,
+  display_name VARCHAR(255) NOT NULL,
+  jitsi_jwt TEXT NOT NULL,
+  state call_participant_state NOT NULL DEFAULT 'invited',
+  notified_at TIMESTAMP NULL,
+  joined_at TIMESTAMP NULL,
+  left_at TIMESTAMP NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+  CHECK ((device_id IS NULL) <> (member_id IS NULL)),
+  UNIQUE (call_id, device_id),
+  UNIQUE (call_id, member_id)
+);
+
+-- Move pending calls from the outbox
+INSERT INTO call (call_id, tenant_id, jitsi_room_id, created_at)
+SELECT device_call_outbox.call_id, device.tenant_id, device_call_outbox.jitsi_room_id, device_call_outbox.created_at
+FROM device_call_outbox
+JOIN device ON device.id = device_call_outbox.device_id
+WHERE device.tenant_id IS NOT NULL;
+
+INSERT INTO call_participant (participant_id, call_id, device_id, display_name, jitsi_jwt, notified_at, created_at)
+SELECT gen_random_uuid()::text, call.id, device.id, device.name, device_call_outbox.jitsi_jwt, device_call_outbox.created_at, device_call_outbox.created_at
+FROM device_call_outbox
+JOIN device ON device.id = device_call_outbox.device_id
+JOIN call ON call.call_id = device_call_outbox.call_id;
+
+DROP TABLE device_call_outbox;
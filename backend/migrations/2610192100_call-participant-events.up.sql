@@ -0,0 +1,13 @@
+-- History of the participants of a call, e.g. who invited whom while the call was ongoing
+CREATE TABLE call_participant_event (
+  id SERIAL PRIMARY KEY,
+  participant_id integer NOT NULL references call_participant(id) ON DELETE CASCADE,
+  state call_participant_state NOT NULL,
+  invited_by VARCHAR(255) NULL references user_tenant(member_id) ON DELETE SET NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX call_participant_event_participant_id_idx ON call_participant_event (participant_id);
+
+INSERT INTO call_participant_event (participant_id, state, created_at)
+SELECT id, state, COALESCE(joined_at, notified_at, created_at) FROM call_participant;
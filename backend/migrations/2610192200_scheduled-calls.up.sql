@@ -0,0 +1,20 @@
+CREATE TYPE scheduled_call_status AS ENUM ('scheduled', 'completed', 'missed', 'cancelled');
+
+-- Calls planned in advance, e.g. visits of care staff
+CREATE TABLE scheduled_call (
+  id SERIAL PRIMARY KEY,
+  scheduled_call_id VARCHAR(255) NOT NULL UNIQUE,
+  tenant_id integer NOT NULL references tenant(id) ON DELETE CASCADE,
+  device_id integer NOT NULL references device(id) ON DELETE CASCADE,
+  assigned_member_id VARCHAR(255) NULL references user_tenant(member_id) ON DELETE SET NULL,
+  created_by VARCHAR(255) NULL references user_tenant(member_id) ON DELETE SET NULL,
+  starts_at TIMESTAMP NOT NULL,
+  duration_seconds integer NOT NULL CHECK (duration_seconds > 0),
+  status scheduled_call_status NOT NULL DEFAULT 'scheduled',
+  -- Set by the scheduler once the reminders for the call are sent
+  reminded_at TIMESTAMP NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX scheduled_call_tenant_id_starts_at_idx ON scheduled_call (tenant_id, starts_at);
+CREATE INDEX scheduled_call_pending_idx ON scheduled_call (starts_at) WHERE status = 'scheduled';
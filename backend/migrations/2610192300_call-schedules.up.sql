@@ -0,0 +1,36 @@
+-- IANA time zone of the device, recurring calls follow its wall clock
+ALTER TABLE device ADD COLUMN time_zone VARCHAR(64) NOT NULL DEFAULT 'UTC';
+
+-- Recurring calls with a device, the scheduler adds their occurrences as scheduled calls
+CREATE TABLE call_schedule (
+  id SERIAL PRIMARY KEY,
+  schedule_id VARCHAR(255) NOT NULL UNIQUE,
+  tenant_id integer NOT NULL references tenant(id) ON DELETE CASCADE,
+  device_id integer NOT NULL references device(id) ON DELETE CASCADE,
+  assigned_member_id VARCHAR(255) NULL references user_tenant(member_id) ON DELETE SET NULL,
+  created_by VARCHAR(255) NULL references user_tenant(member_id) ON DELETE SET NULL,
+  -- iCalendar RRULE, e.g. FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR
+  rrule TEXT NOT NULL,
+  -- Wall clock time of the first occurrence in the time zone of the device
+  start_time TIMESTAMP NOT NULL,
+  duration_seconds integer NOT NULL CHECK (duration_seconds > 0),
+  -- Occurrences starting before this time have been added as scheduled calls
+  expanded_until TIMESTAMP NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Skipped occurrences of call schedules
+CREATE TABLE call_schedule_exception (
+  id SERIAL PRIMARY KEY,
+  schedule_id integer NOT NULL references call_schedule(id) ON DELETE CASCADE,
+  -- Wall clock start time of the skipped occurrence
+  occurrence_start TIMESTAMP NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+  UNIQUE (schedule_id, occurrence_start)
+);
+
+-- Past occurrences are kept when their schedule is removed
+ALTER TABLE scheduled_call
+  ADD COLUMN schedule_id integer NULL references call_schedule(id) ON DELETE SET NULL,
+  ADD COLUMN occurrence_start TIMESTAMP NULL,
+  ADD CONSTRAINT scheduled_call_occurrence_unique UNIQUE (schedule_id, occurrence_start);
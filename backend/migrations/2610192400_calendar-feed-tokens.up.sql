@@ -0,0 +1,11 @@
+-- Tokens for the calendar feeds of tenant members, the tokens are stored hashed
+CREATE TABLE calendar_feed_token (
+  id SERIAL PRIMARY KEY,
+  token_id VARCHAR(255) NOT NULL UNIQUE,
+  member_id VARCHAR(255) NOT NULL references user_tenant(member_id) ON DELETE CASCADE,
+  token_hash VARCHAR(255) NOT NULL UNIQUE,
+  created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+  last_used_at TIMESTAMP NULL
+);
+
+CREATE INDEX calendar_feed_token_member_id_idx ON calendar_feed_token (member_id);
@@ -0,0 +1,10 @@
+-- Daily quiet hours of a device in minutes after midnight in its time zone, the window wraps around midnight if it ends before it starts
+ALTER TABLE device ADD COLUMN quiet_hours_start SMALLINT NULL;
+ALTER TABLE device ADD COLUMN quiet_hours_end SMALLINT NULL;
+ALTER TABLE device ADD CONSTRAINT device_quiet_hours_check CHECK (
+  (quiet_hours_start IS NULL AND quiet_hours_end IS NULL) OR
+  (quiet_hours_start BETWEEN 0 AND 1439 AND quiet_hours_end BETWEEN 0 AND 1439 AND quiet_hours_start <> quiet_hours_end)
+);
+
+-- Whether the device was called during its quiet hours because the caller overrode them
+ALTER TABLE call_participant ADD COLUMN quiet_hours_overridden BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,31 @@
+-- Only admins and the allowed callers may call a restricted device.
+-- The flag is separate from the allowed callers so that removing a member or group never widens who may call the device.
+ALTER TABLE device ADD COLUMN callers_restricted BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Members allowed to call a device, either directly or through a device group they are assigned to
+CREATE TABLE allowed_caller (
+  id SERIAL PRIMARY KEY,
+  device_id integer NOT NULL references device(id) ON DELETE CASCADE,
+  member_id VARCHAR(255) NULL references user_tenant(member_id) ON DELETE CASCADE,
+  device_group_id integer NULL references device_group(id) ON DELETE CASCADE,
+  created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+  CHECK ((member_id IS NULL) <> (device_group_id IS NULL)),
+  UNIQUE (device_id, member_id),
+  UNIQUE (device_id, device_group_id)
+);
+
+CREATE TYPE allowed_caller_action AS ENUM ('restricted', 'unrestricted', 'added', 'removed');
+
+-- Audit log of the changes to who may call a device.
+-- Members and groups are referenced by their public IDs so the log outlives them.
+CREATE TABLE allowed_caller_event (
+  id SERIAL PRIMARY KEY,
+  device_id integer NOT NULL references device(id) ON DELETE CASCADE,
+  action allowed_caller_action NOT NULL,
+  member_id VARCHAR(255) NULL,
+  group_id VARCHAR(255) NULL,
+  changed_by VARCHAR(255) NULL references user_tenant(member_id) ON DELETE SET NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX allowed_caller_event_device_id_idx ON allowed_caller_event (device_id);
@@ -0,0 +1,18 @@
+-- Auto-answer is decided by the server for every call, based on the policy of the device
+ALTER TABLE device ADD COLUMN auto_answer BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE device ADD COLUMN auto_answer_delay_seconds INTEGER NOT NULL DEFAULT 0 CHECK (auto_answer_delay_seconds >= 0);
+-- Whether only the allowed callers of the device are answered automatically
+ALTER TABLE device ADD COLUMN auto_answer_allowed_callers_only BOOLEAN NOT NULL DEFAULT FALSE;
+-- Daily window in minutes after midnight in the time zone of the device, all day if start and end are equal
+ALTER TABLE device ADD COLUMN auto_answer_start SMALLINT NOT NULL DEFAULT 0 CHECK (auto_answer_start BETWEEN 0 AND 1439);
+ALTER TABLE device ADD COLUMN auto_answer_end SMALLINT NOT NULL DEFAULT 0 CHECK (auto_answer_end BETWEEN 0 AND 1439);
+
+-- Devices that have not enrolled yet keep the auto-answer settings they were created with
+UPDATE device SET
+  auto_answer = COALESCE((enrollment.device_settings->>'autoAnswer')::BOOLEAN, FALSE),
+  auto_answer_delay_seconds = COALESCE((enrollment.device_settings->>'autoAnswerDelaySeconds')::INTEGER, 0)
+FROM enrollment
+WHERE enrollment.id = device.id;
+
+-- The decision for a device in a call, set if the device answers by itself after the delay
+ALTER TABLE call_participant ADD COLUMN auto_answer_delay_seconds INTEGER NULL;
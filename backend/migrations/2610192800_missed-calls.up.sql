@@ -0,0 +1,44 @@
+-- The member that started a call
+ALTER TABLE call ADD COLUMN created_by VARCHAR(255) NULL references user_tenant(member_id) ON DELETE SET NULL;
+
+UPDATE call SET created_by = (
+  SELECT call_participant_event.invited_by
+  FROM call_participant_event
+  JOIN call_participant ON call_participant.id = call_participant_event.participant_id
+  WHERE call_participant.call_id = call.id AND call_participant_event.invited_by IS NOT NULL
+  ORDER BY call_participant_event.id
+  LIMIT 1
+);
+
+CREATE TYPE missed_call_reason AS ENUM ('timeout', 'declined');
+
+-- Calls a device did not answer, shown on the device until it dismisses them or requests a callback
+CREATE TABLE missed_call (
+  id SERIAL PRIMARY KEY,
+  missed_call_id VARCHAR(255) NOT NULL UNIQUE,
+  participant_id integer NOT NULL UNIQUE references call_participant(id) ON DELETE CASCADE,
+  device_id integer NOT NULL references device(id) ON DELETE CASCADE,
+  -- The name the caller had in the call
+  caller_display_name VARCHAR(255) NOT NULL,
+  reason missed_call_reason NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+  dismissed_at TIMESTAMP NULL
+);
+
+CREATE INDEX missed_call_device_id_idx ON missed_call (device_id);
+
+-- Requests from devices to be called back, handled by the members of the tenant
+CREATE TABLE callback_request (
+  id SERIAL PRIMARY KEY,
+  callback_request_id VARCHAR(255) NOT NULL UNIQUE,
+  tenant_id integer NOT NULL references tenant(id) ON DELETE CASCADE,
+  device_id integer NOT NULL references device(id) ON DELETE CASCADE,
+  missed_call_id integer NULL references missed_call(id) ON DELETE SET NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+  resolved_at TIMESTAMP NULL,
+  resolved_by VARCHAR(255) NULL references user_tenant(member_id) ON DELETE SET NULL
+);
+
+CREATE INDEX callback_request_tenant_id_idx ON callback_request (tenant_id, created_at);
+-- A device has at most one open callback request
+CREATE UNIQUE INDEX callback_request_open_idx ON callback_request (device_id) WHERE resolved_at IS NULL;
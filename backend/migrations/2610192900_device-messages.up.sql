@@ -0,0 +1,19 @@
+-- Text and photo messages sent to devices between calls
+CREATE TABLE device_message (
+  id SERIAL PRIMARY KEY,
+  message_id VARCHAR(255) NOT NULL UNIQUE,
+  device_id integer NOT NULL references device(id) ON DELETE CASCADE,
+  sender_member_id VARCHAR(255) NULL references user_tenant(member_id) ON DELETE SET NULL,
+  -- The name of the sender when the message was sent
+  sender_display_name VARCHAR(255) NOT NULL,
+  text TEXT NULL,
+  image BYTEA NULL,
+  image_content_type VARCHAR(255) NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+  -- When the device acknowledged the message
+  read_at TIMESTAMP NULL,
+  CONSTRAINT device_message_content CHECK ((text IS NULL) <> (image IS NULL)),
+  CONSTRAINT device_message_image_content_type CHECK ((image IS NULL) = (image_content_type IS NULL))
+);
+
+CREATE INDEX device_message_device_id_idx ON device_message (device_id, created_at);
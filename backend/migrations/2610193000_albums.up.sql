@@ -0,0 +1,29 @@
+-- Photo albums shown on idle devices, either on a single device or on every device in a group
+CREATE TABLE album (
+  id SERIAL PRIMARY KEY,
+  album_id VARCHAR(255) NOT NULL UNIQUE,
+  tenant_id integer NOT NULL references tenant(id) ON DELETE CASCADE,
+  name VARCHAR(255) NOT NULL,
+  device_id integer NULL references device(id) ON DELETE CASCADE,
+  device_group_id integer NULL references device_group(id) ON DELETE CASCADE,
+  created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+  CONSTRAINT album_target CHECK ((device_id IS NULL) <> (device_group_id IS NULL))
+);
+
+CREATE INDEX album_tenant_id_idx ON album (tenant_id);
+
+-- The images of an album, their content is kept in the blob store
+CREATE TABLE album_image (
+  id SERIAL PRIMARY KEY,
+  image_id VARCHAR(255) NOT NULL UNIQUE,
+  album_id integer NOT NULL references album(id) ON DELETE CASCADE,
+  blob_key VARCHAR(255) NOT NULL,
+  content_type VARCHAR(255) NOT NULL,
+  size_bytes BIGINT NOT NULL,
+  -- The hex encoded SHA-256 of the content
+  etag VARCHAR(255) NOT NULL,
+  uploaded_by VARCHAR(255) NULL references user_tenant(member_id) ON DELETE SET NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX album_image_album_id_idx ON album_image (album_id, created_at);
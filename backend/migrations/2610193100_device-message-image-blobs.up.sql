@@ -0,0 +1,17 @@
+-- Message photos are kept in the blob store instead of the database
+ALTER TABLE device_message
+  ADD COLUMN image_blob_key VARCHAR(255) NULL,
+  ADD COLUMN image_size_bytes BIGINT NULL;
+
+-- Photos stored in the database can not be moved to the blob store by a migration
+DELETE FROM device_message WHERE image IS NOT NULL;
+
+ALTER TABLE device_message
+  DROP CONSTRAINT device_message_content,
+  DROP CONSTRAINT device_message_image_content_type,
+  DROP COLUMN image,
+  ADD CONSTRAINT device_message_content CHECK ((text IS NULL) <> (image_blob_key IS NULL)),
+  ADD CONSTRAINT device_message_image CHECK (
+    (image_blob_key IS NULL) = (image_content_type IS NULL) AND
+    (image_blob_key IS NULL) = (image_size_bytes IS NULL)
+  );
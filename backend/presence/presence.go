@@ -0,0 +1,72 @@
+package presence
+
+import "time"
+
+// AppState is the state of the app on a device as reported in its heartbeats.
+type AppState int
+
+const (
+	AppStateUnknown AppState = iota
+	AppStateForeground
+	AppStateBackground
+)
+
+// Config holds the thresholds used to decide whether a device is online.
+type Config struct {
+	// ForegroundTimeout is how long a device with the app in the foreground
+	// is considered online after its last heartbeat.
+	ForegroundTimeout time.Duration
+	// BackgroundTimeout is how long a device with the app in the background
+	// is considered online after its last heartbeat.
+	// Background apps are throttled by the OS, so this is usually much longer.
+	BackgroundTimeout time.Duration
+}
+
+type Model struct {
+	cfg Config
+}
+
+func NewModel(cfg Config) *Model {
+	return &Model{
+		cfg: cfg,
+	}
+}
+
+// Online returns whether a device that was last seen at lastSeen in the given state is online at now.
+func (m *Model) Online(lastSeen time.Time, state AppState, now time.Time) bool {
+	if lastSeen.IsZero() {
+		return false
+	}
+	return now.Sub(lastSeen) <= m.timeout(state)
+}
+
+// notificationTokenTimeout is how long a device that has never sent a heartbeat
+// is considered online after it last updated its notification token.
+const notificationTokenTimeout = time.Hour
+
+// OnlineWithoutHeartbeats returns whether a device that has never sent a heartbeat is online at now.
+// Apps from before heartbeats only update their notification token, so a recent update is taken
+// as a sign of life to keep those devices callable until they are updated.
+func (m *Model) OnlineWithoutHeartbeats(notificationTokenUpdatedAt time.Time, now time.Time) bool {
+	if notificationTokenUpdatedAt.IsZero() {
+		return false
+	}
+	return now.Sub(notificationTokenUpdatedAt) <= notificationTokenTimeout
+}
+
+// NextHeartbeat returns how long a device in the given state may wait before sending its next heartbeat.
+// Half of the timeout is used to leave room for network delays and retries.
+func (m *Model) NextHeartbeat(state AppState) time.Duration {
+	return m.timeout(state) / 2
+}
+
+func (m *Model) timeout(state AppState) time.Duration {
+	switch state {
+	case AppStateForeground:
+		return m.cfg.ForegroundTimeout
+	default:
+		// An unknown state is treated as background, as that is the most forgiving assumption
+		// that still requires the device to be heard from regularly.
+		return m.cfg.BackgroundTimeout
+	}
+}
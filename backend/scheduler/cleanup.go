@@ -0,0 +1,49 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	. "github.com/go-jet/jet/v2/postgres"
+	. "sidus.io/home-call/gen/jetdb/public/table"
+	"sidus.io/home-call/util"
+	"time"
+)
+
+// clearExpiredPairingCodes forgets the hashes of expired pairing codes,
+// so the codes can be issued to other devices again.
+func (s *Scheduler) clearExpiredPairingCodes(ctx context.Context, db util.DB, now time.Time) error {
+	_, err := Enrollment.UPDATE().
+		SET(Enrollment.PairingCodeHash.SET(StringExp(NULL))).
+		WHERE(
+			Enrollment.PairingCodeHash.IS_NOT_NULL().
+				AND(Enrollment.PairingCodeExpiresAt.LT_EQ(TimestampT(now))),
+		).
+		ExecContext(ctx, db)
+	if err != nil {
+		return fmt.Errorf("failed to clear expired pairing codes: %w", err)
+	}
+	return nil
+}
+
+// deleteExpiredDiagnostics deletes the diagnostics reports of all devices that are older than the retention.
+func (s *Scheduler) deleteExpiredDiagnostics(ctx context.Context, db util.DB, now time.Time) error {
+	_, err := DeviceDiagnostics.DELETE().
+		WHERE(DeviceDiagnostics.ReportedAt.LT(TimestampT(now.Add(-s.cfg.DiagnosticsRetention)))).
+		ExecContext(ctx, db)
+	if err != nil {
+		return fmt.Errorf("failed to delete expired diagnostics: %w", err)
+	}
+	return nil
+}
+
+// deleteExpiredDeviceLogs deletes the logs of all devices that were requested longer ago than the retention,
+// including requests the device never uploaded a log for.
+func (s *Scheduler) deleteExpiredDeviceLogs(ctx context.Context, db util.DB, now time.Time) error {
+	_, err := DeviceLog.DELETE().
+		WHERE(DeviceLog.RequestedAt.LT(TimestampT(now.Add(-s.cfg.DeviceLogRetention)))).
+		ExecContext(ctx, db)
+	if err != nil {
+		return fmt.Errorf("failed to delete expired device logs: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,203 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	fm "firebase.google.com/go/v4/messaging"
+	"fmt"
+	. "github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"sidus.io/home-call/calls"
+	"sidus.io/home-call/gen/jetdb/public/enum"
+	"sidus.io/home-call/gen/jetdb/public/model"
+	. "sidus.io/home-call/gen/jetdb/public/table"
+	"sidus.io/home-call/messaging"
+	"sidus.io/home-call/util"
+	"time"
+)
+
+type unansweredParticipant struct {
+	model.CallParticipant
+	model.Call
+	model.Tenant
+	model.Device
+	model.DeviceNotificationToken
+}
+
+// detectMissedCalls records the device participants that declined a call, or did not join it within the
+// missed call timeout, as missed calls. Devices that answer calls automatically are given their
+// auto-answer delay on top of the timeout. The device is notified so it can show the missed call,
+// and so is the member that started the call.
+func (s *Scheduler) detectMissedCalls(ctx context.Context, db util.DB, now time.Time) error {
+	var unanswered []unansweredParticipant
+	err := SELECT(
+		CallParticipant.ID,
+		CallParticipant.CallID,
+		CallParticipant.DeviceID,
+		CallParticipant.State,
+		CallParticipant.NotifiedAt,
+		CallParticipant.AutoAnswerDelaySeconds,
+		Call.CallID,
+		Call.CreatedBy,
+		Tenant.TenantID,
+		Device.DeviceID,
+		Device.DisabledAt,
+		DeviceNotificationToken.NotificationToken,
+	).FROM(
+		CallParticipant.
+			INNER_JOIN(Call, Call.ID.EQ(CallParticipant.CallID)).
+			INNER_JOIN(Tenant, Tenant.ID.EQ(Call.TenantID)).
+			INNER_JOIN(Device, Device.ID.EQ(CallParticipant.DeviceID)).
+			LEFT_JOIN(DeviceNotificationToken, DeviceNotificationToken.DeviceID.EQ(Device.ID)),
+	).WHERE(
+		calls.Joinable().
+			AND(CallParticipant.State.EQ(enum.CallParticipantState.Declined).
+				OR(CallParticipant.State.EQ(enum.CallParticipantState.Invited).
+					AND(CallParticipant.NotifiedAt.LT(TimestampT(now.Add(-s.cfg.MissedCallTimeout)))))).
+			AND(NOT(EXISTS(
+				SELECT(MissedCall.ID).FROM(MissedCall).WHERE(MissedCall.ParticipantID.EQ(CallParticipant.ID)),
+			))),
+	).ORDER_BY(CallParticipant.ID.ASC()).QueryContext(ctx, db, &unanswered)
+	if err != nil && !errors.Is(err, qrm.ErrNoRows) {
+		return fmt.Errorf("failed to query unanswered call participants: %w", err)
+	}
+
+	var errs []error
+	for _, participant := range unanswered {
+		if participant.CallParticipant.State == model.CallParticipantState_Invited &&
+			now.Before(s.missedCallDeadline(participant.CallParticipant)) {
+			continue
+		}
+
+		callerName, err := callerDisplayName(ctx, db, participant.Call)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		reason := enum.MissedCallReason.Timeout
+		if participant.CallParticipant.State == model.CallParticipantState_Declined {
+			reason = enum.MissedCallReason.Declined
+		}
+		missedCallId := uuid.New().String()
+		result, err := MissedCall.INSERT(
+			MissedCall.MissedCallID,
+			MissedCall.ParticipantID,
+			MissedCall.DeviceID,
+			MissedCall.CallerDisplayName,
+			MissedCall.Reason,
+			MissedCall.CreatedAt,
+		).VALUES(
+			String(missedCallId),
+			Int32(participant.CallParticipant.ID),
+			Int32(participant.Device.ID),
+			String(callerName),
+			reason,
+			TimestampT(now),
+		).ON_CONFLICT(MissedCall.ParticipantID).DO_NOTHING().ExecContext(ctx, db)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to insert missed call: %w", err))
+			continue
+		}
+		rows, err := result.RowsAffected()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to get affected rows: %w", err))
+			continue
+		}
+		if rows == 0 {
+			continue
+		}
+
+		s.logger.Info("call missed",
+			"missed_call_id", missedCallId,
+			"call_id", participant.Call.CallID,
+			"device_id", participant.Device.DeviceID)
+
+		err = s.sendMissedCallNotification(ctx, participant, missedCallId, callerName)
+		if err != nil {
+			// The missed call is still listed when the device asks for it
+			s.logger.Warn("failed to send missed call notification to device",
+				"missed_call_id", missedCallId,
+				"device_id", participant.Device.DeviceID,
+				"error", err)
+		}
+
+		if participant.Call.CreatedBy == nil {
+			continue
+		}
+		err = s.broker.PublishMissedCall(messaging.MissedCallEvent{
+			MissedCallID: missedCallId,
+			TenantID:     participant.Tenant.TenantID,
+			MemberID:     *participant.Call.CreatedBy,
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to publish missed call: %w", err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// missedCallDeadline returns when an invited participant that has not joined the call has missed it.
+func (s *Scheduler) missedCallDeadline(participant model.CallParticipant) time.Time {
+	deadline := participant.NotifiedAt.Add(s.cfg.MissedCallTimeout)
+	if participant.AutoAnswerDelaySeconds != nil {
+		deadline = deadline.Add(time.Duration(*participant.AutoAnswerDelaySeconds) * time.Second)
+	}
+	return deadline
+}
+
+// callerDisplayName returns the name the member that started a call had in it.
+func callerDisplayName(ctx context.Context, db util.DB, call model.Call) (string, error) {
+	if call.CreatedBy == nil {
+		return "", nil
+	}
+
+	var caller []model.CallParticipant
+	err := SELECT(CallParticipant.DisplayName).FROM(CallParticipant).WHERE(
+		CallParticipant.CallID.EQ(Int32(call.ID)).
+			AND(CallParticipant.MemberID.EQ(String(*call.CreatedBy))),
+	).LIMIT(1).QueryContext(ctx, db, &caller)
+	if err != nil && !errors.Is(err, qrm.ErrNoRows) {
+		return "", fmt.Errorf("failed to query caller: %w", err)
+	}
+	if len(caller) == 0 {
+		return "", nil
+	}
+	return caller[0].DisplayName, nil
+}
+
+func (s *Scheduler) sendMissedCallNotification(ctx context.Context, participant unansweredParticipant, missedCallId string, callerName string) error {
+	if participant.Device.DisabledAt != nil {
+		return errors.New("device is disabled")
+	}
+	if participant.DeviceNotificationToken.NotificationToken == "" {
+		return errors.New("device has no notification token")
+	}
+
+	body := "Du har ett missat samtal"
+	if callerName != "" {
+		body = fmt.Sprintf("Du har ett missat samtal från %s", callerName)
+	}
+
+	err := s.notificationService.SendNotification(ctx, &fm.Message{
+		Token: participant.DeviceNotificationToken.NotificationToken,
+		Data: map[string]string{
+			"missedCallId": missedCallId,
+			"callId":       participant.Call.CallID,
+			"callerName":   callerName,
+			"type":         "missedCall",
+		},
+		Notification: &fm.Notification{
+			Title: "Missat samtal",
+			Body:  body,
+		},
+		Android: &fm.AndroidConfig{
+			Priority: "high",
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send notification: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,339 @@
+package scheduler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	fm "firebase.google.com/go/v4/messaging"
+	"fmt"
+	. "github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"log/slog"
+	"math"
+	"sidus.io/home-call/gen/jetdb/public/enum"
+	"sidus.io/home-call/gen/jetdb/public/model"
+	. "sidus.io/home-call/gen/jetdb/public/table"
+	"sidus.io/home-call/messaging"
+	"sidus.io/home-call/notifications"
+	"sidus.io/home-call/schedules"
+	"sidus.io/home-call/util"
+	"time"
+)
+
+// lockKey identifies the Postgres advisory lock held while scheduled calls are processed,
+// so that only one replica processes them at a time.
+const lockKey int64 = 0x686f6d6563616c6c // "homecall"
+
+// Config holds the timing of the scheduler.
+type Config struct {
+	// Interval is how often scheduled calls are processed.
+	Interval time.Duration
+	// ReminderLead is how long before a scheduled call starts the device and the assigned member are reminded.
+	ReminderLead time.Duration
+	// MissedCallTimeout is how long a device has to join a call before it is missed.
+	MissedCallTimeout time.Duration
+	// DiagnosticsRetention is how long diagnostics reports from devices are kept.
+	DiagnosticsRetention time.Duration
+	// DeviceLogRetention is how long requested device logs are kept, whether they were uploaded or not.
+	DeviceLogRetention time.Duration
+}
+
+// Scheduler adds the occurrences of call schedules as scheduled calls, sends reminders for scheduled calls,
+// closes their slots once they have ended and detects calls that devices did not answer.
+// It also cleans up expired pairing codes, diagnostics and device logs.
+type Scheduler struct {
+	db                  *sql.DB
+	broker              *messaging.Broker
+	notificationService notifications.Service
+	cfg                 Config
+	logger              *slog.Logger
+}
+
+func New(db *sql.DB, broker *messaging.Broker, notificationService notifications.Service, cfg Config, logger *slog.Logger) *Scheduler {
+	return &Scheduler{
+		db:                  db,
+		broker:              broker,
+		notificationService: notificationService,
+		cfg:                 cfg,
+		logger:              logger,
+	}
+}
+
+// Run processes scheduled calls every interval until the context is done.
+func (s *Scheduler) Run(ctx context.Context) error {
+	ticker := time.NewTicker(s.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		err := s.process(ctx)
+		if err != nil && ctx.Err() == nil {
+			s.logger.Error("failed to process scheduled calls", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// process expands call schedules, sends due reminders, closes ended slots, detects missed calls
+// and cleans up expired data, unless another replica is already doing so.
+func (s *Scheduler) process(ctx context.Context) error {
+	// Session level advisory locks belong to a connection, so hold on to one
+	conn, err := s.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get database connection: %w", err)
+	}
+	defer func() {
+		err := conn.Close()
+		if err != nil {
+			s.logger.Error("failed to close database connection", "error", err)
+		}
+	}()
+
+	var locked bool
+	err = conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", lockKey).Scan(&locked)
+	if err != nil {
+		return fmt.Errorf("failed to acquire scheduler lock: %w", err)
+	}
+	if !locked {
+		s.logger.Debug("scheduled calls are processed by another replica")
+		return nil
+	}
+	defer func() {
+		// Unlock even if the context is done, the connection goes back to the pool
+		_, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", lockKey)
+		if err != nil {
+			s.logger.Error("failed to release scheduler lock", "error", err)
+		}
+	}()
+
+	now := time.Now().UTC()
+	return errors.Join(
+		s.expandSchedules(ctx, conn, now),
+		s.sendReminders(ctx, conn, now),
+		s.closeEndedSlots(ctx, conn, now),
+		s.detectMissedCalls(ctx, conn, now),
+		s.clearExpiredPairingCodes(ctx, conn, now),
+		s.deleteExpiredDiagnostics(ctx, conn, now),
+		s.deleteExpiredDeviceLogs(ctx, conn, now),
+	)
+}
+
+// expandSchedules expands every call schedule in a transaction of its own, a schedule that fails
+// to expand is logged and tried again next time without holding back the others.
+func (s *Scheduler) expandSchedules(ctx context.Context, db util.DB, now time.Time) error {
+	dueSchedules, err := schedules.DueForExpansion(ctx, db, now)
+	if err != nil {
+		return err
+	}
+
+	for _, schedule := range dueSchedules {
+		err := util.WithTransaction(s.db, func(tx util.DB) error {
+			return schedules.Expand(ctx, tx, CallSchedule.ID.EQ(Int32(schedule.ID)), now)
+		})
+		if err != nil {
+			s.logger.Error("failed to expand call schedule", "schedule_id", schedule.ScheduleID, "error", err)
+		}
+	}
+	return nil
+}
+
+type dueScheduledCall struct {
+	model.ScheduledCall
+	model.Tenant
+	model.Device
+	model.DeviceNotificationToken
+}
+
+func (s *Scheduler) sendReminders(ctx context.Context, db util.DB, now time.Time) error {
+	var due []dueScheduledCall
+	err := s.queryScheduledCalls(ctx, db, ScheduledCall.RemindedAt.IS_NULL().
+		AND(ScheduledCall.StartsAt.LT_EQ(TimestampT(now.Add(s.cfg.ReminderLead)))), &due)
+	if err != nil {
+		return fmt.Errorf("failed to query scheduled calls to remind: %w", err)
+	}
+
+	var errs []error
+	for _, scheduledCall := range due {
+		if slotEnd(scheduledCall.ScheduledCall).Before(now) {
+			// Too late for a reminder, the slot is closed instead
+			continue
+		}
+
+		claimed, err := claim(ctx, db, ScheduledCall.UPDATE(ScheduledCall.RemindedAt).
+			SET(TimestampT(now)).
+			WHERE(pending(scheduledCall.ScheduledCall.ID).AND(ScheduledCall.RemindedAt.IS_NULL())))
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+
+		err = s.sendDeviceReminder(ctx, scheduledCall, now)
+		if err != nil {
+			// The member is still reminded, they can call the device anyway
+			s.logger.Warn("failed to send scheduled call reminder to device",
+				"scheduled_call_id", scheduledCall.ScheduledCall.ScheduledCallID,
+				"device_id", scheduledCall.Device.DeviceID,
+				"error", err)
+		}
+
+		errs = append(errs, s.publish(scheduledCall, messaging.ScheduledCallEventReminder))
+	}
+	return errors.Join(errs...)
+}
+
+// closeEndedSlots marks scheduled calls whose slot has ended as completed if the device joined a call during it,
+// or as missed otherwise.
+func (s *Scheduler) closeEndedSlots(ctx context.Context, db util.DB, now time.Time) error {
+	var started []dueScheduledCall
+	err := s.queryScheduledCalls(ctx, db, ScheduledCall.StartsAt.LT_EQ(TimestampT(now)), &started)
+	if err != nil {
+		return fmt.Errorf("failed to query started scheduled calls: %w", err)
+	}
+
+	var errs []error
+	for _, scheduledCall := range started {
+		end := slotEnd(scheduledCall.ScheduledCall)
+		if end.After(now) {
+			continue
+		}
+
+		// Calls started a little early count as well
+		var joined []model.CallParticipant
+		err := SELECT(CallParticipant.ID).FROM(CallParticipant).WHERE(
+			CallParticipant.DeviceID.EQ(Int32(scheduledCall.ScheduledCall.DeviceID)).
+				AND(CallParticipant.JoinedAt.BETWEEN(
+					TimestampT(scheduledCall.ScheduledCall.StartsAt.Add(-s.cfg.ReminderLead)),
+					TimestampT(end),
+				)),
+		).LIMIT(1).QueryContext(ctx, db, &joined)
+		if err != nil && !errors.Is(err, qrm.ErrNoRows) {
+			errs = append(errs, fmt.Errorf("failed to query call participants: %w", err))
+			continue
+		}
+
+		status := enum.ScheduledCallStatus.Missed
+		if len(joined) > 0 {
+			status = enum.ScheduledCallStatus.Completed
+		}
+		claimed, err := claim(ctx, db, ScheduledCall.UPDATE(ScheduledCall.Status).
+			SET(status).
+			WHERE(pending(scheduledCall.ScheduledCall.ID)))
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if !claimed || len(joined) > 0 {
+			continue
+		}
+
+		s.logger.Info("scheduled call missed",
+			"scheduled_call_id", scheduledCall.ScheduledCall.ScheduledCallID,
+			"device_id", scheduledCall.Device.DeviceID)
+		errs = append(errs, s.publish(scheduledCall, messaging.ScheduledCallEventMissed))
+	}
+	return errors.Join(errs...)
+}
+
+// queryScheduledCalls returns the scheduled calls that have not taken place yet matching the condition.
+func (s *Scheduler) queryScheduledCalls(ctx context.Context, db util.DB, condition BoolExpression, dest *[]dueScheduledCall) error {
+	err := SELECT(
+		ScheduledCall.AllColumns,
+		Tenant.TenantID,
+		Device.DeviceID,
+		Device.DisabledAt,
+		DeviceNotificationToken.NotificationToken,
+	).FROM(
+		ScheduledCall.
+			INNER_JOIN(Tenant, Tenant.ID.EQ(ScheduledCall.TenantID)).
+			INNER_JOIN(Device, Device.ID.EQ(ScheduledCall.DeviceID)).
+			LEFT_JOIN(DeviceNotificationToken, DeviceNotificationToken.DeviceID.EQ(Device.ID)),
+	).WHERE(
+		ScheduledCall.Status.EQ(enum.ScheduledCallStatus.Scheduled).AND(condition),
+	).ORDER_BY(ScheduledCall.StartsAt.ASC()).QueryContext(ctx, db, dest)
+	if err != nil && !errors.Is(err, qrm.ErrNoRows) {
+		return err
+	}
+	return nil
+}
+
+// pending matches a scheduled call that has not taken place yet.
+func pending(id int32) BoolExpression {
+	return ScheduledCall.ID.EQ(Int32(id)).AND(ScheduledCall.Status.EQ(enum.ScheduledCallStatus.Scheduled))
+}
+
+// claim executes an update and returns whether it changed a row,
+// making sure every step of a scheduled call is only taken once.
+func claim(ctx context.Context, db util.DB, update UpdateStatement) (bool, error) {
+	result, err := update.ExecContext(ctx, db)
+	if err != nil {
+		return false, fmt.Errorf("failed to update scheduled call: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	return rows > 0, nil
+}
+
+func (s *Scheduler) sendDeviceReminder(ctx context.Context, scheduledCall dueScheduledCall, now time.Time) error {
+	if scheduledCall.Device.DisabledAt != nil {
+		return errors.New("device is disabled")
+	}
+	if scheduledCall.DeviceNotificationToken.NotificationToken == "" {
+		return errors.New("device has no notification token")
+	}
+
+	minutes := int(math.Ceil(scheduledCall.ScheduledCall.StartsAt.Sub(now).Minutes()))
+	body := "Du har ett planerat samtal nu"
+	if minutes > 0 {
+		body = fmt.Sprintf("Du har ett planerat samtal om %d minuter", minutes)
+	}
+
+	err := s.notificationService.SendNotification(ctx, &fm.Message{
+		Token: scheduledCall.DeviceNotificationToken.NotificationToken,
+		Data: map[string]string{
+			"scheduledCallId": scheduledCall.ScheduledCall.ScheduledCallID,
+			"startsAt":        scheduledCall.ScheduledCall.StartsAt.Format(time.RFC3339),
+			"type":            "scheduledCallReminder",
+		},
+		Notification: &fm.Notification{
+			Title: "Planerat samtal",
+			Body:  body,
+		},
+		Android: &fm.AndroidConfig{
+			Priority: "high",
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send notification: %w", err)
+	}
+	return nil
+}
+
+func (s *Scheduler) publish(scheduledCall dueScheduledCall, eventType messaging.ScheduledCallEventType) error {
+	if scheduledCall.ScheduledCall.AssignedMemberID == nil {
+		return nil
+	}
+	err := s.broker.PublishScheduledCallEvent(messaging.ScheduledCallEvent{
+		Type:            eventType,
+		ScheduledCallID: scheduledCall.ScheduledCall.ScheduledCallID,
+		TenantID:        scheduledCall.Tenant.TenantID,
+		MemberID:        *scheduledCall.ScheduledCall.AssignedMemberID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to publish scheduled call event: %w", err)
+	}
+	return nil
+}
+
+// slotEnd returns when the slot of a scheduled call ends.
+func slotEnd(scheduledCall model.ScheduledCall) time.Time {
+	return scheduledCall.StartsAt.Add(time.Duration(scheduledCall.DurationSeconds) * time.Second)
+}
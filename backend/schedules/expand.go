@@ -0,0 +1,171 @@
+package schedules
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	. "github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"sidus.io/home-call/gen/jetdb/public/enum"
+	"sidus.io/home-call/gen/jetdb/public/model"
+	. "sidus.io/home-call/gen/jetdb/public/table"
+	"sidus.io/home-call/util"
+	"time"
+)
+
+// Horizon is how far ahead occurrences of call schedules are added as scheduled calls.
+const Horizon = 14 * 24 * time.Hour
+
+// expandInterval is how often the occurrences of a call schedule are extended to the horizon.
+const expandInterval = 24 * time.Hour
+
+// Expand adds the upcoming occurrences of the call schedules matching the condition as scheduled calls,
+// unless they were expanded recently. Occurrences that already exist are left as they are.
+// The schedules are locked, so db should be a transaction to keep concurrent changes from interleaving.
+func Expand(ctx context.Context, db util.DB, condition BoolExpression, now time.Time) error {
+	var dbSchedules []struct {
+		model.CallSchedule
+		model.Device
+	}
+	err := SELECT(
+		CallSchedule.AllColumns,
+		Device.TimeZone,
+	).FROM(
+		CallSchedule.INNER_JOIN(Device, Device.ID.EQ(CallSchedule.DeviceID)),
+	).WHERE(
+		condition.AND(needsExpansion(now)),
+	).FOR(UPDATE().OF(CallSchedule)).QueryContext(ctx, db, &dbSchedules)
+	if err != nil && !errors.Is(err, qrm.ErrNoRows) {
+		return fmt.Errorf("failed to query call schedules: %w", err)
+	}
+
+	var errs []error
+	for _, dbSchedule := range dbSchedules {
+		err := expandSchedule(ctx, db, dbSchedule.CallSchedule, dbSchedule.Device.TimeZone, now)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to expand call schedule %s: %w", dbSchedule.CallSchedule.ScheduleID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// DueForExpansion returns the call schedules that have not been expanded recently, without locking them,
+// so that they can be expanded one at a time.
+func DueForExpansion(ctx context.Context, db util.DB, now time.Time) ([]model.CallSchedule, error) {
+	var dbSchedules []model.CallSchedule
+	err := SELECT(CallSchedule.ID, CallSchedule.ScheduleID).
+		FROM(CallSchedule).
+		WHERE(needsExpansion(now)).
+		ORDER_BY(CallSchedule.ID.ASC()).
+		QueryContext(ctx, db, &dbSchedules)
+	if err != nil && !errors.Is(err, qrm.ErrNoRows) {
+		return nil, fmt.Errorf("failed to query call schedules: %w", err)
+	}
+	return dbSchedules, nil
+}
+
+func needsExpansion(now time.Time) BoolExpression {
+	return CallSchedule.ExpandedUntil.IS_NULL().
+		OR(CallSchedule.ExpandedUntil.LT(TimestampT(now.Add(Horizon - expandInterval))))
+}
+
+// Reset removes the upcoming occurrences of the call schedules matching the condition that have not been
+// reminded yet, so that they are expanded again, e.g. after the schedule or the time zone of its device changed.
+// Cancelled occurrences are kept and stay skipped.
+func Reset(ctx context.Context, db util.DB, condition BoolExpression, now time.Time) error {
+	schedules := SELECT(CallSchedule.ID).FROM(CallSchedule).WHERE(condition)
+
+	_, err := ScheduledCall.DELETE().WHERE(
+		ScheduledCall.ScheduleID.IN(schedules).
+			AND(ScheduledCall.Status.EQ(enum.ScheduledCallStatus.Scheduled)).
+			AND(ScheduledCall.RemindedAt.IS_NULL()).
+			AND(ScheduledCall.StartsAt.GT(TimestampT(now))),
+	).ExecContext(ctx, db)
+	if err != nil {
+		return fmt.Errorf("failed to delete upcoming occurrences: %w", err)
+	}
+
+	_, err = CallSchedule.UPDATE().
+		SET(CallSchedule.ExpandedUntil.SET(TimestampExp(NULL))).
+		WHERE(CallSchedule.ID.IN(schedules)).
+		ExecContext(ctx, db)
+	if err != nil {
+		return fmt.Errorf("failed to reset call schedules: %w", err)
+	}
+	return nil
+}
+
+// LoadRecurrence returns the recurrence of a call schedule in the time zone of its device.
+func LoadRecurrence(ctx context.Context, db util.DB, schedule model.CallSchedule, timeZone string) (*Recurrence, error) {
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load time zone: %w", err)
+	}
+
+	var exceptions []model.CallScheduleException
+	err = SELECT(CallScheduleException.OccurrenceStart).
+		FROM(CallScheduleException).
+		WHERE(CallScheduleException.ScheduleID.EQ(Int32(schedule.ID))).
+		QueryContext(ctx, db, &exceptions)
+	if err != nil && !errors.Is(err, qrm.ErrNoRows) {
+		return nil, fmt.Errorf("failed to query call schedule exceptions: %w", err)
+	}
+	exceptionStarts := make([]time.Time, len(exceptions))
+	for i, exception := range exceptions {
+		exceptionStarts[i] = exception.OccurrenceStart
+	}
+
+	return NewRecurrence(schedule.Rrule, schedule.StartTime, location, exceptionStarts)
+}
+
+func expandSchedule(ctx context.Context, db util.DB, schedule model.CallSchedule, timeZone string, now time.Time) error {
+	recurrence, err := LoadRecurrence(ctx, db, schedule, timeZone)
+	if err != nil {
+		return err
+	}
+
+	until := now.Add(Horizon)
+	occurrences := recurrence.Between(now, until)
+	if len(occurrences) > 0 {
+		insertStmt := ScheduledCall.INSERT(
+			ScheduledCall.ScheduledCallID,
+			ScheduledCall.TenantID,
+			ScheduledCall.DeviceID,
+			ScheduledCall.AssignedMemberID,
+			ScheduledCall.CreatedBy,
+			ScheduledCall.StartsAt,
+			ScheduledCall.DurationSeconds,
+			ScheduledCall.ScheduleID,
+			ScheduledCall.OccurrenceStart,
+		)
+		for _, occurrence := range occurrences {
+			insertStmt = insertStmt.VALUES(
+				uuid.New().String(),
+				schedule.TenantID,
+				schedule.DeviceID,
+				schedule.AssignedMemberID,
+				schedule.CreatedBy,
+				occurrence.StartsAt,
+				schedule.DurationSeconds,
+				schedule.ID,
+				occurrence.Start,
+			)
+		}
+		_, err = insertStmt.
+			ON_CONFLICT(ScheduledCall.ScheduleID, ScheduledCall.OccurrenceStart).DO_NOTHING().
+			ExecContext(ctx, db)
+		if err != nil {
+			return fmt.Errorf("failed to insert occurrences: %w", err)
+		}
+	}
+
+	_, err = CallSchedule.UPDATE().
+		SET(CallSchedule.ExpandedUntil.SET(TimestampT(until))).
+		WHERE(CallSchedule.ID.EQ(Int32(schedule.ID))).
+		ExecContext(ctx, db)
+	if err != nil {
+		return fmt.Errorf("failed to update call schedule: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package schedules
+
+import (
+	"errors"
+	"fmt"
+	"github.com/teambition/rrule-go"
+	"sort"
+	"strings"
+	"time"
+	// Devices can be in any time zone, do not depend on the zone database of the host
+	_ "time/tzdata"
+)
+
+// Recurrence is a recurring call expressed as an iCalendar RRULE in the time zone of a device.
+//
+// Occurrences are expanded on the wall clock of the device, so a call every day at 10:00
+// stays at 10:00 when daylight saving time starts or ends.
+// Wall clock times are represented as times in UTC with the same date and clock,
+// which is also how they are stored in the database.
+type Recurrence struct {
+	rule       *rrule.RRule
+	location   *time.Location
+	exceptions map[time.Time]bool
+}
+
+// Occurrence is a single call of a recurrence.
+type Occurrence struct {
+	// Start is the wall clock time the occurrence starts at, it identifies the occurrence within its recurrence.
+	Start time.Time
+	// StartsAt is the instant the occurrence starts at, in UTC.
+	StartsAt time.Time
+}
+
+// NewRecurrence parses an RRULE, e.g. "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR".
+// start is the wall clock time of the first occurrence, location is the time zone of the device
+// and exceptions are the wall clock start times of skipped occurrences.
+func NewRecurrence(rule string, start time.Time, location *time.Location, exceptions []time.Time) (*Recurrence, error) {
+	options, err := ParseRule(rule)
+	if err != nil {
+		return nil, err
+	}
+	options.Dtstart = WallClock(start)
+
+	r, err := rrule.NewRRule(*options)
+	if err != nil {
+		return nil, fmt.Errorf("invalid recurrence rule: %w", err)
+	}
+
+	recurrence := &Recurrence{
+		rule:       r,
+		location:   location,
+		exceptions: make(map[time.Time]bool, len(exceptions)),
+	}
+	for _, exception := range exceptions {
+		recurrence.exceptions[WallClock(exception)] = true
+	}
+	return recurrence, nil
+}
+
+// ParseRule parses and validates an RRULE.
+// Only daily or less frequent rules are allowed, UNTIL is interpreted as a wall clock time.
+func ParseRule(rule string) (*rrule.ROption, error) {
+	rule = strings.TrimPrefix(strings.TrimSpace(rule), "RRULE:")
+	if rule == "" || strings.ContainsAny(rule, "\r\n") {
+		return nil, errors.New("a single recurrence rule is required")
+	}
+
+	options, err := rrule.StrToROptionInLocation(rule, time.UTC)
+	if err != nil {
+		return nil, fmt.Errorf("invalid recurrence rule: %w", err)
+	}
+	switch options.Freq {
+	case rrule.DAILY, rrule.WEEKLY, rrule.MONTHLY, rrule.YEARLY:
+	default:
+		return nil, errors.New("recurrence rules can repeat at most daily")
+	}
+	if !options.Until.IsZero() {
+		options.Until = WallClock(options.Until)
+	}
+	return options, nil
+}
+
+// Between returns the occurrences starting at or after from and before to, ordered by start.
+func (r *Recurrence) Between(from time.Time, to time.Time) []Occurrence {
+	// Wall clocks and instants differ by at most a day, the result is filtered on the instants below
+	wallFrom := WallClock(from.In(r.location)).Add(-24 * time.Hour)
+	wallTo := WallClock(to.In(r.location)).Add(24 * time.Hour)
+
+	var occurrences []Occurrence
+	for _, start := range r.rule.Between(wallFrom, wallTo, true) {
+		if r.exceptions[start] {
+			continue
+		}
+		startsAt := Instant(start, r.location).UTC()
+		if startsAt.Before(from) || !startsAt.Before(to) {
+			continue
+		}
+		occurrences = append(occurrences, Occurrence{
+			Start:    start,
+			StartsAt: startsAt,
+		})
+	}
+	sort.Slice(occurrences, func(i, j int) bool {
+		return occurrences[i].StartsAt.Before(occurrences[j].StartsAt)
+	})
+	return occurrences
+}
+
+// Instant returns when a wall clock time happens in a time zone.
+//
+// Wall clock times skipped when daylight saving time starts are moved forward by the length of the gap,
+// e.g. 02:30 becomes 03:30, as recommended by RFC 5545.
+// Wall clock times that happen twice when daylight saving time ends use the first of the two instants.
+func Instant(wallClock time.Time, location *time.Location) time.Time {
+	t := time.Date(wallClock.Year(), wallClock.Month(), wallClock.Day(), wallClock.Hour(), wallClock.Minute(), wallClock.Second(), 0, location)
+	_, offset := t.Zone()
+	_, offsetBefore := t.Add(-24 * time.Hour).Zone()
+
+	if WallClock(t).Equal(wallClock) {
+		// time.Date does not say which instant it picks when the clock is ambiguous
+		if offsetBefore > offset {
+			earlier := t.Add(-time.Duration(offsetBefore-offset) * time.Second)
+			if WallClock(earlier).Equal(wallClock) {
+				return earlier
+			}
+		}
+		return t
+	}
+
+	// The wall clock does not exist, use the offset from before the gap
+	return wallClock.Add(-time.Duration(offsetBefore) * time.Second)
+}
+
+// WallClock returns the date and clock of t as a time in UTC.
+func WallClock(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, time.UTC)
+}
+
+// InLocation returns the wall clock time of an instant in a time zone.
+func InLocation(t time.Time, location *time.Location) time.Time {
+	return WallClock(t.In(location))
+}
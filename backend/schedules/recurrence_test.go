@@ -0,0 +1,141 @@
+package schedules
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func stockholm(t *testing.T) *time.Location {
+	t.Helper()
+	location, err := time.LoadLocation("Europe/Stockholm")
+	require.NoError(t, err)
+	return location
+}
+
+func wallClock(year int, month time.Month, day, hour, minute int) time.Time {
+	return time.Date(year, month, day, hour, minute, 0, 0, time.UTC)
+}
+
+func TestRecurrenceWeekdays(t *testing.T) {
+	location := stockholm(t)
+	// Monday the 19th of October 2026
+	recurrence, err := NewRecurrence("FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR", wallClock(2026, 10, 19, 10, 0), location, nil)
+	require.NoError(t, err)
+
+	occurrences := recurrence.Between(time.Date(2026, 10, 19, 0, 0, 0, 0, location), time.Date(2026, 10, 26, 0, 0, 0, 0, location))
+	require.Len(t, occurrences, 5)
+	for i, occurrence := range occurrences {
+		local := occurrence.StartsAt.In(location)
+		assert.Equal(t, time.Weekday(i+1), local.Weekday())
+		assert.Equal(t, 10, local.Hour())
+		assert.Equal(t, wallClock(2026, 10, 19+i, 10, 0), occurrence.Start)
+	}
+}
+
+func TestRecurrenceDaylightSavingTime(t *testing.T) {
+	location := stockholm(t)
+
+	t.Run("starts", func(t *testing.T) {
+		// Clocks go from 02:00 to 03:00 on the 29th of March 2026
+		recurrence, err := NewRecurrence("FREQ=DAILY", wallClock(2026, 3, 27, 10, 0), location, nil)
+		require.NoError(t, err)
+
+		occurrences := recurrence.Between(time.Date(2026, 3, 27, 0, 0, 0, 0, time.UTC), time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC))
+		require.Len(t, occurrences, 5)
+		for _, occurrence := range occurrences {
+			assert.Equal(t, 10, occurrence.StartsAt.In(location).Hour())
+		}
+		assert.Equal(t, time.Date(2026, 3, 28, 9, 0, 0, 0, time.UTC), occurrences[1].StartsAt)
+		assert.Equal(t, time.Date(2026, 3, 29, 8, 0, 0, 0, time.UTC), occurrences[2].StartsAt)
+	})
+
+	t.Run("ends", func(t *testing.T) {
+		// Clocks go from 03:00 back to 02:00 on the 25th of October 2026
+		recurrence, err := NewRecurrence("FREQ=DAILY", wallClock(2026, 10, 23, 10, 0), location, nil)
+		require.NoError(t, err)
+
+		occurrences := recurrence.Between(time.Date(2026, 10, 23, 0, 0, 0, 0, time.UTC), time.Date(2026, 10, 28, 0, 0, 0, 0, time.UTC))
+		require.Len(t, occurrences, 5)
+		for _, occurrence := range occurrences {
+			assert.Equal(t, 10, occurrence.StartsAt.In(location).Hour())
+		}
+		assert.Equal(t, time.Date(2026, 10, 24, 8, 0, 0, 0, time.UTC), occurrences[1].StartsAt)
+		assert.Equal(t, time.Date(2026, 10, 25, 9, 0, 0, 0, time.UTC), occurrences[2].StartsAt)
+	})
+
+	t.Run("skipped wall clock", func(t *testing.T) {
+		recurrence, err := NewRecurrence("FREQ=DAILY", wallClock(2026, 3, 28, 2, 30), location, nil)
+		require.NoError(t, err)
+
+		occurrences := recurrence.Between(time.Date(2026, 3, 28, 0, 0, 0, 0, time.UTC), time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC))
+		require.Len(t, occurrences, 3)
+		// 02:30 does not exist on the 29th, the call moves to 03:30
+		assert.Equal(t, wallClock(2026, 3, 29, 2, 30), occurrences[1].Start)
+		assert.Equal(t, time.Date(2026, 3, 29, 1, 30, 0, 0, time.UTC), occurrences[1].StartsAt)
+		assert.Equal(t, 3, occurrences[1].StartsAt.In(location).Hour())
+		assert.Equal(t, 2, occurrences[2].StartsAt.In(location).Hour())
+	})
+
+	t.Run("repeated wall clock", func(t *testing.T) {
+		recurrence, err := NewRecurrence("FREQ=DAILY", wallClock(2026, 10, 24, 2, 30), location, nil)
+		require.NoError(t, err)
+
+		occurrences := recurrence.Between(time.Date(2026, 10, 24, 0, 0, 0, 0, time.UTC), time.Date(2026, 10, 27, 0, 0, 0, 0, time.UTC))
+		require.Len(t, occurrences, 3)
+		// 02:30 happens twice on the 25th, the call only takes place the first time
+		assert.Equal(t, time.Date(2026, 10, 25, 0, 30, 0, 0, time.UTC), occurrences[1].StartsAt)
+		assert.Equal(t, time.Date(2026, 10, 26, 1, 30, 0, 0, time.UTC), occurrences[2].StartsAt)
+	})
+}
+
+func TestRecurrenceExceptions(t *testing.T) {
+	location := stockholm(t)
+	recurrence, err := NewRecurrence("FREQ=DAILY;COUNT=3", wallClock(2026, 10, 19, 10, 0), location, []time.Time{
+		wallClock(2026, 10, 20, 10, 0),
+		// Not an occurrence, ignored
+		wallClock(2026, 10, 21, 11, 0),
+	})
+	require.NoError(t, err)
+
+	occurrences := recurrence.Between(time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC))
+	require.Len(t, occurrences, 2)
+	assert.Equal(t, wallClock(2026, 10, 19, 10, 0), occurrences[0].Start)
+	assert.Equal(t, wallClock(2026, 10, 21, 10, 0), occurrences[1].Start)
+}
+
+func TestRecurrenceBetween(t *testing.T) {
+	location := stockholm(t)
+	recurrence, err := NewRecurrence("RRULE:FREQ=DAILY;UNTIL=20261022T100000", wallClock(2026, 10, 19, 10, 0), location, nil)
+	require.NoError(t, err)
+
+	// The range is on instants, UNTIL on the wall clock of the device
+	occurrences := recurrence.Between(time.Date(2026, 10, 19, 8, 0, 0, 1, time.UTC), time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC))
+	require.Len(t, occurrences, 3)
+	assert.Equal(t, time.Date(2026, 10, 20, 8, 0, 0, 0, time.UTC), occurrences[0].StartsAt)
+	assert.Equal(t, time.Date(2026, 10, 22, 8, 0, 0, 0, time.UTC), occurrences[2].StartsAt)
+}
+
+func TestParseRule(t *testing.T) {
+	for _, rule := range []string{
+		"FREQ=WEEKLY;BYDAY=MO,WE",
+		"RRULE:FREQ=MONTHLY;BYMONTHDAY=1",
+		"FREQ=DAILY;INTERVAL=2;COUNT=10",
+	} {
+		_, err := ParseRule(rule)
+		assert.NoError(t, err, rule)
+	}
+
+	for _, rule := range []string{
+		"",
+		"FREQ=HOURLY",
+		"FREQ=MINUTELY;COUNT=5",
+		"BYDAY=MO",
+		"FREQ=DAILY;BYDAY=XX",
+		"DTSTART:20261019T100000\nRRULE:FREQ=DAILY",
+	} {
+		_, err := ParseRule(rule)
+		assert.Error(t, err, rule)
+	}
+}
@@ -20,3 +20,32 @@ func GetAuth(ctx context.Context) *Auth {
 	auth, _ := ctx.Value(authKey).(*Auth)
 	return auth
 }
+
+var deviceIdentityKey key = 1
+
+// DeviceIdentity is the identity of a device that authenticated with a device token.
+type DeviceIdentity struct {
+	// ID is the internal database id of the device.
+	ID       int32
+	DeviceID string
+	TenantID string
+}
+
+func WithDeviceIdentity(ctx context.Context, identity *DeviceIdentity) context.Context {
+	return context.WithValue(ctx, deviceIdentityKey, identity)
+}
+
+func GetDeviceIdentity(ctx context.Context) *DeviceIdentity {
+	identity, _ := ctx.Value(deviceIdentityKey).(*DeviceIdentity)
+	return identity
+}
+
+// MustGetDeviceIdentity returns the identity of the calling device and panics if there is none.
+// DeviceAuthInterceptor puts an identity into the context of every device procedure except Enroll.
+func MustGetDeviceIdentity(ctx context.Context) *DeviceIdentity {
+	identity := GetDeviceIdentity(ctx)
+	if identity == nil {
+		panic("no device identity in context")
+	}
+	return identity
+}
@@ -0,0 +1,361 @@
+package auth
+
+import (
+	"connectrpc.com/connect"
+	"container/list"
+	"context"
+	"database/sql"
+	"errors"
+	"expvar"
+	"fmt"
+	. "github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	jose "gopkg.in/go-jose/go-jose.v2/jwt"
+	"log/slog"
+	"net/http"
+	"sidus.io/home-call/devicekey"
+	homecallv1alpha "sidus.io/home-call/gen/connect/homecall/v1alpha"
+	"sidus.io/home-call/gen/connect/homecall/v1alpha/homecallv1alphaconnect"
+	"sidus.io/home-call/gen/jetdb/public/model"
+	. "sidus.io/home-call/gen/jetdb/public/table"
+	"sidus.io/home-call/util"
+	"strings"
+	"sync"
+	"time"
+)
+
+// deviceTokenRejections counts device tokens that were correctly signed but rejected anyway, by reason.
+var deviceTokenRejections = expvar.NewMap("homecall_device_token_rejections")
+
+const deviceTokenLeeway = 30 * time.Second
+
+// maxCachedDeviceKeys bounds the number of parsed public keys kept in memory,
+// the least recently used keys are dropped first.
+const maxCachedDeviceKeys = 10000
+
+// deviceClaims are the claims of a device token.
+type deviceClaims struct {
+	jwt.RegisteredClaims
+	// Procedure optionally binds the token to a single RPC procedure,
+	// e.g. "/homecall.v1alpha.DeviceService/Heartbeat".
+	Procedure string `json:"procedure,omitempty"`
+}
+
+// DeviceAuthInterceptor verifies device tokens and puts the DeviceIdentity of the caller into the context.
+// Enroll is the only procedure that is called without a token.
+type DeviceAuthInterceptor struct {
+	db               *sql.DB
+	tokenMaxLifetime time.Duration
+	logger           *slog.Logger
+
+	keysMu sync.Mutex
+	// keys holds the elements of keysLRU by device, the most recently used key is at the front.
+	keys    map[int32]*list.Element
+	keysLRU *list.List
+}
+
+// cachedDeviceKey is a parsed public key together with the PEM it was parsed from,
+// so a rotated key is noticed without any invalidation.
+type cachedDeviceKey struct {
+	id  int32
+	pem string
+	key *devicekey.Key
+}
+
+func NewDeviceAuthInterceptor(db *sql.DB, tokenMaxLifetime time.Duration, logger *slog.Logger) *DeviceAuthInterceptor {
+	return &DeviceAuthInterceptor{
+		db:               db,
+		tokenMaxLifetime: tokenMaxLifetime,
+		logger:           logger,
+		keys:             make(map[int32]*list.Element),
+		keysLRU:          list.New(),
+	}
+}
+
+func (i *DeviceAuthInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(
+		ctx context.Context,
+		req connect.AnyRequest,
+	) (connect.AnyResponse, error) {
+		procedure := req.Spec().Procedure
+		if procedure == homecallv1alphaconnect.DeviceServiceEnrollProcedure {
+			return next(ctx, req)
+		}
+		ctx, err := i.authenticate(ctx, req.Header(), procedure)
+		if err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+func (*DeviceAuthInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(
+		ctx context.Context,
+		spec connect.Spec,
+	) connect.StreamingClientConn {
+		return next(ctx, spec)
+	}
+}
+
+func (i *DeviceAuthInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(
+		ctx context.Context,
+		conn connect.StreamingHandlerConn,
+	) error {
+		ctx, err := i.authenticate(ctx, conn.RequestHeader(), conn.Spec().Procedure)
+		if err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	}
+}
+
+func (i *DeviceAuthInterceptor) authenticate(ctx context.Context, header http.Header, procedure string) (context.Context, error) {
+	identity, err := i.verifyDeviceToken(ctx, header, procedure)
+	if err != nil {
+		cErr := &connect.Error{}
+		if errors.As(err, &cErr) {
+			return ctx, err
+		}
+		return ctx, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+	return WithDeviceIdentity(ctx, identity), nil
+}
+
+func (i *DeviceAuthInterceptor) verifyDeviceToken(ctx context.Context, header http.Header, procedure string) (*DeviceIdentity, error) {
+	// Verify bearer token
+	bearerToken := strings.TrimSpace(
+		strings.TrimPrefix(
+			header.Get("Authorization"),
+			"Bearer "),
+	)
+	if bearerToken == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing bearer token"))
+	}
+
+	parsedToken, err := jose.ParseSigned(bearerToken)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse the token: %w", err)
+	}
+
+	unsafeClaims := jose.Claims{}
+	err = parsedToken.UnsafeClaimsWithoutVerification(&unsafeClaims)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse the claims: %w", err)
+	}
+
+	deviceId := unsafeClaims.Subject
+	if deviceId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("missing device id"))
+	}
+
+	deviceStmt := SELECT(
+		Device.ID,
+		Device.PublicKey,
+		Device.PublicKeyAlgorithm,
+		Device.DisabledAt,
+		Tenant.TenantID,
+	).FROM(
+		Device.INNER_JOIN(Tenant, Device.TenantID.EQ(Tenant.ID)),
+	).WHERE(
+		Device.DeviceID.EQ(String(deviceId)),
+	).LIMIT(1)
+
+	var device struct {
+		model.Device
+		Tenant model.Tenant
+	}
+	err = deviceStmt.QueryContext(ctx, i.db, &device)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("invalid device id"))
+		}
+		return nil, fmt.Errorf("failed to query database: %w", err)
+	}
+
+	if device.PublicKey == nil {
+		// The enrollment was reset
+		i.forgetPublicKey(device.ID)
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("device not enrolled"))
+	}
+	publicKey, err := i.publicKey(device.ID, *device.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse public key: %w", err)
+	}
+	if device.PublicKeyAlgorithm == nil || string(*device.PublicKeyAlgorithm) != string(publicKey.Algorithm) {
+		return nil, errors.New("public key does not match the stored algorithm")
+	}
+
+	claims, err := verifyDeviceToken(bearerToken, publicKey, deviceId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("invalid token: %w", err))
+	}
+
+	err = i.checkDeviceClaims(claims, procedure)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("invalid token: %w", err))
+	}
+
+	// Checked after the token so the state of a device is only revealed to the device itself
+	if device.DisabledAt != nil {
+		i.forgetPublicKey(device.ID)
+		return nil, NewDeviceDisabledError(connect.CodePermissionDenied, deviceId, *device.DisabledAt)
+	}
+
+	// Every token can only be used once
+	replayed, err := i.recordTokenUse(ctx, device.ID, claims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record token use: %w", err)
+	}
+	if replayed {
+		deviceTokenRejections.Add("replay", 1)
+		i.logger.Warn("rejected replayed device token", "device_id", deviceId, "procedure", procedure)
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("invalid token: token has already been used"))
+	}
+
+	return &DeviceIdentity{
+		ID:       device.ID,
+		DeviceID: deviceId,
+		TenantID: device.Tenant.TenantID,
+	}, nil
+}
+
+// NewDeviceDisabledError returns an error with a DeviceDisabledError detail,
+// which lets the apps tell a disabled device apart from other failures.
+func NewDeviceDisabledError(code connect.Code, deviceId string, disabledAt time.Time) *connect.Error {
+	connectErr := connect.NewError(code, errors.New("device is disabled"))
+	detail, err := connect.NewErrorDetail(&homecallv1alpha.DeviceDisabledError{
+		DeviceId:   deviceId,
+		DisabledAt: timestamppb.New(disabledAt),
+	})
+	if err == nil {
+		connectErr.AddDetail(detail)
+	}
+	return connectErr
+}
+
+// publicKey returns the parsed public key of a device, keys are only parsed again when they change.
+func (i *DeviceAuthInterceptor) publicKey(id int32, pem string) (*devicekey.Key, error) {
+	i.keysMu.Lock()
+	if element, ok := i.keys[id]; ok {
+		cached := element.Value.(*cachedDeviceKey)
+		if cached.pem == pem {
+			i.keysLRU.MoveToFront(element)
+			i.keysMu.Unlock()
+			return cached.key, nil
+		}
+	}
+	i.keysMu.Unlock()
+
+	key, err := devicekey.Parse(pem)
+	if err != nil {
+		return nil, err
+	}
+
+	i.keysMu.Lock()
+	defer i.keysMu.Unlock()
+	if element, ok := i.keys[id]; ok {
+		i.keysLRU.Remove(element)
+	}
+	i.keys[id] = i.keysLRU.PushFront(&cachedDeviceKey{id: id, pem: pem, key: key})
+	for i.keysLRU.Len() > maxCachedDeviceKeys {
+		oldest := i.keysLRU.Back()
+		i.keysLRU.Remove(oldest)
+		delete(i.keys, oldest.Value.(*cachedDeviceKey).id)
+	}
+	return key, nil
+}
+
+// forgetPublicKey removes the parsed public key of a device that can no longer authenticate with it.
+func (i *DeviceAuthInterceptor) forgetPublicKey(id int32) {
+	i.keysMu.Lock()
+	defer i.keysMu.Unlock()
+	if element, ok := i.keys[id]; ok {
+		i.keysLRU.Remove(element)
+		delete(i.keys, id)
+	}
+}
+
+// checkDeviceClaims enforces the rules for device tokens that go beyond a valid signature.
+func (i *DeviceAuthInterceptor) checkDeviceClaims(claims *deviceClaims, procedure string) error {
+	if claims.ID == "" {
+		deviceTokenRejections.Add("missing_jti", 1)
+		return errors.New("missing jti")
+	}
+	if claims.IssuedAt == nil {
+		deviceTokenRejections.Add("missing_iat", 1)
+		return errors.New("missing iat")
+	}
+	if claims.ExpiresAt.Sub(claims.IssuedAt.Time) > i.tokenMaxLifetime {
+		deviceTokenRejections.Add("lifetime", 1)
+		return fmt.Errorf("token lifetime exceeds %s", i.tokenMaxLifetime)
+	}
+	if claims.Procedure != "" && claims.Procedure != procedure {
+		deviceTokenRejections.Add("procedure", 1)
+		return fmt.Errorf("token is bound to %s", claims.Procedure)
+	}
+	return nil
+}
+
+// recordTokenUse stores the jti of a token until it expires, it returns true if the token was used before.
+func (i *DeviceAuthInterceptor) recordTokenUse(ctx context.Context, deviceId int32, claims *deviceClaims) (bool, error) {
+	now := time.Now().UTC()
+	replayed := false
+	err := util.WithTransaction(i.db, func(tx util.DB) error {
+		// Expired tokens are rejected anyway, so they don't need to be remembered
+		_, err := DeviceTokenUse.DELETE().
+			WHERE(
+				DeviceTokenUse.DeviceID.EQ(Int32(deviceId)).
+					AND(DeviceTokenUse.ExpiresAt.LT(TimestampT(now))),
+			).
+			ExecContext(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("failed to delete expired token uses: %w", err)
+		}
+
+		res, err := DeviceTokenUse.
+			INSERT(DeviceTokenUse.DeviceID, DeviceTokenUse.TokenID, DeviceTokenUse.ExpiresAt).
+			VALUES(Int32(deviceId), String(claims.ID), TimestampT(claims.ExpiresAt.UTC().Add(deviceTokenLeeway))).
+			ON_CONFLICT(DeviceTokenUse.DeviceID, DeviceTokenUse.TokenID).
+			DO_NOTHING().
+			ExecContext(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("failed to insert token use: %w", err)
+		}
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get affected rows: %w", err)
+		}
+		replayed = affected == 0
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return replayed, nil
+}
+
+func verifyDeviceToken(token string, publicKey *devicekey.Key, deviceId string) (*deviceClaims, error) {
+	claims := &deviceClaims{}
+	_, err := jwt.ParseWithClaims(
+		token,
+		claims,
+		func(token *jwt.Token) (interface{}, error) {
+			return publicKey.PublicKey, nil
+		},
+		jwt.WithAudience("homecall"),
+		jwt.WithIssuer("homecall-device"),
+		jwt.WithIssuedAt(),
+		jwt.WithSubject(deviceId),
+		jwt.WithLeeway(deviceTokenLeeway),
+		jwt.WithExpirationRequired(),
+		jwt.WithValidMethods(publicKey.SigningMethods()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid token: %w", err)
+	}
+	return claims, nil
+}
@@ -3,88 +3,192 @@ package deviceapi
 import (
 	"connectrpc.com/connect"
 	"context"
-	"crypto/rsa"
 	"database/sql"
 	"errors"
 	"fmt"
 	. "github.com/go-jet/jet/v2/postgres"
 	"github.com/go-jet/jet/v2/qrm"
-	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 	"google.golang.org/protobuf/encoding/protojson"
-	jose "gopkg.in/go-jose/go-jose.v2/jwt"
+	"io"
 	"log/slog"
+	"net"
+	"net/http"
+	"sidus.io/home-call/albums"
+	"sidus.io/home-call/attestation"
+	"sidus.io/home-call/blobstore"
+	"sidus.io/home-call/calls"
+	"sidus.io/home-call/devicekey"
+	"sidus.io/home-call/devicemessage"
+	"sidus.io/home-call/enrollment"
 	"sidus.io/home-call/gen/connect/homecall/v1alpha"
 	"sidus.io/home-call/gen/connect/homecall/v1alpha/homecallv1alphaconnect"
+	"sidus.io/home-call/gen/jetdb/public/enum"
 	"sidus.io/home-call/gen/jetdb/public/model"
 	. "sidus.io/home-call/gen/jetdb/public/table"
 	"sidus.io/home-call/messaging"
+	"sidus.io/home-call/presence"
+	"sidus.io/home-call/services/auth"
 	"sidus.io/home-call/util"
+	"slices"
 	"strings"
 	"time"
 )
 
 var _ homecallv1alphaconnect.DeviceServiceHandler = (*Service)(nil)
 
-func NewService(db *sql.DB, broker *messaging.Broker, logger *slog.Logger) *Service {
+func NewService(
+	db *sql.DB,
+	broker *messaging.Broker,
+	presenceModel *presence.Model,
+	logMaxBytes int64,
+	enrollmentIssuer *enrollment.Issuer,
+	pairingLimits enrollment.PairingLimits,
+	attestationVerifier *attestation.Verifier,
+	blobStore blobstore.Store,
+	logger *slog.Logger,
+) *Service {
 	return &Service{
-		db:     db,
-		broker: broker,
-		logger: logger,
+		db:                  db,
+		broker:              broker,
+		presenceModel:       presenceModel,
+		logMaxBytes:         logMaxBytes,
+		enrollmentIssuer:    enrollmentIssuer,
+		pairingLimits:       pairingLimits,
+		attestationVerifier: attestationVerifier,
+		blobStore:           blobStore,
+		logger:              logger,
 	}
 }
 
 type Service struct {
-	db     *sql.DB
-	broker *messaging.Broker
-	logger *slog.Logger
+	db                  *sql.DB
+	broker              *messaging.Broker
+	presenceModel       *presence.Model
+	logMaxBytes         int64
+	enrollmentIssuer    *enrollment.Issuer
+	pairingLimits       enrollment.PairingLimits
+	attestationVerifier *attestation.Verifier
+	blobStore           blobstore.Store
+	logger              *slog.Logger
 }
 
 func (s *Service) Enroll(ctx context.Context, req *connect.Request[homecallv1alpha.EnrollRequest]) (*connect.Response[homecallv1alpha.EnrollResponse], error) {
+	// Validate the key before the enrollment is consumed,
+	// a key that can not be used to verify tokens would lock the device out for good.
+	publicKey, err := devicekey.Parse(req.Msg.GetPublicKey())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid public key: %w", err))
+	}
+	attestationType, err := s.verifyAttestation(req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Devices can enroll with the full key, the QR payload containing the key or a short pairing code
+	var enrollmentCondition BoolExpression
+	pairingCodeSource := ""
+	switch {
+	case req.Msg.GetEnrollmentKey() != "":
+		enrollmentCondition = Enrollment.KeyHash.EQ(String(util.HashSecret(req.Msg.GetEnrollmentKey())))
+	case req.Msg.GetQrPayload() != "":
+		enrollmentKey, err := s.enrollmentIssuer.KeyFromQRPayload(req.Msg.GetQrPayload())
+		if err != nil {
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		}
+		enrollmentCondition = Enrollment.KeyHash.EQ(String(util.HashSecret(enrollmentKey)))
+	case req.Msg.GetPairingCode() != "":
+		pairingCodeSource = s.pairingCodeSource(req.Peer(), req.Header())
+		err := s.checkPairingCodeAttempts(ctx, pairingCodeSource)
+		if err != nil {
+			return nil, err
+		}
+		// Expired pairing codes are treated as invalid, so guessing them counts as a failed attempt
+		enrollmentCondition = Enrollment.PairingCodeHash.EQ(String(s.enrollmentIssuer.HashPairingCode(req.Msg.GetPairingCode()))).
+			AND(Enrollment.PairingCodeExpiresAt.GT(TimestampT(time.Now().UTC())))
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("missing enrollment key, pairing code or qr payload"))
+	}
+
 	enrollmentStmt := SELECT(
 		Enrollment.ID,
-		Enrollment.Key,
+		Enrollment.ExpiresAt,
 		Enrollment.DeviceSettings,
 		Device.DeviceID,
 		Device.ID,
 		Device.Name,
+		Device.DisabledAt,
+		Device.TimeZone,
+		Device.QuietHoursStart,
+		Device.QuietHoursEnd,
+		Tenant.TenantID,
+		Tenant.RequireDeviceAttestation,
 	).FROM(
 		Enrollment.
-			LEFT_JOIN(Device, Enrollment.ID.EQ(Device.ID)),
+			LEFT_JOIN(Device, Enrollment.ID.EQ(Device.ID)).
+			LEFT_JOIN(Tenant, Device.TenantID.EQ(Tenant.ID)),
 	).WHERE(
-		Enrollment.Key.EQ(String(req.Msg.GetEnrollmentKey())),
-	).LIMIT(1)
+		enrollmentCondition,
+	).LIMIT(1).
+		// Lock the enrollment so that it can only be consumed once
+		FOR(UPDATE().OF(Enrollment))
 
 	var enrollment struct {
 		model.Enrollment
 		model.Device
+		model.Tenant
 	}
 	var deviceSettings homecallv1alpha.DeviceSettings
 
-	err := util.WithTransaction(s.db, func(db util.DB) error {
-		err := enrollmentStmt.QueryContext(ctx, s.db, &enrollment)
+	err = util.WithTransaction(s.db, func(db util.DB) error {
+		err := enrollmentStmt.QueryContext(ctx, db, &enrollment)
 		if err != nil {
 			if errors.Is(err, qrm.ErrNoRows) {
+				if pairingCodeSource != "" {
+					return connect.NewError(connect.CodeUnauthenticated, errors.New("invalid pairing code"))
+				}
 				return connect.NewError(connect.CodeUnauthenticated, errors.New("invalid enrollment key"))
 			}
 			return fmt.Errorf("failed to query database: %w", err)
 		}
+		if time.Now().UTC().After(enrollment.ExpiresAt) {
+			return connect.NewError(connect.CodeFailedPrecondition, errors.New("enrollment key has expired, ask an admin to regenerate it"))
+		}
+		if enrollment.Device.DisabledAt != nil {
+			return auth.NewDeviceDisabledError(connect.CodePermissionDenied, enrollment.Device.DeviceID, *enrollment.Device.DisabledAt)
+		}
+		if enrollment.Tenant.RequireDeviceAttestation && attestationType == nil {
+			return connect.NewError(connect.CodeFailedPrecondition, errors.New("attestation is required to enroll"))
+		}
 
 		err = protojson.Unmarshal([]byte(enrollment.DeviceSettings), &deviceSettings)
 		if err != nil {
 			return fmt.Errorf("failed to unmarshal device settings: %w", err)
 		}
+		// The quiet hours may have changed since the enrollment was created
+		deviceSettings.QuietHours = calls.QuietHoursToProto(enrollment.Device)
+
+		var attestedAt TimestampExpression = TimestampExp(NULL)
+		var attestationTypeExpression StringExpression = StringExp(NULL)
+		if attestationType != nil {
+			attestedAt = TimestampT(time.Now().UTC())
+			attestationTypeExpression = attestationType
+		}
 
 		deviceUpdateStmt := Device.UPDATE().SET(
 			Device.PublicKey.SET(String(req.Msg.GetPublicKey())),
+			Device.PublicKeyAlgorithm.SET(keyAlgorithmEnum(publicKey.Algorithm)),
+			Device.AttestationType.SET(attestationTypeExpression),
+			Device.AttestedAt.SET(attestedAt),
 		).WHERE(Device.ID.EQ(Int32(enrollment.Device.ID)))
 
-		_, err = deviceUpdateStmt.ExecContext(ctx, s.db)
+		_, err = deviceUpdateStmt.ExecContext(ctx, db)
 		if err != nil {
 			return fmt.Errorf("failed to insert device: %w", err)
 		}
 
 		enrollmentDeleteStmt := Enrollment.DELETE().WHERE(Enrollment.ID.EQ(Int32(enrollment.Enrollment.ID)))
-		_, err = enrollmentDeleteStmt.ExecContext(ctx, s.db)
+		_, err = enrollmentDeleteStmt.ExecContext(ctx, db)
 		if err != nil {
 			return fmt.Errorf("failed to delete enrollment: %w", err)
 		}
@@ -97,29 +201,141 @@ func (s *Service) Enroll(ctx context.Context, req *connect.Request[homecallv1alp
 		return nil
 	})
 	if err != nil {
+		if pairingCodeSource != "" && connect.CodeOf(err) == connect.CodeUnauthenticated {
+			s.recordFailedPairingCodeAttempt(ctx, pairingCodeSource)
+		}
 		return nil, err
 	}
 
+	s.publishDeviceEvent(messaging.DeviceEventEnrolled, enrollment.Tenant.TenantID, enrollment.Device.DeviceID)
+
 	return &connect.Response[homecallv1alpha.EnrollResponse]{
 		Msg: &homecallv1alpha.EnrollResponse{
 			DeviceId: enrollment.DeviceID,
 			Settings: &deviceSettings,
-			Name:     enrollment.Name,
+			Name:     enrollment.Device.Name,
 		},
 	}, nil
 }
 
-func (s *Service) UpdateNotificationToken(ctx context.Context, req *connect.Request[homecallv1alpha.UpdateNotificationTokenRequest]) (*connect.Response[homecallv1alpha.UpdateNotificationTokenResponse], error) {
-	deviceId, err := s.verifyDeviceToken(ctx, req)
+// RotateKey replaces the public key of a device, the call itself is authenticated with the old key.
+func (s *Service) RotateKey(ctx context.Context, req *connect.Request[homecallv1alpha.RotateKeyRequest]) (*connect.Response[homecallv1alpha.RotateKeyResponse], error) {
+	identity := auth.MustGetDeviceIdentity(ctx)
+	deviceId := identity.DeviceID
+
+	// Make sure the device can still authenticate with the new key
+	publicKey, err := devicekey.Parse(req.Msg.GetPublicKey())
 	if err != nil {
-		cErr := &connect.Error{}
-		if errors.As(err, &cErr) {
-			return nil, err
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid public key: %w", err))
+	}
+
+	_, err = Device.UPDATE().
+		SET(
+			Device.PublicKey.SET(String(req.Msg.GetPublicKey())),
+			Device.PublicKeyAlgorithm.SET(keyAlgorithmEnum(publicKey.Algorithm)),
+		).
+		WHERE(Device.DeviceID.EQ(String(deviceId))).
+		ExecContext(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update public key: %w", err)
+	}
+
+	return &connect.Response[homecallv1alpha.RotateKeyResponse]{
+		Msg: &homecallv1alpha.RotateKeyResponse{},
+	}, nil
+}
+
+// checkPairingCodeAttempts returns an error if there have been too many failed pairing code attempts from the given source.
+// Once too many attempts have failed in total, only sources that have not failed yet may try, so that a distributed
+// attack is slowed down without locking every tenant out of pairing.
+func (s *Service) checkPairingCodeAttempts(ctx context.Context, source string) error {
+	windowStart := TimestampT(time.Now().UTC().Add(-s.pairingLimits.Window))
+
+	var total struct{ Count int }
+	err := SELECT(COUNT(PairingCodeAttempt.ID).AS("count")).
+		FROM(PairingCodeAttempt).
+		WHERE(PairingCodeAttempt.AttemptedAt.GT(windowStart)).
+		QueryContext(ctx, s.db, &total)
+	if err != nil {
+		return fmt.Errorf("failed to count pairing code attempts: %w", err)
+	}
+	maxFromSource := s.pairingLimits.MaxFailedAttemptsPerSource
+	if total.Count >= s.pairingLimits.MaxFailedAttempts {
+		maxFromSource = 1
+	}
+
+	var fromSource struct{ Count int }
+	err = SELECT(COUNT(PairingCodeAttempt.ID).AS("count")).
+		FROM(PairingCodeAttempt).
+		WHERE(
+			PairingCodeAttempt.AttemptedAt.GT(windowStart).
+				AND(PairingCodeAttempt.Source.EQ(String(source))),
+		).
+		QueryContext(ctx, s.db, &fromSource)
+	if err != nil {
+		return fmt.Errorf("failed to count pairing code attempts: %w", err)
+	}
+	if fromSource.Count >= maxFromSource {
+		return connect.NewError(connect.CodeResourceExhausted, errors.New("too many failed pairing code attempts, try again later or use the enrollment key"))
+	}
+	return nil
+}
+
+// recordFailedPairingCodeAttempt stores a failed pairing code attempt and removes attempts outside the window.
+func (s *Service) recordFailedPairingCodeAttempt(ctx context.Context, source string) {
+	now := time.Now().UTC()
+	err := util.WithTransaction(s.db, func(tx util.DB) error {
+		_, err := PairingCodeAttempt.
+			INSERT(PairingCodeAttempt.Source, PairingCodeAttempt.AttemptedAt).
+			VALUES(String(source), TimestampT(now)).
+			ExecContext(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("failed to insert pairing code attempt: %w", err)
+		}
+
+		_, err = PairingCodeAttempt.DELETE().
+			WHERE(PairingCodeAttempt.AttemptedAt.LT(TimestampT(now.Add(-s.pairingLimits.Window)))).
+			ExecContext(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("failed to delete expired pairing code attempts: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("failed to record pairing code attempt", "error", err, "source", source)
+	}
+}
+
+// pairingCodeSource returns the address of the client sending a pairing code, used to tell clients apart
+// when rate limiting. The address is taken from the configured source header if the request came through
+// the trusted proxies, otherwise the host of the peer is used.
+func (s *Service) pairingCodeSource(peer connect.Peer, header http.Header) string {
+	if s.pairingLimits.SourceHeader != "" && s.pairingLimits.TrustedProxies > 0 {
+		var addresses []string
+		for _, value := range header.Values(s.pairingLimits.SourceHeader) {
+			for _, address := range strings.Split(value, ",") {
+				addresses = append(addresses, strings.TrimSpace(address))
+			}
+		}
+		// The closest trusted proxy appended the address last
+		if len(addresses) >= s.pairingLimits.TrustedProxies {
+			address := addresses[len(addresses)-s.pairingLimits.TrustedProxies]
+			if address != "" {
+				return address
+			}
 		}
-		return nil, connect.NewError(connect.CodeUnauthenticated, err)
 	}
 
-	deviceIdExpression := SELECT(Device.ID).FROM(Device).WHERE(Device.DeviceID.EQ(String(deviceId))).LIMIT(1)
+	host, _, err := net.SplitHostPort(peer.Addr)
+	if err != nil {
+		return peer.Addr
+	}
+	return host
+}
+
+func (s *Service) UpdateNotificationToken(ctx context.Context, req *connect.Request[homecallv1alpha.UpdateNotificationTokenRequest]) (*connect.Response[homecallv1alpha.UpdateNotificationTokenResponse], error) {
+	identity := auth.MustGetDeviceIdentity(ctx)
+	deviceIdExpression := Int32(identity.ID)
 
 	updateStmt := DeviceNotificationToken.
 		INSERT(DeviceNotificationToken.DeviceID, DeviceNotificationToken.NotificationToken, DeviceNotificationToken.UpdatedAt).
@@ -130,7 +346,7 @@ func (s *Service) UpdateNotificationToken(ctx context.Context, req *connect.Requ
 			DeviceNotificationToken.UpdatedAt.SET(CAST(NOW()).AS_TIMESTAMP()),
 		))
 
-	_, err = updateStmt.ExecContext(ctx, s.db)
+	_, err := updateStmt.ExecContext(ctx, s.db)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update fcm token: %w", err)
 	}
@@ -141,27 +357,21 @@ func (s *Service) UpdateNotificationToken(ctx context.Context, req *connect.Requ
 }
 
 func (s *Service) GetCallDetails(ctx context.Context, req *connect.Request[homecallv1alpha.GetCallDetailsRequest]) (*connect.Response[homecallv1alpha.GetCallDetailsResponse], error) {
-	deviceId, err := s.verifyDeviceToken(ctx, req)
-	if err != nil {
-		cErr := &connect.Error{}
-		if errors.As(err, &cErr) {
-			return nil, err
-		}
-		return nil, connect.NewError(connect.CodeUnauthenticated, err)
-	}
+	identity := auth.MustGetDeviceIdentity(ctx)
 
-	callStmt := SELECT(DeviceCallOutbox.JitsiJwt, DeviceCallOutbox.JitsiRoomID).
-		FROM(DeviceCallOutbox.LEFT_JOIN(Device, DeviceCallOutbox.DeviceID.EQ(Device.ID))).
+	callStmt := SELECT(CallParticipant.JitsiJwt, CallParticipant.AutoAnswerDelaySeconds, Call.JitsiRoomID).
+		FROM(CallParticipant.INNER_JOIN(Call, CallParticipant.CallID.EQ(Call.ID))).
 		WHERE(
-			Device.DeviceID.EQ(String(deviceId)).
-				AND(DeviceCallOutbox.CallID.EQ(String(req.Msg.GetCallId()))).
-				AND(DeviceCallOutbox.CreatedAt.GT(CAST(NOW()).AS_TIMESTAMP().SUB(INTERVALd(time.Hour)))),
+			CallParticipant.DeviceID.EQ(Int32(identity.ID)).
+				AND(Call.CallID.EQ(String(req.Msg.GetCallId()))).
+				AND(calls.Joinable()),
 		).LIMIT(1)
 
 	var call struct {
-		model.DeviceCallOutbox
+		model.Call
+		model.CallParticipant
 	}
-	err = callStmt.QueryContext(ctx, s.db, &call)
+	err := callStmt.QueryContext(ctx, s.db, &call)
 	if err != nil {
 		if errors.Is(err, qrm.ErrNoRows) {
 			return nil, connect.NewError(connect.CodeNotFound, errors.New("call not found"))
@@ -169,95 +379,583 @@ func (s *Service) GetCallDetails(ctx context.Context, req *connect.Request[homec
 		return nil, fmt.Errorf("failed to query database: %w", err)
 	}
 
+	details := &homecallv1alpha.GetCallDetailsResponse{
+		JitsiJwt:    call.CallParticipant.JitsiJwt,
+		JitsiRoomId: call.Call.JitsiRoomID,
+		CallId:      req.Msg.GetCallId(),
+	}
+	if call.CallParticipant.AutoAnswerDelaySeconds != nil {
+		details.AutoAnswer = true
+		details.AutoAnswerDelaySeconds = int64(*call.CallParticipant.AutoAnswerDelaySeconds)
+	}
+
 	return &connect.Response[homecallv1alpha.GetCallDetailsResponse]{
-		Msg: &homecallv1alpha.GetCallDetailsResponse{
-			JitsiJwt:    call.JitsiJwt,
-			JitsiRoomId: call.JitsiRoomID,
-			CallId:      req.Msg.GetCallId(),
-		},
+		Msg: details,
 	}, nil
 }
 
-func (s *Service) verifyDeviceToken(ctx context.Context, req connect.AnyRequest) (string, error) {
-	// Verify bearer token
-	bearerToken := strings.TrimSpace(
-		strings.TrimPrefix(
-			req.Header().Get("Authorization"),
-			"Bearer "),
-	)
-	if bearerToken == "" {
-		return "", connect.NewError(connect.CodeUnauthenticated, errors.New("missing bearer token"))
+// UpdateCallState records that the device joined, left or declined a call.
+func (s *Service) UpdateCallState(ctx context.Context, req *connect.Request[homecallv1alpha.UpdateCallStateRequest]) (*connect.Response[homecallv1alpha.UpdateCallStateResponse], error) {
+	identity := auth.MustGetDeviceIdentity(ctx)
+
+	err := calls.UpdateParticipantState(ctx, s.db, req.Msg.GetCallId(), CallParticipant.DeviceID.EQ(Int32(identity.ID)), req.Msg.GetState())
+	if err != nil {
+		return nil, err
+	}
+
+	return &connect.Response[homecallv1alpha.UpdateCallStateResponse]{
+		Msg: &homecallv1alpha.UpdateCallStateResponse{},
+	}, nil
+}
+
+// ListMissedCalls returns the missed calls of the device that have not been dismissed.
+func (s *Service) ListMissedCalls(ctx context.Context, req *connect.Request[homecallv1alpha.ListMissedCallsRequest]) (*connect.Response[homecallv1alpha.ListMissedCallsResponse], error) {
+	identity := auth.MustGetDeviceIdentity(ctx)
+
+	var missedCalls []calls.MissedCallRow
+	err := calls.SelectMissedCalls(
+		MissedCall.DeviceID.EQ(Int32(identity.ID)).AND(MissedCall.DismissedAt.IS_NULL()),
+	).ORDER_BY(MissedCall.CreatedAt.DESC(), MissedCall.ID.DESC()).QueryContext(ctx, s.db, &missedCalls)
+	if err != nil && !errors.Is(err, qrm.ErrNoRows) {
+		return nil, fmt.Errorf("failed to query missed calls: %w", err)
 	}
 
-	parsedToken, err := jose.ParseSigned(bearerToken)
+	response := &homecallv1alpha.ListMissedCallsResponse{}
+	for _, missedCall := range missedCalls {
+		response.MissedCalls = append(response.MissedCalls, calls.MissedCallToProto(missedCall))
+	}
+	return &connect.Response[homecallv1alpha.ListMissedCallsResponse]{
+		Msg: response,
+	}, nil
+}
+
+// DismissMissedCall removes a missed call from the badge of the device.
+func (s *Service) DismissMissedCall(ctx context.Context, req *connect.Request[homecallv1alpha.DismissMissedCallRequest]) (*connect.Response[homecallv1alpha.DismissMissedCallResponse], error) {
+	identity := auth.MustGetDeviceIdentity(ctx)
+
+	err := dismissMissedCall(ctx, s.db, identity.ID, req.Msg.GetMissedCallId(), time.Now().UTC())
 	if err != nil {
-		return "", fmt.Errorf("could not parse the token: %w", err)
+		return nil, err
 	}
 
-	unsafeClaims := jose.Claims{}
-	err = parsedToken.UnsafeClaimsWithoutVerification(&unsafeClaims)
+	return &connect.Response[homecallv1alpha.DismissMissedCallResponse]{
+		Msg: &homecallv1alpha.DismissMissedCallResponse{},
+	}, nil
+}
+
+// RequestCallback adds a callback request for the device, or returns its open one.
+func (s *Service) RequestCallback(ctx context.Context, req *connect.Request[homecallv1alpha.RequestCallbackRequest]) (*connect.Response[homecallv1alpha.RequestCallbackResponse], error) {
+	identity := auth.MustGetDeviceIdentity(ctx)
+
+	missedCallId := req.Msg.GetMissedCallId()
+	now := time.Now().UTC()
+	var callbackRequests []calls.CallbackRequestRow
+	err := util.WithTransaction(s.db, func(tx util.DB) error {
+		var missedCall Expression = NULL
+		if missedCallId != "" {
+			err := dismissMissedCall(ctx, tx, identity.ID, missedCallId, now)
+			if err != nil {
+				return err
+			}
+			missedCall = SELECT(MissedCall.ID).FROM(MissedCall).WHERE(MissedCall.MissedCallID.EQ(String(missedCallId)))
+		}
+
+		// A device has a single open request, which is kept if it asks again
+		_, err := CallbackRequest.INSERT(
+			CallbackRequest.CallbackRequestID,
+			CallbackRequest.TenantID,
+			CallbackRequest.DeviceID,
+			CallbackRequest.MissedCallID,
+			CallbackRequest.CreatedAt,
+		).VALUES(
+			String(uuid.New().String()),
+			SELECT(Device.TenantID).FROM(Device).WHERE(Device.ID.EQ(Int32(identity.ID))),
+			Int32(identity.ID),
+			missedCall,
+			TimestampT(now),
+		).ON_CONFLICT(CallbackRequest.DeviceID).WHERE(CallbackRequest.ResolvedAt.IS_NULL()).DO_NOTHING().
+			ExecContext(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("failed to insert callback request: %w", err)
+		}
+
+		err = calls.SelectCallbackRequests(
+			CallbackRequest.DeviceID.EQ(Int32(identity.ID)).AND(CallbackRequest.ResolvedAt.IS_NULL()),
+		).QueryContext(ctx, tx, &callbackRequests)
+		if err != nil && !errors.Is(err, qrm.ErrNoRows) {
+			return fmt.Errorf("failed to query callback request: %w", err)
+		}
+		if len(callbackRequests) == 0 {
+			return errors.New("callback request not found after insert")
+		}
+		return nil
+	})
 	if err != nil {
-		return "", fmt.Errorf("could not parse the claims: %w", err)
+		return nil, err
+	}
+
+	return &connect.Response[homecallv1alpha.RequestCallbackResponse]{
+		Msg: &homecallv1alpha.RequestCallbackResponse{
+			CallbackRequest: calls.CallbackRequestToProto(callbackRequests[0]),
+		},
+	}, nil
+}
+
+// ListMessages returns the messages sent to the device.
+func (s *Service) ListMessages(ctx context.Context, req *connect.Request[homecallv1alpha.ListMessagesRequest]) (*connect.Response[homecallv1alpha.ListMessagesResponse], error) {
+	identity := auth.MustGetDeviceIdentity(ctx)
+
+	var messages []devicemessage.Row
+	err := devicemessage.Select(DeviceMessage.DeviceID.EQ(Int32(identity.ID))).QueryContext(ctx, s.db, &messages)
+	if err != nil && !errors.Is(err, qrm.ErrNoRows) {
+		return nil, fmt.Errorf("failed to query messages: %w", err)
 	}
 
-	deviceId := unsafeClaims.Subject
-	if deviceId == "" {
-		return "", connect.NewError(connect.CodeInvalidArgument, errors.New("missing device id"))
+	response := &homecallv1alpha.ListMessagesResponse{}
+	for _, message := range messages {
+		response.Messages = append(response.Messages, devicemessage.ToProto(message))
 	}
+	return &connect.Response[homecallv1alpha.ListMessagesResponse]{
+		Msg: response,
+	}, nil
+}
 
-	deviceStmt := SELECT(
-		Device.PublicKey,
-	).FROM(
-		Device,
+// AckMessage marks a message sent to the device as read, acknowledging it again keeps the first time.
+func (s *Service) AckMessage(ctx context.Context, req *connect.Request[homecallv1alpha.AckMessageRequest]) (*connect.Response[homecallv1alpha.AckMessageResponse], error) {
+	identity := auth.MustGetDeviceIdentity(ctx)
+
+	result, err := DeviceMessage.UPDATE().SET(
+		DeviceMessage.ReadAt.SET(TimestampExp(COALESCE(DeviceMessage.ReadAt, TimestampT(time.Now().UTC())))),
 	).WHERE(
-		Device.DeviceID.EQ(String(deviceId)),
-	).LIMIT(1)
+		DeviceMessage.MessageID.EQ(String(req.Msg.GetMessageId())).AND(DeviceMessage.DeviceID.EQ(Int32(identity.ID))),
+	).ExecContext(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acknowledge message: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	if rows == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("message not found"))
+	}
 
-	var device model.Device
-	err = deviceStmt.QueryContext(ctx, s.db, &device)
+	return &connect.Response[homecallv1alpha.AckMessageResponse]{
+		Msg: &homecallv1alpha.AckMessageResponse{},
+	}, nil
+}
+
+// GetMessageImage returns the content of the photo of a message sent to the device.
+func (s *Service) GetMessageImage(ctx context.Context, req *connect.Request[homecallv1alpha.GetMessageImageRequest]) (*connect.Response[homecallv1alpha.GetMessageImageResponse], error) {
+	identity := auth.MustGetDeviceIdentity(ctx)
+
+	var message model.DeviceMessage
+	err := SELECT(DeviceMessage.AllColumns).FROM(DeviceMessage).WHERE(
+		DeviceMessage.MessageID.EQ(String(req.Msg.GetMessageId())).AND(DeviceMessage.DeviceID.EQ(Int32(identity.ID))),
+	).LIMIT(1).QueryContext(ctx, s.db, &message)
 	if err != nil {
 		if errors.Is(err, qrm.ErrNoRows) {
-			return "", connect.NewError(connect.CodeUnauthenticated, errors.New("invalid device id"))
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("message not found"))
 		}
-		return "", fmt.Errorf("failed to query database: %w", err)
+		return nil, fmt.Errorf("failed to query message: %w", err)
+	}
+
+	image, data, err := devicemessage.ReadImage(ctx, s.blobStore, message)
+	if err != nil {
+		return nil, err
+	}
 
+	return &connect.Response[homecallv1alpha.GetMessageImageResponse]{
+		Msg: &homecallv1alpha.GetMessageImageResponse{
+			Image: image,
+			Data:  data,
+		},
+	}, nil
+}
+
+// SyncContent returns the manifest of the albums shown on the device, unless the device already has it.
+func (s *Service) SyncContent(ctx context.Context, req *connect.Request[homecallv1alpha.SyncContentRequest]) (*connect.Response[homecallv1alpha.SyncContentResponse], error) {
+	identity := auth.MustGetDeviceIdentity(ctx)
+
+	deviceAlbums, err := albums.Load(ctx, s.db, albums.ShownOn(identity.ID))
+	if err != nil {
+		return nil, err
+	}
+	etag, err := albums.ManifestETag(deviceAlbums)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &homecallv1alpha.SyncContentResponse{
+		Etag: etag,
+	}
+	if req.Msg.GetEtag() == etag {
+		response.NotModified = true
+	} else {
+		response.Albums = deviceAlbums
+	}
+	return &connect.Response[homecallv1alpha.SyncContentResponse]{
+		Msg: response,
+	}, nil
+}
+
+// GetAlbumImage returns the content of an image in an album shown on the device.
+func (s *Service) GetAlbumImage(ctx context.Context, req *connect.Request[homecallv1alpha.GetAlbumImageRequest]) (*connect.Response[homecallv1alpha.GetAlbumImageResponse], error) {
+	identity := auth.MustGetDeviceIdentity(ctx)
+
+	var image model.AlbumImage
+	err := SELECT(AlbumImage.AllColumns).
+		FROM(AlbumImage.INNER_JOIN(Album, Album.ID.EQ(AlbumImage.AlbumID))).
+		WHERE(AlbumImage.ImageID.EQ(String(req.Msg.GetImageId())).AND(albums.ShownOn(identity.ID))).
+		LIMIT(1).QueryContext(ctx, s.db, &image)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("image not found"))
+		}
+		return nil, fmt.Errorf("failed to query album image: %w", err)
 	}
 
-	if device.PublicKey == nil {
-		return "", connect.NewError(connect.CodeFailedPrecondition, errors.New("device not enrolled"))
+	content, err := s.blobStore.Get(ctx, image.BlobKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get image content: %w", err)
 	}
-	publicKey, err := jwt.ParseRSAPublicKeyFromPEM([]byte(*device.PublicKey))
+	defer content.Close()
+	data, err := io.ReadAll(content)
 	if err != nil {
-		return "", fmt.Errorf("failed to parse public key: %w", err)
+		return nil, fmt.Errorf("failed to read image content: %w", err)
 	}
 
-	err = verifyDeviceToken(bearerToken, publicKey, deviceId)
+	return &connect.Response[homecallv1alpha.GetAlbumImageResponse]{
+		Msg: &homecallv1alpha.GetAlbumImageResponse{
+			Image: albums.ImageToProto(image),
+			Data:  data,
+		},
+	}, nil
+}
+
+// dismissMissedCall marks a missed call of a device as dismissed, dismissing it again keeps the first time.
+func dismissMissedCall(ctx context.Context, db util.DB, deviceId int32, missedCallId string, now time.Time) error {
+	result, err := MissedCall.UPDATE().SET(
+		MissedCall.DismissedAt.SET(TimestampExp(COALESCE(MissedCall.DismissedAt, TimestampT(now)))),
+	).WHERE(
+		MissedCall.MissedCallID.EQ(String(missedCallId)).AND(MissedCall.DeviceID.EQ(Int32(deviceId))),
+	).ExecContext(ctx, db)
+	if err != nil {
+		return fmt.Errorf("failed to dismiss missed call: %w", err)
+	}
+	rows, err := result.RowsAffected()
 	if err != nil {
-		return "", connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("invalid token: %w", err))
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	if rows == 0 {
+		return connect.NewError(connect.CodeNotFound, errors.New("missed call not found"))
 	}
-	return deviceId, nil
+	return nil
 }
 
-func verifyDeviceToken(token string, publicKey *rsa.PublicKey, deviceId string) error {
-	_, err := jwt.Parse(
-		token,
-		func(token *jwt.Token) (interface{}, error) {
-			return publicKey, nil
+func (s *Service) Heartbeat(ctx context.Context, req *connect.Request[homecallv1alpha.HeartbeatRequest]) (*connect.Response[homecallv1alpha.HeartbeatResponse], error) {
+	identity := auth.MustGetDeviceIdentity(ctx)
+	deviceId := identity.DeviceID
+
+	state := req.Msg.GetState()
+	appState, presenceState := appStateFromProto(state.GetAppState())
+	networkType := networkTypeFromProto(state.GetNetworkType())
+
+	var batteryLevel Expression = NULL
+	if state.BatteryLevel != nil {
+		if state.GetBatteryLevel() < 0 || state.GetBatteryLevel() > 100 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("battery level must be between 0 and 100"))
+		}
+		batteryLevel = Int32(state.GetBatteryLevel())
+	}
+	var batteryCharging Expression = NULL
+	if state.BatteryCharging != nil {
+		batteryCharging = Bool(state.GetBatteryCharging())
+	}
+
+	deviceIdExpression := Int32(identity.ID)
+
+	upsertStmt := DevicePresence.
+		INSERT(
+			DevicePresence.DeviceID,
+			DevicePresence.LastSeenAt,
+			DevicePresence.AppState,
+			DevicePresence.BatteryLevel,
+			DevicePresence.BatteryCharging,
+			DevicePresence.NetworkType,
+		).
+		VALUES(deviceIdExpression, TimestampT(time.Now().UTC()), appState, batteryLevel, batteryCharging, networkType).
+		ON_CONFLICT(DevicePresence.DeviceID).
+		DO_UPDATE(SET(
+			DevicePresence.LastSeenAt.SET(DevicePresence.EXCLUDED.LastSeenAt),
+			DevicePresence.AppState.SET(DevicePresence.EXCLUDED.AppState),
+			DevicePresence.BatteryLevel.SET(DevicePresence.EXCLUDED.BatteryLevel),
+			DevicePresence.BatteryCharging.SET(DevicePresence.EXCLUDED.BatteryCharging),
+			DevicePresence.NetworkType.SET(DevicePresence.EXCLUDED.NetworkType),
+		))
+
+	_, err := upsertStmt.ExecContext(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update device presence: %w", err)
+	}
+
+	s.publishDeviceEvent(messaging.DeviceEventHeartbeat, identity.TenantID, deviceId)
+
+	var device model.Device
+	err = SELECT(Device.TimeZone, Device.QuietHoursStart, Device.QuietHoursEnd).
+		FROM(Device).
+		WHERE(Device.ID.EQ(deviceIdExpression)).
+		QueryContext(ctx, s.db, &device)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query device: %w", err)
+	}
+
+	return &connect.Response[homecallv1alpha.HeartbeatResponse]{
+		Msg: &homecallv1alpha.HeartbeatResponse{
+			NextHeartbeatSeconds: int64(s.presenceModel.NextHeartbeat(presenceState).Seconds()),
+			QuietHours:           calls.QuietHoursToProto(device),
 		},
-		jwt.WithAudience("homecall"),
-		jwt.WithIssuer("homecall-device"),
-		jwt.WithIssuedAt(),
-		jwt.WithSubject(deviceId),
-		jwt.WithLeeway(time.Second*30),
-		jwt.WithExpirationRequired(),
-		jwt.WithValidMethods([]string{
-			jwt.SigningMethodRS256.Alg(),
-			jwt.SigningMethodRS384.Alg(),
-			jwt.SigningMethodRS512.Alg(),
-		}),
+	}, nil
+}
+
+const (
+	maxDiagnosticsErrors       = 50
+	maxDiagnosticsFieldLength  = 1024
+	maxDiagnosticsErrorsLength = 4096
+)
+
+func (s *Service) ReportDiagnostics(ctx context.Context, req *connect.Request[homecallv1alpha.ReportDiagnosticsRequest]) (*connect.Response[homecallv1alpha.ReportDiagnosticsResponse], error) {
+	identity := auth.MustGetDeviceIdentity(ctx)
+
+	diagnostics := req.Msg.GetDiagnostics()
+	if diagnostics == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("missing diagnostics"))
+	}
+	err := validateDiagnostics(diagnostics)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	report, err := protojson.Marshal(diagnostics)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal diagnostics: %w", err)
+	}
+
+	deviceIdExpression := Int32(identity.ID)
+	now := time.Now().UTC()
+
+	// Expired reports are deleted by the scheduler
+	insertStmt := DeviceDiagnostics.
+		INSERT(DeviceDiagnostics.DeviceID, DeviceDiagnostics.Report, DeviceDiagnostics.ReportedAt).
+		VALUES(deviceIdExpression, Json(string(report)), TimestampT(now))
+	_, err = insertStmt.ExecContext(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert diagnostics: %w", err)
+	}
+
+	return &connect.Response[homecallv1alpha.ReportDiagnosticsResponse]{
+		Msg: &homecallv1alpha.ReportDiagnosticsResponse{},
+	}, nil
+}
+
+var allowedLogContentEncodings = []string{"gzip", "identity"}
+
+func (s *Service) UploadLogs(ctx context.Context, stream *connect.ClientStream[homecallv1alpha.UploadLogsRequest]) (*connect.Response[homecallv1alpha.UploadLogsResponse], error) {
+	identity := auth.MustGetDeviceIdentity(ctx)
+
+	// The first message contains the metadata
+	if !stream.Receive() {
+		if stream.Err() != nil {
+			return nil, fmt.Errorf("failed to receive metadata: %w", stream.Err())
+		}
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("missing metadata"))
+	}
+	metadata := stream.Msg().GetMetadata()
+	if metadata == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("first message must contain metadata"))
+	}
+	contentEncoding := metadata.GetContentEncoding()
+	if contentEncoding == "" {
+		contentEncoding = "identity"
+	}
+	if !slices.Contains(allowedLogContentEncodings, contentEncoding) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported content encoding %q", contentEncoding))
+	}
+
+	// Make sure the upload was requested before reading the content
+	logStmt := SELECT(
+		DeviceLog.ID,
+		DeviceLog.DeviceID,
+		DeviceLog.Status,
+	).FROM(
+		DeviceLog,
+	).WHERE(
+		DeviceLog.LogID.EQ(String(metadata.GetLogId())).
+			AND(DeviceLog.DeviceID.EQ(Int32(identity.ID))),
+	).LIMIT(1)
+
+	var deviceLog model.DeviceLog
+	err := logStmt.QueryContext(ctx, s.db, &deviceLog)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("log not found"))
+		}
+		return nil, fmt.Errorf("failed to query database: %w", err)
+	}
+	if deviceLog.Status != model.DeviceLogStatus_Requested {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("log has already been uploaded"))
+	}
+
+	var content []byte
+	for stream.Receive() {
+		chunk := stream.Msg().GetChunk()
+		if int64(len(content)+len(chunk)) > s.logMaxBytes {
+			return nil, connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("log is larger than %d bytes", s.logMaxBytes))
+		}
+		content = append(content, chunk...)
+	}
+	if stream.Err() != nil {
+		return nil, fmt.Errorf("failed to receive log: %w", stream.Err())
+	}
+
+	// Expired logs are deleted by the scheduler
+	updateStmt := DeviceLog.UPDATE(
+		DeviceLog.Status,
+		DeviceLog.UploadedAt,
+		DeviceLog.ContentEncoding,
+		DeviceLog.SizeBytes,
+		DeviceLog.Content,
+	).SET(
+		enum.DeviceLogStatus.Uploaded,
+		TimestampT(time.Now().UTC()),
+		String(contentEncoding),
+		Int(int64(len(content))),
+		Bytea(content),
+	).WHERE(
+		DeviceLog.ID.EQ(Int32(deviceLog.ID)).
+			AND(DeviceLog.Status.EQ(enum.DeviceLogStatus.Requested)),
 	)
+	res, err := updateStmt.ExecContext(ctx, s.db)
 	if err != nil {
-		return fmt.Errorf("invalid token: %w", err)
+		return nil, fmt.Errorf("failed to update log: %w", err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	if affected == 0 {
+		// Another upload finished while we were receiving, or the request expired
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("log has already been uploaded"))
+	}
+
+	return &connect.Response[homecallv1alpha.UploadLogsResponse]{
+		Msg: &homecallv1alpha.UploadLogsResponse{
+			SizeBytes: int64(len(content)),
+		},
+	}, nil
+}
+
+func validateDiagnostics(diagnostics *homecallv1alpha.DeviceDiagnostics) error {
+	for name, value := range map[string]string{
+		"app version": diagnostics.GetAppVersion(),
+		"os name":     diagnostics.GetOsName(),
+		"os version":  diagnostics.GetOsVersion(),
+	} {
+		if len(value) > maxDiagnosticsFieldLength {
+			return fmt.Errorf("%s is longer than %d characters", name, maxDiagnosticsFieldLength)
+		}
+	}
+
+	if len(diagnostics.GetRecentErrors()) > maxDiagnosticsErrors {
+		return fmt.Errorf("more than %d recent errors", maxDiagnosticsErrors)
+	}
+	for _, diagnosticsError := range diagnostics.GetRecentErrors() {
+		if len(diagnosticsError.GetSource()) > maxDiagnosticsFieldLength {
+			return fmt.Errorf("error source is longer than %d characters", maxDiagnosticsFieldLength)
+		}
+		if len(diagnosticsError.GetMessage()) > maxDiagnosticsErrorsLength {
+			return fmt.Errorf("error message is longer than %d characters", maxDiagnosticsErrorsLength)
+		}
 	}
 	return nil
 }
+
+func (s *Service) publishDeviceEvent(eventType messaging.DeviceEventType, tenantId string, deviceId string) {
+	err := s.broker.PublishDeviceEvent(messaging.DeviceEvent{
+		Type:     eventType,
+		TenantID: tenantId,
+		DeviceID: deviceId,
+	})
+	if err != nil {
+		s.logger.Error("failed to publish device event", "error", err, "type", eventType, "device_id", deviceId)
+	}
+}
+
+// verifyAttestation verifies the attestation of an enroll request, if there is one.
+// It returns the type of the attestation or nil if the request has no attestation.
+func (s *Service) verifyAttestation(req *homecallv1alpha.EnrollRequest) (StringExpression, error) {
+	// The statement is bound to the public key, so it can not be replayed to enroll another key
+	clientData := []byte(req.GetPublicKey())
+
+	var attestationType StringExpression
+	var err error
+	switch statement := req.GetAttestation().GetStatement().(type) {
+	case nil:
+		return nil, nil
+	case *homecallv1alpha.DeviceAttestation_PlayIntegrityToken:
+		attestationType = enum.DeviceAttestationType.PlayIntegrity
+		err = s.attestationVerifier.VerifyPlayIntegrity(statement.PlayIntegrityToken, clientData)
+	case *homecallv1alpha.DeviceAttestation_AppAttest:
+		attestationType = enum.DeviceAttestationType.AppAttest
+		err = s.attestationVerifier.VerifyAppAttest(statement.AppAttest.GetAttestationObject(), statement.AppAttest.GetKeyId(), clientData)
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("unsupported attestation"))
+	}
+	if errors.Is(err, attestation.ErrNotConfigured) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	if err != nil {
+		s.logger.Warn("rejected device attestation", "error", err)
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("attestation failed: %w", err))
+	}
+	return attestationType, nil
+}
+
+func keyAlgorithmEnum(algorithm devicekey.Algorithm) StringExpression {
+	switch algorithm {
+	case devicekey.AlgorithmECDSAP256:
+		return enum.DeviceKeyAlgorithm.EcdsaP256
+	case devicekey.AlgorithmECDSAP384:
+		return enum.DeviceKeyAlgorithm.EcdsaP384
+	case devicekey.AlgorithmEd25519:
+		return enum.DeviceKeyAlgorithm.Ed25519
+	default:
+		return enum.DeviceKeyAlgorithm.Rsa
+	}
+}
+
+func appStateFromProto(appState homecallv1alpha.AppState) (StringExpression, presence.AppState) {
+	switch appState {
+	case homecallv1alpha.AppState_APP_STATE_FOREGROUND:
+		return enum.DeviceAppState.Foreground, presence.AppStateForeground
+	case homecallv1alpha.AppState_APP_STATE_BACKGROUND:
+		return enum.DeviceAppState.Background, presence.AppStateBackground
+	default:
+		return enum.DeviceAppState.Unknown, presence.AppStateUnknown
+	}
+}
+
+func networkTypeFromProto(networkType homecallv1alpha.NetworkType) StringExpression {
+	switch networkType {
+	case homecallv1alpha.NetworkType_NETWORK_TYPE_NONE:
+		return enum.DeviceNetworkType.None
+	case homecallv1alpha.NetworkType_NETWORK_TYPE_WIFI:
+		return enum.DeviceNetworkType.Wifi
+	case homecallv1alpha.NetworkType_NETWORK_TYPE_CELLULAR:
+		return enum.DeviceNetworkType.Cellular
+	case homecallv1alpha.NetworkType_NETWORK_TYPE_ETHERNET:
+		return enum.DeviceNetworkType.Ethernet
+	default:
+		return enum.DeviceNetworkType.Unknown
+	}
+}
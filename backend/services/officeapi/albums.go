@@ -0,0 +1,347 @@
+package officeapi
+
+import (
+	"bytes"
+	"connectrpc.com/connect"
+	"context"
+	"errors"
+	"fmt"
+	. "github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"sidus.io/home-call/albums"
+	"sidus.io/home-call/devicemessage"
+	"sidus.io/home-call/gen/connect/homecall/v1alpha"
+	"sidus.io/home-call/gen/jetdb/public/model"
+	. "sidus.io/home-call/gen/jetdb/public/table"
+	"sidus.io/home-call/services/tenantapi"
+	"sidus.io/home-call/util"
+	"strings"
+	"time"
+)
+
+// maxAlbumImages is the maximum number of images in an album.
+const maxAlbumImages = 500
+
+// CreateAlbum creates an album for a device or a device group.
+func (s *Service) CreateAlbum(ctx context.Context, req *connect.Request[homecallv1alpha.CreateAlbumRequest]) (*connect.Response[homecallv1alpha.CreateAlbumResponse], error) {
+	tenantId := req.Msg.GetTenantId()
+
+	name := strings.TrimSpace(req.Msg.GetName())
+	if name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("name is required"))
+	}
+
+	var deviceId, deviceGroupId Expression = NULL, NULL
+	switch target := req.Msg.GetTarget().(type) {
+	case *homecallv1alpha.CreateAlbumRequest_DeviceId:
+		err := s.tenantService.CanAccessDevice(ctx, target.DeviceId, false)
+		if err != nil {
+			return nil, fmt.Errorf("failed access device: %w", err)
+		}
+		device, err := s.getDevice(ctx, target.DeviceId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get device: %w", err)
+		}
+		if device.GetTenantId() != tenantId {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("device is not in the tenant"))
+		}
+		deviceId = SELECT(Device.ID).FROM(Device).WHERE(Device.DeviceID.EQ(String(target.DeviceId)))
+	case *homecallv1alpha.CreateAlbumRequest_GroupId:
+		groupId, err := s.deviceGroupID(ctx, tenantId, target.GroupId)
+		if err != nil {
+			return nil, err
+		}
+		err = s.canAccessDeviceGroup(ctx, tenantId, groupId)
+		if err != nil {
+			return nil, err
+		}
+		deviceGroupId = Int32(groupId)
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("device or group is required"))
+	}
+
+	albumId := uuid.New().String()
+	_, err := Album.INSERT(
+		Album.AlbumID,
+		Album.TenantID,
+		Album.Name,
+		Album.DeviceID,
+		Album.DeviceGroupID,
+		Album.CreatedAt,
+	).VALUES(
+		String(albumId),
+		SELECT(Tenant.ID).FROM(Tenant).WHERE(Tenant.TenantID.EQ(String(tenantId))),
+		String(name),
+		deviceId,
+		deviceGroupId,
+		TimestampT(time.Now().UTC()),
+	).ExecContext(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert album: %w", err)
+	}
+
+	created, err := albums.Load(ctx, s.db, Album.AlbumID.EQ(String(albumId)))
+	if err != nil {
+		return nil, err
+	}
+	if len(created) == 0 {
+		return nil, errors.New("album not found after insert")
+	}
+
+	return &connect.Response[homecallv1alpha.CreateAlbumResponse]{
+		Msg: &homecallv1alpha.CreateAlbumResponse{
+			Album: created[0],
+		},
+	}, nil
+}
+
+// ListAlbums returns the albums of a tenant,
+// members scoped to device groups only see the albums of their devices and groups.
+func (s *Service) ListAlbums(ctx context.Context, req *connect.Request[homecallv1alpha.ListAlbumsRequest]) (*connect.Response[homecallv1alpha.ListAlbumsResponse], error) {
+	tenantId := req.Msg.GetTenantId()
+
+	err := s.tenantService.CanAccessTenant(ctx, tenantId, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed access tenant: %w", err)
+	}
+
+	conditions := Tenant.TenantID.EQ(String(tenantId))
+	scope, err := s.tenantService.DeviceGroupScope(ctx, tenantId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get device group scope: %w", err)
+	}
+	if scope != nil {
+		conditions = conditions.AND(Device.GroupID.IN(scope).OR(Album.DeviceGroupID.IN(scope)))
+	}
+
+	tenantAlbums, err := albums.Load(ctx, s.db, conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	return &connect.Response[homecallv1alpha.ListAlbumsResponse]{
+		Msg: &homecallv1alpha.ListAlbumsResponse{
+			Albums: tenantAlbums,
+		},
+	}, nil
+}
+
+// DeleteAlbum removes an album and the content of its images.
+func (s *Service) DeleteAlbum(ctx context.Context, req *connect.Request[homecallv1alpha.DeleteAlbumRequest]) (*connect.Response[homecallv1alpha.DeleteAlbumResponse], error) {
+	album, err := s.accessAlbum(ctx, req.Msg.GetAlbumId())
+	if err != nil {
+		return nil, err
+	}
+
+	var images []model.AlbumImage
+	err = SELECT(AlbumImage.BlobKey).FROM(AlbumImage).
+		WHERE(AlbumImage.AlbumID.EQ(Int32(album.Album.ID))).
+		QueryContext(ctx, s.db, &images)
+	if err != nil && !errors.Is(err, qrm.ErrNoRows) {
+		return nil, fmt.Errorf("failed to query album images: %w", err)
+	}
+
+	// The images are removed with the album
+	_, err = Album.DELETE().WHERE(Album.ID.EQ(Int32(album.Album.ID))).ExecContext(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete album: %w", err)
+	}
+	for _, image := range images {
+		s.deleteBlob(ctx, image.BlobKey)
+	}
+
+	return &connect.Response[homecallv1alpha.DeleteAlbumResponse]{
+		Msg: &homecallv1alpha.DeleteAlbumResponse{},
+	}, nil
+}
+
+// AddAlbumImage stores a photo in the blob store and adds it to an album.
+func (s *Service) AddAlbumImage(ctx context.Context, req *connect.Request[homecallv1alpha.AddAlbumImageRequest]) (*connect.Response[homecallv1alpha.AddAlbumImageResponse], error) {
+	album, err := s.accessAlbum(ctx, req.Msg.GetAlbumId())
+	if err != nil {
+		return nil, err
+	}
+
+	content := req.Msg.GetImage()
+	if int64(len(content)) > s.albumImageMaxBytes {
+		return nil, connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("image is larger than %d bytes", s.albumImageMaxBytes))
+	}
+	contentType, ok := devicemessage.ImageContentType(content)
+	if !ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("image must be a JPEG, PNG, GIF or WebP image"))
+	}
+
+	memberId, err := s.tenantService.MemberID(ctx, album.Tenant.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed access tenant: %w", err)
+	}
+
+	// The content is stored first, an image is never listed without it
+	imageId := uuid.New().String()
+	err = s.blobStore.Put(ctx, imageId, bytes.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("failed to store image: %w", err)
+	}
+
+	var image model.AlbumImage
+	err = util.WithTransaction(s.db, func(tx util.DB) error {
+		// Lock the album so that concurrent uploads can not exceed the number of images together
+		var dbAlbum model.Album
+		err := SELECT(Album.ID).FROM(Album).
+			WHERE(Album.ID.EQ(Int32(album.Album.ID))).
+			FOR(UPDATE()).
+			QueryContext(ctx, tx, &dbAlbum)
+		if err != nil {
+			if errors.Is(err, qrm.ErrNoRows) {
+				return connect.NewError(connect.CodeNotFound, errors.New("album not found"))
+			}
+			return fmt.Errorf("failed to lock album: %w", err)
+		}
+
+		var count struct {
+			Count int64
+		}
+		err = SELECT(COUNT(AlbumImage.ID).AS("count")).FROM(AlbumImage).
+			WHERE(AlbumImage.AlbumID.EQ(Int32(album.Album.ID))).
+			QueryContext(ctx, tx, &count)
+		if err != nil {
+			return fmt.Errorf("failed to count album images: %w", err)
+		}
+		if count.Count >= maxAlbumImages {
+			return connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("an album can have at most %d images", maxAlbumImages))
+		}
+
+		err = AlbumImage.INSERT(
+			AlbumImage.ImageID,
+			AlbumImage.AlbumID,
+			AlbumImage.BlobKey,
+			AlbumImage.ContentType,
+			AlbumImage.SizeBytes,
+			AlbumImage.Etag,
+			AlbumImage.UploadedBy,
+			AlbumImage.CreatedAt,
+		).VALUES(
+			String(imageId),
+			Int32(album.Album.ID),
+			String(imageId),
+			String(contentType),
+			Int64(int64(len(content))),
+			String(albums.ContentETag(content)),
+			String(memberId),
+			TimestampT(time.Now().UTC()),
+		).RETURNING(AlbumImage.AllColumns).QueryContext(ctx, tx, &image)
+		if err != nil {
+			return fmt.Errorf("failed to insert album image: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		s.deleteBlob(ctx, imageId)
+		return nil, err
+	}
+
+	return &connect.Response[homecallv1alpha.AddAlbumImageResponse]{
+		Msg: &homecallv1alpha.AddAlbumImageResponse{
+			Image: albums.ImageToProto(image),
+		},
+	}, nil
+}
+
+// RemoveAlbumImage removes an image from its album and the blob store.
+func (s *Service) RemoveAlbumImage(ctx context.Context, req *connect.Request[homecallv1alpha.RemoveAlbumImageRequest]) (*connect.Response[homecallv1alpha.RemoveAlbumImageResponse], error) {
+	var image struct {
+		model.AlbumImage
+		model.Album
+	}
+	err := SELECT(AlbumImage.ID, AlbumImage.BlobKey, Album.AlbumID).
+		FROM(AlbumImage.INNER_JOIN(Album, Album.ID.EQ(AlbumImage.AlbumID))).
+		WHERE(AlbumImage.ImageID.EQ(String(req.Msg.GetImageId()))).
+		LIMIT(1).QueryContext(ctx, s.db, &image)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("image not found"))
+		}
+		return nil, fmt.Errorf("failed to query album image: %w", err)
+	}
+
+	_, err = s.accessAlbum(ctx, image.Album.AlbumID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = AlbumImage.DELETE().WHERE(AlbumImage.ID.EQ(Int32(image.AlbumImage.ID))).ExecContext(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete album image: %w", err)
+	}
+	s.deleteBlob(ctx, image.AlbumImage.BlobKey)
+
+	return &connect.Response[homecallv1alpha.RemoveAlbumImageResponse]{
+		Msg: &homecallv1alpha.RemoveAlbumImageResponse{},
+	}, nil
+}
+
+// accessAlbum returns an album if the caller can access the device or device group it is shown on.
+func (s *Service) accessAlbum(ctx context.Context, albumId string) (albums.Row, error) {
+	var album albums.Row
+	err := albums.Select(Album.AlbumID.EQ(String(albumId))).LIMIT(1).QueryContext(ctx, s.db, &album)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return albums.Row{}, connect.NewError(connect.CodeNotFound, errors.New("album not found"))
+		}
+		return albums.Row{}, fmt.Errorf("failed to query album: %w", err)
+	}
+
+	if album.Device != nil {
+		err = s.tenantService.CanAccessDevice(ctx, album.Device.DeviceID, false)
+		if err != nil {
+			return albums.Row{}, fmt.Errorf("failed access device: %w", err)
+		}
+		return album, nil
+	}
+	if album.Album.DeviceGroupID == nil {
+		return albums.Row{}, errors.New("album has no device or group")
+	}
+	err = s.canAccessDeviceGroup(ctx, album.Tenant.TenantID, *album.Album.DeviceGroupID)
+	if err != nil {
+		return albums.Row{}, err
+	}
+	return album, nil
+}
+
+// canAccessDeviceGroup checks that the caller is a member of the tenant
+// and, if they are scoped to device groups, that the group is one of them.
+func (s *Service) canAccessDeviceGroup(ctx context.Context, tenantId string, groupId int32) error {
+	err := s.tenantService.CanAccessTenant(ctx, tenantId, false)
+	if err != nil {
+		return fmt.Errorf("failed access tenant: %w", err)
+	}
+
+	scope, err := s.tenantService.DeviceGroupScope(ctx, tenantId)
+	if err != nil {
+		return fmt.Errorf("failed to get device group scope: %w", err)
+	}
+	if scope == nil {
+		return nil
+	}
+
+	var dbGroup model.DeviceGroup
+	err = SELECT(DeviceGroup.ID).FROM(DeviceGroup).
+		WHERE(DeviceGroup.ID.EQ(Int32(groupId)).AND(DeviceGroup.ID.IN(scope))).
+		LIMIT(1).QueryContext(ctx, s.db, &dbGroup)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return fmt.Errorf("failed access device group: %w", tenantapi.ErrNoAccess)
+		}
+		return fmt.Errorf("failed to query device group scope: %w", err)
+	}
+	return nil
+}
+
+// deleteBlob removes content from the blob store, failures only leave unused content behind.
+func (s *Service) deleteBlob(ctx context.Context, key string) {
+	err := s.blobStore.Delete(ctx, key)
+	if err != nil {
+		s.logger.Warn("failed to delete blob", "error", err, "key", key)
+	}
+}
@@ -0,0 +1,379 @@
+package officeapi
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"errors"
+	"fmt"
+	. "github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sidus.io/home-call/gen/connect/homecall/v1alpha"
+	"sidus.io/home-call/gen/jetdb/public/enum"
+	"sidus.io/home-call/gen/jetdb/public/model"
+	. "sidus.io/home-call/gen/jetdb/public/table"
+	"sidus.io/home-call/services/tenantapi"
+	"sidus.io/home-call/util"
+)
+
+// GetAllowedCallers returns whether calls to a device are restricted and who is allowed to call it.
+func (s *Service) GetAllowedCallers(ctx context.Context, req *connect.Request[homecallv1alpha.GetAllowedCallersRequest]) (*connect.Response[homecallv1alpha.GetAllowedCallersResponse], error) {
+	err := s.tenantService.CanAccessDevice(ctx, req.Msg.GetDeviceId(), false)
+	if err != nil {
+		return nil, fmt.Errorf("failed access device: %w", err)
+	}
+
+	restricted, callers, err := s.allowedCallers(ctx, s.db, req.Msg.GetDeviceId())
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&homecallv1alpha.GetAllowedCallersResponse{
+		Restricted: restricted,
+		Callers:    callers,
+	}), nil
+}
+
+// SetAllowedCallers replaces who may call a device and records every change.
+func (s *Service) SetAllowedCallers(ctx context.Context, req *connect.Request[homecallv1alpha.SetAllowedCallersRequest]) (*connect.Response[homecallv1alpha.SetAllowedCallersResponse], error) {
+	deviceId := req.Msg.GetDeviceId()
+	err := s.tenantService.CanAccessDevice(ctx, deviceId, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed access device: %w", err)
+	}
+
+	device, err := s.getDevice(ctx, deviceId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get device: %w", err)
+	}
+	tenantId := device.GetTenantId()
+
+	callerMemberId, err := s.tenantService.MemberID(ctx, tenantId)
+	if err != nil {
+		return nil, fmt.Errorf("failed access tenant: %w", err)
+	}
+
+	// Validate the callers and resolve the internal IDs of their groups
+	wanted := make(map[allowedCallerKey]bool)
+	var wantedKeys []allowedCallerKey
+	groupIds := make(map[string]int32)
+	for _, caller := range req.Msg.GetCallers() {
+		key := allowedCallerKeyOf(caller)
+		switch {
+		case key.memberId != "":
+			_, err = s.memberDisplayNames(ctx, tenantId, []string{key.memberId})
+			if err != nil {
+				return nil, err
+			}
+		case key.groupId != "":
+			groupIds[key.groupId], err = s.deviceGroupID(ctx, tenantId, key.groupId)
+			if err != nil {
+				return nil, err
+			}
+		default:
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("allowed callers must be a member or a group"))
+		}
+		if !wanted[key] {
+			wanted[key] = true
+			wantedKeys = append(wantedKeys, key)
+		}
+	}
+
+	deviceIdExpression := SELECT(Device.ID).FROM(Device).WHERE(Device.DeviceID.EQ(String(deviceId)))
+	err = util.WithTransaction(s.db, func(tx util.DB) error {
+		// Lock the device so that concurrent changes are recorded against what they actually changed
+		var dbDevice model.Device
+		err := SELECT(Device.ID).FROM(Device).
+			WHERE(Device.DeviceID.EQ(String(deviceId))).
+			FOR(UPDATE()).
+			QueryContext(ctx, tx, &dbDevice)
+		if err != nil {
+			return fmt.Errorf("failed to lock device: %w", err)
+		}
+
+		restricted, callers, err := s.allowedCallers(ctx, tx, deviceId)
+		if err != nil {
+			return err
+		}
+
+		if restricted != req.Msg.GetRestricted() {
+			_, err = Device.UPDATE().
+				SET(Device.CallersRestricted.SET(Bool(req.Msg.GetRestricted()))).
+				WHERE(Device.ID.EQ(Int32(dbDevice.ID))).
+				ExecContext(ctx, tx)
+			if err != nil {
+				return fmt.Errorf("failed to update device: %w", err)
+			}
+			action := enum.AllowedCallerAction.Unrestricted
+			if req.Msg.GetRestricted() {
+				action = enum.AllowedCallerAction.Restricted
+			}
+			err = recordAllowedCallerEvent(ctx, tx, dbDevice.ID, action, allowedCallerKey{}, callerMemberId)
+			if err != nil {
+				return err
+			}
+		}
+
+		current := make(map[allowedCallerKey]bool, len(callers))
+		for _, caller := range callers {
+			key := allowedCallerKeyOf(caller)
+			current[key] = true
+			if wanted[key] {
+				continue
+			}
+
+			condition := AllowedCaller.DeviceID.EQ(Int32(dbDevice.ID))
+			if key.memberId != "" {
+				condition = condition.AND(AllowedCaller.MemberID.EQ(String(key.memberId)))
+			} else {
+				condition = condition.AND(AllowedCaller.DeviceGroupID.IN(
+					SELECT(DeviceGroup.ID).FROM(DeviceGroup).WHERE(DeviceGroup.GroupID.EQ(String(key.groupId))),
+				))
+			}
+			_, err = AllowedCaller.DELETE().WHERE(condition).ExecContext(ctx, tx)
+			if err != nil {
+				return fmt.Errorf("failed to delete allowed caller: %w", err)
+			}
+			err = recordAllowedCallerEvent(ctx, tx, dbDevice.ID, enum.AllowedCallerAction.Removed, key, callerMemberId)
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, key := range wantedKeys {
+			if current[key] {
+				continue
+			}
+
+			var memberId, groupId Expression = NULL, NULL
+			if key.memberId != "" {
+				memberId = String(key.memberId)
+			} else {
+				groupId = Int32(groupIds[key.groupId])
+			}
+			_, err = AllowedCaller.INSERT(
+				AllowedCaller.DeviceID,
+				AllowedCaller.MemberID,
+				AllowedCaller.DeviceGroupID,
+			).VALUES(
+				deviceIdExpression,
+				memberId,
+				groupId,
+			).ExecContext(ctx, tx)
+			if err != nil {
+				return fmt.Errorf("failed to insert allowed caller: %w", err)
+			}
+			err = recordAllowedCallerEvent(ctx, tx, dbDevice.ID, enum.AllowedCallerAction.Added, key, callerMemberId)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	restricted, callers, err := s.allowedCallers(ctx, s.db, deviceId)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&homecallv1alpha.SetAllowedCallersResponse{
+		Restricted: restricted,
+		Callers:    callers,
+	}), nil
+}
+
+// ListAllowedCallerEvents lists the changes to who may call a device, only admins can see them.
+func (s *Service) ListAllowedCallerEvents(ctx context.Context, req *connect.Request[homecallv1alpha.ListAllowedCallerEventsRequest]) (*connect.Response[homecallv1alpha.ListAllowedCallerEventsResponse], error) {
+	err := s.tenantService.CanAccessDevice(ctx, req.Msg.GetDeviceId(), true)
+	if err != nil {
+		return nil, fmt.Errorf("failed access device: %w", err)
+	}
+
+	var dbEvents []model.AllowedCallerEvent
+	err = SELECT(
+		AllowedCallerEvent.AllColumns,
+	).FROM(
+		AllowedCallerEvent.INNER_JOIN(Device, Device.ID.EQ(AllowedCallerEvent.DeviceID)),
+	).WHERE(
+		Device.DeviceID.EQ(String(req.Msg.GetDeviceId())),
+	).ORDER_BY(
+		AllowedCallerEvent.CreatedAt.ASC(),
+		AllowedCallerEvent.ID.ASC(),
+	).QueryContext(ctx, s.db, &dbEvents)
+	if err != nil && !errors.Is(err, qrm.ErrNoRows) {
+		return nil, fmt.Errorf("failed to query allowed caller events: %w", err)
+	}
+
+	events := make([]*homecallv1alpha.AllowedCallerEvent, len(dbEvents))
+	for i, dbEvent := range dbEvents {
+		events[i] = &homecallv1alpha.AllowedCallerEvent{
+			Action:    allowedCallerActionToProto(dbEvent.Action),
+			CreatedAt: timestamppb.New(dbEvent.CreatedAt),
+		}
+		switch {
+		case dbEvent.MemberID != nil:
+			events[i].Caller = &homecallv1alpha.AllowedCaller{
+				Caller: &homecallv1alpha.AllowedCaller_MemberId{MemberId: *dbEvent.MemberID},
+			}
+		case dbEvent.GroupID != nil:
+			events[i].Caller = &homecallv1alpha.AllowedCaller{
+				Caller: &homecallv1alpha.AllowedCaller_GroupId{GroupId: *dbEvent.GroupID},
+			}
+		}
+		if dbEvent.ChangedBy != nil {
+			events[i].ChangedByMemberId = *dbEvent.ChangedBy
+		}
+	}
+
+	return connect.NewResponse(&homecallv1alpha.ListAllowedCallerEventsResponse{
+		Events: events,
+	}), nil
+}
+
+// canCallDevice returns an error if the caller may not call a device because calls to it are restricted.
+// Admins may call every device they can access.
+func (s *Service) canCallDevice(ctx context.Context, device *homecallv1alpha.Device, callerMemberId string) error {
+	err := s.tenantService.CanAccessTenant(ctx, device.GetTenantId(), true)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, tenantapi.ErrNoAccess) {
+		return fmt.Errorf("failed to check tenant admin: %w", err)
+	}
+
+	var dbDevice model.Device
+	err = SELECT(Device.ID).FROM(Device).WHERE(
+		Device.DeviceID.EQ(String(device.GetId())).
+			AND(Device.CallersRestricted.IS_FALSE().OR(isAllowedCaller(callerMemberId))),
+	).LIMIT(1).QueryContext(ctx, s.db, &dbDevice)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("not allowed to call device %s", device.GetName()))
+		}
+		return fmt.Errorf("failed to query allowed callers: %w", err)
+	}
+	return nil
+}
+
+// isAllowedCaller matches the devices a member is an allowed caller of, directly or through a device group.
+// It must be used in queries on Device.
+func isAllowedCaller(memberId string) BoolExpression {
+	return EXISTS(
+		SELECT(AllowedCaller.ID).FROM(AllowedCaller).WHERE(
+			AllowedCaller.DeviceID.EQ(Device.ID).AND(
+				AllowedCaller.MemberID.EQ(String(memberId)).OR(AllowedCaller.DeviceGroupID.IN(
+					SELECT(MemberDeviceGroup.DeviceGroupID).FROM(MemberDeviceGroup).WHERE(MemberDeviceGroup.MemberID.EQ(String(memberId))),
+				)),
+			),
+		),
+	)
+}
+
+// allowedCallerKey identifies an allowed caller by either its member ID or its group ID.
+type allowedCallerKey struct {
+	memberId string
+	groupId  string
+}
+
+func allowedCallerKeyOf(caller *homecallv1alpha.AllowedCaller) allowedCallerKey {
+	return allowedCallerKey{
+		memberId: caller.GetMemberId(),
+		groupId:  caller.GetGroupId(),
+	}
+}
+
+// allowedCallers returns whether calls to a device are restricted and who is allowed to call it, members first.
+func (s *Service) allowedCallers(ctx context.Context, db util.DB, deviceId string) (bool, []*homecallv1alpha.AllowedCaller, error) {
+	var dbDevice model.Device
+	err := SELECT(Device.CallersRestricted).FROM(Device).
+		WHERE(Device.DeviceID.EQ(String(deviceId))).
+		QueryContext(ctx, db, &dbDevice)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return false, nil, connect.NewError(connect.CodeNotFound, errors.New("device not found"))
+		}
+		return false, nil, fmt.Errorf("failed to query device: %w", err)
+	}
+
+	var dbCallers []struct {
+		model.AllowedCaller
+		model.DeviceGroup
+	}
+	err = SELECT(
+		AllowedCaller.ID,
+		AllowedCaller.MemberID,
+		DeviceGroup.GroupID,
+	).FROM(
+		AllowedCaller.
+			INNER_JOIN(Device, Device.ID.EQ(AllowedCaller.DeviceID)).
+			LEFT_JOIN(DeviceGroup, DeviceGroup.ID.EQ(AllowedCaller.DeviceGroupID)),
+	).WHERE(
+		Device.DeviceID.EQ(String(deviceId)),
+	).ORDER_BY(
+		AllowedCaller.MemberID.ASC().NULLS_LAST(),
+		AllowedCaller.ID.ASC(),
+	).QueryContext(ctx, db, &dbCallers)
+	if err != nil && !errors.Is(err, qrm.ErrNoRows) {
+		return false, nil, fmt.Errorf("failed to query allowed callers: %w", err)
+	}
+
+	callers := make([]*homecallv1alpha.AllowedCaller, len(dbCallers))
+	for i, dbCaller := range dbCallers {
+		if dbCaller.AllowedCaller.MemberID != nil {
+			callers[i] = &homecallv1alpha.AllowedCaller{
+				Caller: &homecallv1alpha.AllowedCaller_MemberId{MemberId: *dbCaller.AllowedCaller.MemberID},
+			}
+		} else {
+			callers[i] = &homecallv1alpha.AllowedCaller{
+				Caller: &homecallv1alpha.AllowedCaller_GroupId{GroupId: dbCaller.DeviceGroup.GroupID},
+			}
+		}
+	}
+	return dbDevice.CallersRestricted, callers, nil
+}
+
+// recordAllowedCallerEvent records a change to who may call a device.
+func recordAllowedCallerEvent(ctx context.Context, db util.DB, deviceId int32, action StringExpression, key allowedCallerKey, changedBy string) error {
+	var memberId, groupId Expression = NULL, NULL
+	if key.memberId != "" {
+		memberId = String(key.memberId)
+	}
+	if key.groupId != "" {
+		groupId = String(key.groupId)
+	}
+	_, err := AllowedCallerEvent.INSERT(
+		AllowedCallerEvent.DeviceID,
+		AllowedCallerEvent.Action,
+		AllowedCallerEvent.MemberID,
+		AllowedCallerEvent.GroupID,
+		AllowedCallerEvent.ChangedBy,
+	).VALUES(
+		deviceId,
+		action,
+		memberId,
+		groupId,
+		changedBy,
+	).ExecContext(ctx, db)
+	if err != nil {
+		return fmt.Errorf("failed to insert allowed caller event: %w", err)
+	}
+	return nil
+}
+
+func allowedCallerActionToProto(action model.AllowedCallerAction) homecallv1alpha.AllowedCallerAction {
+	switch action {
+	case model.AllowedCallerAction_Restricted:
+		return homecallv1alpha.AllowedCallerAction_ALLOWED_CALLER_ACTION_RESTRICTED
+	case model.AllowedCallerAction_Unrestricted:
+		return homecallv1alpha.AllowedCallerAction_ALLOWED_CALLER_ACTION_UNRESTRICTED
+	case model.AllowedCallerAction_Added:
+		return homecallv1alpha.AllowedCallerAction_ALLOWED_CALLER_ACTION_ADDED
+	case model.AllowedCallerAction_Removed:
+		return homecallv1alpha.AllowedCallerAction_ALLOWED_CALLER_ACTION_REMOVED
+	default:
+		return homecallv1alpha.AllowedCallerAction_ALLOWED_CALLER_ACTION_UNSPECIFIED
+	}
+}
@@ -0,0 +1,156 @@
+package officeapi
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"errors"
+	"fmt"
+	. "github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sidus.io/home-call/gen/connect/homecall/v1alpha"
+	"sidus.io/home-call/gen/jetdb/public/model"
+	. "sidus.io/home-call/gen/jetdb/public/table"
+	"sidus.io/home-call/util"
+)
+
+// calendarFeedTokenLength is the length of the random calendar feed tokens.
+const calendarFeedTokenLength = 32
+
+// CreateCalendarFeedToken creates a token for the calendar feed of the scheduled calls assigned to the caller.
+func (s *Service) CreateCalendarFeedToken(ctx context.Context, req *connect.Request[homecallv1alpha.CreateCalendarFeedTokenRequest]) (*connect.Response[homecallv1alpha.CreateCalendarFeedTokenResponse], error) {
+	tenantId := req.Msg.GetTenantId()
+	memberId, err := s.tenantService.MemberID(ctx, tenantId)
+	if err != nil {
+		return nil, fmt.Errorf("failed access tenant: %w", err)
+	}
+
+	token, err := util.RandomString(calendarFeedTokenLength)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate calendar feed token: %w", err)
+	}
+
+	tokenId := uuid.New().String()
+	_, err = CalendarFeedToken.INSERT(
+		CalendarFeedToken.TokenID,
+		CalendarFeedToken.MemberID,
+		CalendarFeedToken.TokenHash,
+	).VALUES(
+		tokenId,
+		memberId,
+		util.HashSecret(token),
+	).ExecContext(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert calendar feed token: %w", err)
+	}
+
+	calendarFeedToken, err := s.getCalendarFeedToken(ctx, tokenId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get calendar feed token: %w", err)
+	}
+
+	return connect.NewResponse(&homecallv1alpha.CreateCalendarFeedTokenResponse{
+		Token:   calendarFeedToken,
+		FeedUrl: s.calendarFeed.URL(token),
+	}), nil
+}
+
+// ListCalendarFeedTokens lists the calendar feed tokens of the caller in a tenant.
+func (s *Service) ListCalendarFeedTokens(ctx context.Context, req *connect.Request[homecallv1alpha.ListCalendarFeedTokensRequest]) (*connect.Response[homecallv1alpha.ListCalendarFeedTokensResponse], error) {
+	memberId, err := s.tenantService.MemberID(ctx, req.Msg.GetTenantId())
+	if err != nil {
+		return nil, fmt.Errorf("failed access tenant: %w", err)
+	}
+
+	var dbTokens []dbCalendarFeedToken
+	err = calendarFeedTokenQuery().
+		WHERE(CalendarFeedToken.MemberID.EQ(String(memberId))).
+		ORDER_BY(CalendarFeedToken.CreatedAt.ASC(), CalendarFeedToken.ID.ASC()).
+		QueryContext(ctx, s.db, &dbTokens)
+	if err != nil && !errors.Is(err, qrm.ErrNoRows) {
+		return nil, fmt.Errorf("failed to query calendar feed tokens: %w", err)
+	}
+
+	tokens := make([]*homecallv1alpha.CalendarFeedToken, len(dbTokens))
+	for i, dbToken := range dbTokens {
+		tokens[i] = calendarFeedTokenToProto(dbToken)
+	}
+
+	return connect.NewResponse(&homecallv1alpha.ListCalendarFeedTokensResponse{
+		Tokens: tokens,
+	}), nil
+}
+
+// RevokeCalendarFeedToken removes a calendar feed token, it can be revoked by its owner and by tenant admins.
+func (s *Service) RevokeCalendarFeedToken(ctx context.Context, req *connect.Request[homecallv1alpha.RevokeCalendarFeedTokenRequest]) (*connect.Response[homecallv1alpha.RevokeCalendarFeedTokenResponse], error) {
+	tokenId := req.Msg.GetTokenId()
+	calendarFeedToken, err := s.getCalendarFeedToken(ctx, tokenId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get calendar feed token: %w", err)
+	}
+
+	memberId, err := s.tenantService.MemberID(ctx, calendarFeedToken.GetTenantId())
+	if err != nil || memberId != calendarFeedToken.GetMemberId() {
+		err = s.tenantService.CanAccessTenant(ctx, calendarFeedToken.GetTenantId(), true)
+		if err != nil {
+			return nil, fmt.Errorf("failed access tenant: %w", err)
+		}
+	}
+
+	_, err = CalendarFeedToken.DELETE().
+		WHERE(CalendarFeedToken.TokenID.EQ(String(tokenId))).
+		ExecContext(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete calendar feed token: %w", err)
+	}
+
+	return connect.NewResponse(&homecallv1alpha.RevokeCalendarFeedTokenResponse{}), nil
+}
+
+type dbCalendarFeedToken struct {
+	model.CalendarFeedToken
+	model.Tenant
+}
+
+func calendarFeedTokenQuery() SelectStatement {
+	return SELECT(
+		CalendarFeedToken.TokenID,
+		CalendarFeedToken.MemberID,
+		CalendarFeedToken.CreatedAt,
+		CalendarFeedToken.LastUsedAt,
+		Tenant.TenantID,
+	).FROM(
+		CalendarFeedToken.
+			INNER_JOIN(UserTenant, UserTenant.MemberID.EQ(CalendarFeedToken.MemberID)).
+			INNER_JOIN(Tenant, Tenant.ID.EQ(UserTenant.TenantID)),
+	)
+}
+
+func (s *Service) getCalendarFeedToken(ctx context.Context, tokenId string) (*homecallv1alpha.CalendarFeedToken, error) {
+	var dbToken dbCalendarFeedToken
+	err := calendarFeedTokenQuery().
+		WHERE(CalendarFeedToken.TokenID.EQ(String(tokenId))).
+		LIMIT(1).
+		QueryContext(ctx, s.db, &dbToken)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("calendar feed token not found"))
+		}
+		return nil, fmt.Errorf("failed to query calendar feed token: %w", err)
+	}
+	return calendarFeedTokenToProto(dbToken), nil
+}
+
+func calendarFeedTokenToProto(dbToken dbCalendarFeedToken) *homecallv1alpha.CalendarFeedToken {
+	token := &homecallv1alpha.CalendarFeedToken{
+		Id:        dbToken.CalendarFeedToken.TokenID,
+		TenantId:  dbToken.Tenant.TenantID,
+		MemberId:  dbToken.CalendarFeedToken.MemberID,
+		CreatedAt: timestamppb.New(dbToken.CalendarFeedToken.CreatedAt),
+	}
+	if dbToken.CalendarFeedToken.LastUsedAt != nil {
+		token.LastUsedAt = timestamppb.New(*dbToken.CalendarFeedToken.LastUsedAt)
+	}
+	return token
+}
@@ -0,0 +1,395 @@
+package officeapi
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"errors"
+	"fmt"
+	. "github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sidus.io/home-call/gen/connect/homecall/v1alpha"
+	"sidus.io/home-call/gen/jetdb/public/enum"
+	"sidus.io/home-call/gen/jetdb/public/model"
+	. "sidus.io/home-call/gen/jetdb/public/table"
+	"sidus.io/home-call/schedules"
+	"sidus.io/home-call/util"
+	"time"
+)
+
+// CreateCallSchedule creates a recurring call with a device and adds its upcoming occurrences as scheduled calls.
+func (s *Service) CreateCallSchedule(ctx context.Context, req *connect.Request[homecallv1alpha.CreateCallScheduleRequest]) (*connect.Response[homecallv1alpha.CreateCallScheduleResponse], error) {
+	deviceId := req.Msg.GetDeviceId()
+
+	err := s.tenantService.CanAccessDevice(ctx, deviceId, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed access device: %w", err)
+	}
+
+	device, err := s.getDevice(ctx, deviceId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get device: %w", err)
+	}
+	tenantId := device.GetTenantId()
+
+	callerMemberId, err := s.tenantService.MemberID(ctx, tenantId)
+	if err != nil {
+		return nil, fmt.Errorf("failed access tenant: %w", err)
+	}
+
+	startsAt, duration, err := validateScheduledCallTime(req.Msg.GetStartsAt(), req.Msg.GetDurationSeconds())
+	if err != nil {
+		return nil, err
+	}
+
+	startTime, err := validateRecurrence(req.Msg.GetRrule(), startsAt, device.GetTimeZone())
+	if err != nil {
+		return nil, err
+	}
+
+	assignedMemberId, err := s.assignedMemberID(ctx, tenantId, req.Msg.GetAssignedMemberId(), callerMemberId)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduleId := uuid.New().String()
+	err = util.WithTransaction(s.db, func(tx util.DB) error {
+		insertStmt := CallSchedule.INSERT(
+			CallSchedule.ScheduleID,
+			CallSchedule.TenantID,
+			CallSchedule.DeviceID,
+			CallSchedule.AssignedMemberID,
+			CallSchedule.CreatedBy,
+			CallSchedule.Rrule,
+			CallSchedule.StartTime,
+			CallSchedule.DurationSeconds,
+		).VALUES(
+			scheduleId,
+			SELECT(Tenant.ID).FROM(Tenant).WHERE(Tenant.TenantID.EQ(String(tenantId))).LIMIT(1),
+			SELECT(Device.ID).FROM(Device).WHERE(Device.DeviceID.EQ(String(deviceId))).LIMIT(1),
+			assignedMemberId,
+			callerMemberId,
+			req.Msg.GetRrule(),
+			startTime,
+			int32(duration.Seconds()),
+		)
+		_, err := insertStmt.ExecContext(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("failed to insert call schedule: %w", err)
+		}
+
+		return schedules.Expand(ctx, tx, CallSchedule.ScheduleID.EQ(String(scheduleId)), time.Now().UTC())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	callSchedule, err := s.getCallSchedule(ctx, scheduleId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &connect.Response[homecallv1alpha.CreateCallScheduleResponse]{
+		Msg: &homecallv1alpha.CreateCallScheduleResponse{
+			CallSchedule: callSchedule,
+		},
+	}, nil
+}
+
+// ListCallSchedules returns the call schedules of a tenant,
+// members scoped to device groups only see the schedules of their devices.
+func (s *Service) ListCallSchedules(ctx context.Context, req *connect.Request[homecallv1alpha.ListCallSchedulesRequest]) (*connect.Response[homecallv1alpha.ListCallSchedulesResponse], error) {
+	tenantId := req.Msg.GetTenantId()
+
+	err := s.tenantService.CanAccessTenant(ctx, tenantId, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed access tenant: %w", err)
+	}
+
+	conditions := Tenant.TenantID.EQ(String(tenantId))
+	if req.Msg.GetDeviceId() != "" {
+		conditions = conditions.AND(Device.DeviceID.EQ(String(req.Msg.GetDeviceId())))
+	}
+
+	scopeCondition, err := s.deviceScopeCondition(ctx, tenantId)
+	if err != nil {
+		return nil, err
+	}
+	if scopeCondition != nil {
+		conditions = conditions.AND(scopeCondition)
+	}
+
+	var dbCallSchedules []dbCallSchedule
+	err = callScheduleQuery().WHERE(conditions).
+		ORDER_BY(CallSchedule.CreatedAt.ASC(), CallSchedule.ID.ASC()).
+		QueryContext(ctx, s.db, &dbCallSchedules)
+	if err != nil && !errors.Is(err, qrm.ErrNoRows) {
+		return nil, fmt.Errorf("failed to query call schedules: %w", err)
+	}
+
+	callSchedules := make([]*homecallv1alpha.CallSchedule, len(dbCallSchedules))
+	for i, dbCallSchedule := range dbCallSchedules {
+		callSchedules[i], err = callScheduleToProto(dbCallSchedule)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &connect.Response[homecallv1alpha.ListCallSchedulesResponse]{
+		Msg: &homecallv1alpha.ListCallSchedulesResponse{
+			CallSchedules: callSchedules,
+		},
+	}, nil
+}
+
+// UpdateCallSchedule changes a call schedule and replaces its upcoming occurrences.
+// Occurrences that were already reminded or cancelled are kept.
+func (s *Service) UpdateCallSchedule(ctx context.Context, req *connect.Request[homecallv1alpha.UpdateCallScheduleRequest]) (*connect.Response[homecallv1alpha.UpdateCallScheduleResponse], error) {
+	scheduleId := req.Msg.GetScheduleId()
+
+	current, err := s.accessCallSchedule(ctx, scheduleId)
+	if err != nil {
+		return nil, err
+	}
+
+	callerMemberId, err := s.tenantService.MemberID(ctx, current.GetTenantId())
+	if err != nil {
+		return nil, fmt.Errorf("failed access tenant: %w", err)
+	}
+
+	startsAt, duration, err := validateScheduledCallTime(req.Msg.GetStartsAt(), req.Msg.GetDurationSeconds())
+	if err != nil {
+		return nil, err
+	}
+
+	startTime, err := validateRecurrence(req.Msg.GetRrule(), startsAt, current.GetTimeZone())
+	if err != nil {
+		return nil, err
+	}
+
+	assignedMemberId, err := s.assignedMemberID(ctx, current.GetTenantId(), req.Msg.GetAssignedMemberId(), callerMemberId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = util.WithTransaction(s.db, func(tx util.DB) error {
+		condition := CallSchedule.ScheduleID.EQ(String(scheduleId))
+		_, err := CallSchedule.UPDATE(
+			CallSchedule.Rrule,
+			CallSchedule.StartTime,
+			CallSchedule.DurationSeconds,
+			CallSchedule.AssignedMemberID,
+		).SET(
+			req.Msg.GetRrule(),
+			startTime,
+			int32(duration.Seconds()),
+			assignedMemberId,
+		).WHERE(condition).ExecContext(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("failed to update call schedule: %w", err)
+		}
+
+		now := time.Now().UTC()
+		err = schedules.Reset(ctx, tx, condition, now)
+		if err != nil {
+			return err
+		}
+		return schedules.Expand(ctx, tx, condition, now)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	callSchedule, err := s.getCallSchedule(ctx, scheduleId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &connect.Response[homecallv1alpha.UpdateCallScheduleResponse]{
+		Msg: &homecallv1alpha.UpdateCallScheduleResponse{
+			CallSchedule: callSchedule,
+		},
+	}, nil
+}
+
+// RemoveCallSchedule removes a call schedule and its upcoming occurrences, past occurrences are kept.
+func (s *Service) RemoveCallSchedule(ctx context.Context, req *connect.Request[homecallv1alpha.RemoveCallScheduleRequest]) (*connect.Response[homecallv1alpha.RemoveCallScheduleResponse], error) {
+	scheduleId := req.Msg.GetScheduleId()
+
+	_, err := s.accessCallSchedule(ctx, scheduleId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = util.WithTransaction(s.db, func(tx util.DB) error {
+		condition := CallSchedule.ScheduleID.EQ(String(scheduleId))
+		err := schedules.Reset(ctx, tx, condition, time.Now().UTC())
+		if err != nil {
+			return err
+		}
+
+		_, err = CallSchedule.DELETE().WHERE(condition).ExecContext(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("failed to delete call schedule: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &connect.Response[homecallv1alpha.RemoveCallScheduleResponse]{
+		Msg: &homecallv1alpha.RemoveCallScheduleResponse{},
+	}, nil
+}
+
+// SkipCallScheduleOccurrence skips a single occurrence of a call schedule,
+// cancelling it if it was already added as a scheduled call.
+func (s *Service) SkipCallScheduleOccurrence(ctx context.Context, req *connect.Request[homecallv1alpha.SkipCallScheduleOccurrenceRequest]) (*connect.Response[homecallv1alpha.SkipCallScheduleOccurrenceResponse], error) {
+	scheduleId := req.Msg.GetScheduleId()
+
+	_, err := s.accessCallSchedule(ctx, scheduleId)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.GetStartsAt() == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("start time is required"))
+	}
+	startsAt := req.Msg.GetStartsAt().AsTime()
+
+	var dbCallSchedule dbCallSchedule
+	err = callScheduleQuery().WHERE(CallSchedule.ScheduleID.EQ(String(scheduleId))).LIMIT(1).QueryContext(ctx, s.db, &dbCallSchedule)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query call schedule: %w", err)
+	}
+	recurrence, err := schedules.LoadRecurrence(ctx, s.db, dbCallSchedule.CallSchedule, dbCallSchedule.Device.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+	occurrences := recurrence.Between(startsAt, startsAt.Add(time.Second))
+	if len(occurrences) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("not an upcoming occurrence of the call schedule"))
+	}
+	occurrenceStart := occurrences[0].Start
+
+	err = util.WithTransaction(s.db, func(tx util.DB) error {
+		_, err := CallScheduleException.INSERT(
+			CallScheduleException.ScheduleID,
+			CallScheduleException.OccurrenceStart,
+		).VALUES(
+			dbCallSchedule.CallSchedule.ID,
+			occurrenceStart,
+		).ON_CONFLICT(CallScheduleException.ScheduleID, CallScheduleException.OccurrenceStart).DO_NOTHING().
+			ExecContext(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("failed to insert call schedule exception: %w", err)
+		}
+
+		_, err = ScheduledCall.UPDATE(ScheduledCall.Status).SET(enum.ScheduledCallStatus.Cancelled).WHERE(
+			ScheduledCall.ScheduleID.EQ(Int32(dbCallSchedule.CallSchedule.ID)).
+				AND(ScheduledCall.OccurrenceStart.EQ(TimestampT(occurrenceStart))).
+				AND(ScheduledCall.Status.EQ(enum.ScheduledCallStatus.Scheduled)),
+		).ExecContext(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("failed to cancel occurrence: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &connect.Response[homecallv1alpha.SkipCallScheduleOccurrenceResponse]{
+		Msg: &homecallv1alpha.SkipCallScheduleOccurrenceResponse{},
+	}, nil
+}
+
+// validateRecurrence checks a recurrence rule and returns the wall clock time of the first occurrence
+// in the time zone of the device.
+func validateRecurrence(rule string, startsAt time.Time, timeZone string) (time.Time, error) {
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to load time zone of device: %w", err)
+	}
+	startTime := schedules.InLocation(startsAt, location)
+
+	_, err = schedules.NewRecurrence(rule, startTime, location, nil)
+	if err != nil {
+		return time.Time{}, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return startTime, nil
+}
+
+// accessCallSchedule returns a call schedule if the caller can access its device.
+func (s *Service) accessCallSchedule(ctx context.Context, scheduleId string) (*homecallv1alpha.CallSchedule, error) {
+	callSchedule, err := s.getCallSchedule(ctx, scheduleId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.tenantService.CanAccessDevice(ctx, callSchedule.GetDeviceId(), false)
+	if err != nil {
+		return nil, fmt.Errorf("failed access device: %w", err)
+	}
+	return callSchedule, nil
+}
+
+type dbCallSchedule struct {
+	model.CallSchedule
+	model.Tenant
+	model.Device
+}
+
+func callScheduleQuery() SelectStatement {
+	return SELECT(
+		CallSchedule.AllColumns,
+		Tenant.TenantID,
+		Device.DeviceID,
+		Device.TimeZone,
+	).FROM(
+		CallSchedule.
+			INNER_JOIN(Tenant, Tenant.ID.EQ(CallSchedule.TenantID)).
+			INNER_JOIN(Device, Device.ID.EQ(CallSchedule.DeviceID)),
+	)
+}
+
+func (s *Service) getCallSchedule(ctx context.Context, scheduleId string) (*homecallv1alpha.CallSchedule, error) {
+	var dbCallSchedule dbCallSchedule
+	err := callScheduleQuery().
+		WHERE(CallSchedule.ScheduleID.EQ(String(scheduleId))).
+		LIMIT(1).QueryContext(ctx, s.db, &dbCallSchedule)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("call schedule not found"))
+		}
+		return nil, fmt.Errorf("failed to query call schedule: %w", err)
+	}
+	return callScheduleToProto(dbCallSchedule)
+}
+
+func callScheduleToProto(dbCallSchedule dbCallSchedule) (*homecallv1alpha.CallSchedule, error) {
+	location, err := time.LoadLocation(dbCallSchedule.Device.TimeZone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load time zone of device: %w", err)
+	}
+
+	callSchedule := &homecallv1alpha.CallSchedule{
+		Id:              dbCallSchedule.CallSchedule.ScheduleID,
+		TenantId:        dbCallSchedule.Tenant.TenantID,
+		DeviceId:        dbCallSchedule.Device.DeviceID,
+		Rrule:           dbCallSchedule.CallSchedule.Rrule,
+		StartsAt:        timestamppb.New(schedules.Instant(dbCallSchedule.CallSchedule.StartTime, location)),
+		DurationSeconds: int64(dbCallSchedule.CallSchedule.DurationSeconds),
+		TimeZone:        dbCallSchedule.Device.TimeZone,
+		CreatedAt:       timestamppb.New(dbCallSchedule.CallSchedule.CreatedAt),
+	}
+	if dbCallSchedule.CallSchedule.AssignedMemberID != nil {
+		callSchedule.AssignedMemberId = *dbCallSchedule.CallSchedule.AssignedMemberID
+	}
+	if dbCallSchedule.CallSchedule.CreatedBy != nil {
+		callSchedule.CreatedByMemberId = *dbCallSchedule.CallSchedule.CreatedBy
+	}
+	return callSchedule, nil
+}
@@ -0,0 +1,931 @@
+package officeapi
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"errors"
+	fm "firebase.google.com/go/v4/messaging"
+	"fmt"
+	. "github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sidus.io/home-call/calls"
+	"sidus.io/home-call/gen/connect/homecall/v1alpha"
+	"sidus.io/home-call/gen/jetdb/public/enum"
+	"sidus.io/home-call/gen/jetdb/public/model"
+	. "sidus.io/home-call/gen/jetdb/public/table"
+	"sidus.io/home-call/messaging"
+	"sidus.io/home-call/services/auth"
+	"sidus.io/home-call/services/tenantapi"
+	"sidus.io/home-call/util"
+	"strconv"
+	"time"
+)
+
+// maxCallParticipants limits the number of devices and members in a call, including the caller.
+const maxCallParticipants = 10
+
+// StartCall starts a call with one or more devices, other members of the tenant can be invited.
+// Every participant gets its own token for the shared room.
+func (s *Service) StartCall(ctx context.Context, req *connect.Request[homecallv1alpha.StartCallRequest]) (*connect.Response[homecallv1alpha.StartCallResponse], error) {
+	authDetails := auth.GetAuth(ctx)
+	if authDetails == nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("unauthenticated"))
+	}
+
+	deviceIds := uniqueIds(append([]string{req.Msg.GetDeviceId()}, req.Msg.GetDeviceIds()...))
+	if len(deviceIds) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("at least one device is required"))
+	}
+
+	now := time.Now().UTC()
+	var tenantId string
+	devices := make([]*homecallv1alpha.Device, 0, len(deviceIds))
+	// Disabled devices are left out of the call, it is only refused if no device is left
+	var disabledDeviceIds []string
+	var disabledErr error
+	quietHoursOverridden := make(map[string]bool)
+	inQuietHoursByDevice := make(map[string]bool)
+	for _, deviceId := range deviceIds {
+		err := s.tenantService.CanAccessDevice(ctx, deviceId, false)
+		if err != nil {
+			return nil, fmt.Errorf("failed access device: %w", err)
+		}
+
+		device, err := s.getDevice(ctx, deviceId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get device: %w", err)
+		}
+
+		if tenantId == "" {
+			tenantId = device.GetTenantId()
+		} else if device.GetTenantId() != tenantId {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("devices must belong to the same tenant"))
+		}
+
+		if device.GetDisabled() {
+			if disabledErr == nil {
+				disabledErr = auth.NewDeviceDisabledError(connect.CodeFailedPrecondition, device.GetId(), device.GetDisabledAt().AsTime())
+			}
+			disabledDeviceIds = append(disabledDeviceIds, device.GetId())
+			continue
+		}
+
+		if !device.GetEnrolled() {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("device is not enrolled"))
+		}
+
+		if !device.Online {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("device is offline"))
+		}
+
+		inQuietHours, err := calls.InQuietHours(device.GetQuietHours(), now)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check quiet hours: %w", err)
+		}
+		if inQuietHours {
+			if !req.Msg.GetOverrideQuietHours() {
+				return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("device %s is in its quiet hours", device.GetName()))
+			}
+			quietHoursOverridden[device.GetId()] = true
+		}
+		inQuietHoursByDevice[device.GetId()] = inQuietHours
+
+		devices = append(devices, device)
+	}
+	if len(devices) == 0 {
+		return nil, disabledErr
+	}
+
+	callerMemberId, err := s.tenantService.MemberID(ctx, tenantId)
+	if err != nil {
+		return nil, fmt.Errorf("failed access tenant: %w", err)
+	}
+
+	autoAnswerDelays := make(map[string]*int32, len(devices))
+	for _, device := range devices {
+		err = s.canCallDevice(ctx, device, callerMemberId)
+		if err != nil {
+			return nil, err
+		}
+
+		autoAnswerDelays[device.GetId()], err = s.autoAnswerDelay(ctx, device, callerMemberId, inQuietHoursByDevice[device.GetId()], now)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var memberIds []string
+	for _, memberId := range uniqueIds(req.Msg.GetMemberIds()) {
+		if memberId != callerMemberId {
+			memberIds = append(memberIds, memberId)
+		}
+	}
+	if len(devices)+len(memberIds)+1 > maxCallParticipants {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("a call can have at most %d participants", maxCallParticipants))
+	}
+
+	memberNames, err := s.memberDisplayNames(ctx, tenantId, memberIds)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create jitsi room
+	jitsiCall, err := s.jitsiApp.NewCall()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create call: %w", err)
+	}
+
+	callId := uuid.New().String()
+
+	// The caller joins right away, everyone else is invited
+	callerParticipantId := uuid.New().String()
+	callerToken, err := jitsiCall.OfficeJWT(callerParticipantId, authDetails.DisplayName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create office token: %w", err)
+	}
+	participants := []newCallParticipant{{
+		participantId: callerParticipantId,
+		memberId:      callerMemberId,
+		displayName:   authDetails.DisplayName,
+		jitsiJwt:      callerToken,
+	}}
+
+	for _, device := range devices {
+		participantId := uuid.New().String()
+		token, err := jitsiCall.DeviceJWT(participantId, device.GetName())
+		if err != nil {
+			return nil, fmt.Errorf("failed to create device token: %w", err)
+		}
+		participants = append(participants, newCallParticipant{
+			participantId:        participantId,
+			deviceId:             device.GetId(),
+			displayName:          device.GetName(),
+			jitsiJwt:             token,
+			quietHoursOverridden: quietHoursOverridden[device.GetId()],
+			autoAnswerDelay:      autoAnswerDelays[device.GetId()],
+		})
+	}
+
+	for _, memberId := range memberIds {
+		participantId := uuid.New().String()
+		token, err := jitsiCall.OfficeJWT(participantId, memberNames[memberId])
+		if err != nil {
+			return nil, fmt.Errorf("failed to create office token: %w", err)
+		}
+		participants = append(participants, newCallParticipant{
+			participantId: participantId,
+			memberId:      memberId,
+			displayName:   memberNames[memberId],
+			jitsiJwt:      token,
+		})
+	}
+
+	err = util.WithTransaction(s.db, func(tx util.DB) error {
+		insertCallStmt := Call.
+			INSERT(
+				Call.CallID,
+				Call.TenantID,
+				Call.JitsiRoomID,
+				Call.CreatedBy,
+			).
+			VALUES(
+				String(callId),
+				SELECT(Tenant.ID).FROM(Tenant).WHERE(Tenant.TenantID.EQ(String(tenantId))).LIMIT(1),
+				String(jitsiCall.RoomName()),
+				String(callerMemberId),
+			)
+		_, err := insertCallStmt.ExecContext(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("failed to insert call: %w", err)
+		}
+
+		for i, participant := range participants {
+			var deviceId, memberId Expression = NULL, NULL
+			if participant.deviceId != "" {
+				deviceId = SELECT(Device.ID).FROM(Device).WHERE(Device.DeviceID.EQ(String(participant.deviceId)))
+			} else {
+				memberId = String(participant.memberId)
+			}
+			state, notifiedAt, joinedAt := model.CallParticipantState_Invited, Expression(TimestampT(now)), Expression(NULL)
+			var autoAnswerDelay Expression = NULL
+			if participant.autoAnswerDelay != nil {
+				autoAnswerDelay = Int32(*participant.autoAnswerDelay)
+			}
+			invitedBy := callerMemberId
+			if i == 0 {
+				state, notifiedAt, joinedAt = model.CallParticipantState_Joined, NULL, TimestampT(now)
+				invitedBy = ""
+			}
+
+			insertParticipantStmt := CallParticipant.
+				INSERT(
+					CallParticipant.ParticipantID,
+					CallParticipant.CallID,
+					CallParticipant.DeviceID,
+					CallParticipant.MemberID,
+					CallParticipant.DisplayName,
+					CallParticipant.JitsiJwt,
+					CallParticipant.State,
+					CallParticipant.NotifiedAt,
+					CallParticipant.JoinedAt,
+					CallParticipant.QuietHoursOverridden,
+					CallParticipant.AutoAnswerDelaySeconds,
+				).
+				VALUES(
+					String(participant.participantId),
+					SELECT(Call.ID).FROM(Call).WHERE(Call.CallID.EQ(String(callId))),
+					deviceId,
+					memberId,
+					String(participant.displayName),
+					String(participant.jitsiJwt),
+					NewEnumValue(state.String()),
+					notifiedAt,
+					joinedAt,
+					Bool(participant.quietHoursOverridden),
+					autoAnswerDelay,
+				)
+			_, err = insertParticipantStmt.ExecContext(ctx, tx)
+			if err != nil {
+				return fmt.Errorf("failed to insert call participant: %w", err)
+			}
+
+			err = calls.RecordParticipantEvent(ctx, tx, participant.participantId, state, invitedBy)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Devices are rung once the call exists, a device that can not be reached does not stop the others
+	var unreachableDeviceIds []string
+	for _, device := range devices {
+		notificationToken, err := s.deviceNotificationToken(ctx, s.db, device.GetId())
+		if err == nil {
+			err = s.sendCallNotification(ctx, notificationToken, callId, autoAnswerDelays[device.GetId()])
+		}
+		if err != nil {
+			s.logger.Warn("failed to notify device about call", "error", err, "call_id", callId, "device_id", device.GetId())
+			unreachableDeviceIds = append(unreachableDeviceIds, device.GetId())
+		}
+	}
+
+	for _, memberId := range memberIds {
+		err := s.broker.PublishCallInvitation(messaging.CallInvitation{
+			CallID:   callId,
+			TenantID: tenantId,
+			MemberID: memberId,
+		})
+		if err != nil {
+			s.logger.Error("failed to publish call invitation", "error", err, "call_id", callId, "member_id", memberId)
+		}
+	}
+
+	call, err := s.getCall(ctx, callId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &connect.Response[homecallv1alpha.StartCallResponse]{
+		Msg: &homecallv1alpha.StartCallResponse{
+			CallId:               callId,
+			JitsiJwt:             callerToken,
+			JitsiRoomId:          jitsiCall.RoomName(),
+			Call:                 call,
+			UnreachableDeviceIds: unreachableDeviceIds,
+			DisabledDeviceIds:    disabledDeviceIds,
+		},
+	}, nil
+}
+
+// newCallParticipant is a participant of a call that is being started, either deviceId or memberId is set.
+type newCallParticipant struct {
+	participantId string
+	deviceId      string
+	memberId      string
+	displayName   string
+	jitsiJwt      string
+	// quietHoursOverridden is set for devices that are called during their quiet hours.
+	quietHoursOverridden bool
+	// autoAnswerDelay is the number of seconds after which a device answers by itself, nil if it does not.
+	autoAnswerDelay *int32
+}
+
+// autoAnswerDelay decides whether a device answers a call from a member by itself.
+// It returns the number of seconds the device rings first, or nil if the call must be answered by hand.
+func (s *Service) autoAnswerDelay(ctx context.Context, device *homecallv1alpha.Device, callerMemberId string, inQuietHours bool, now time.Time) (*int32, error) {
+	policy := device.GetAutoAnswer()
+	if !policy.GetEnabled() || inQuietHours {
+		return nil, nil
+	}
+
+	inHours, err := calls.AutoAnswerHours(policy, device.GetTimeZone(), now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check auto-answer hours: %w", err)
+	}
+	if !inHours {
+		return nil, nil
+	}
+
+	if policy.GetAllowedCallersOnly() {
+		var dbDevice model.Device
+		err = SELECT(Device.ID).FROM(Device).
+			WHERE(Device.DeviceID.EQ(String(device.GetId())).AND(isAllowedCaller(callerMemberId))).
+			LIMIT(1).QueryContext(ctx, s.db, &dbDevice)
+		if err != nil {
+			if errors.Is(err, qrm.ErrNoRows) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("failed to query allowed callers: %w", err)
+		}
+	}
+
+	delay := int32(policy.GetDelaySeconds())
+	return &delay, nil
+}
+
+// memberDisplayNames returns the display names of members of a tenant by member ID.
+func (s *Service) memberDisplayNames(ctx context.Context, tenantId string, memberIds []string) (map[string]string, error) {
+	names := make(map[string]string, len(memberIds))
+	if len(memberIds) == 0 {
+		return names, nil
+	}
+
+	memberIdExpressions := make([]Expression, len(memberIds))
+	for i, memberId := range memberIds {
+		memberIdExpressions[i] = String(memberId)
+	}
+
+	var dbMembers []struct {
+		model.UserTenant
+		model.User
+	}
+	err := SELECT(
+		UserTenant.MemberID,
+		User.DisplayName,
+		User.Email,
+	).FROM(
+		UserTenant.
+			INNER_JOIN(User, User.ID.EQ(UserTenant.UserID)).
+			INNER_JOIN(Tenant, Tenant.ID.EQ(UserTenant.TenantID)),
+	).WHERE(
+		Tenant.TenantID.EQ(String(tenantId)).
+			AND(UserTenant.MemberID.IN(memberIdExpressions...)),
+	).QueryContext(ctx, s.db, &dbMembers)
+	if err != nil && !errors.Is(err, qrm.ErrNoRows) {
+		return nil, fmt.Errorf("failed to query tenant members: %w", err)
+	}
+
+	for _, dbMember := range dbMembers {
+		name := dbMember.User.DisplayName
+		if name == "" {
+			name = dbMember.User.Email
+		}
+		names[dbMember.UserTenant.MemberID] = name
+	}
+	if len(names) != len(memberIds) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("unknown tenant member"))
+	}
+	return names, nil
+}
+
+func (s *Service) sendCallNotification(ctx context.Context, notificationToken string, callId string, autoAnswerDelay *int32) error {
+	data := map[string]string{
+		"callId":     callId,
+		"type":       "call",
+		"autoAnswer": strconv.FormatBool(autoAnswerDelay != nil),
+	}
+	if autoAnswerDelay != nil {
+		data["autoAnswerDelaySeconds"] = strconv.Itoa(int(*autoAnswerDelay))
+	}
+	err := s.notificationService.SendNotification(ctx, &fm.Message{
+		Token: notificationToken,
+		Data:  data,
+		Notification: &fm.Notification{
+			Title: "Inkommande samtal",
+			Body:  "Du har ett inkommande samtal, klicka här för att svara",
+		},
+		Android: &fm.AndroidConfig{
+			// Required for background/quit data-only messages on Android
+			Priority: "high",
+		},
+		APNS: &fm.APNSConfig{
+			Payload: &fm.APNSPayload{
+				Aps: &fm.Aps{
+					// Required for background/quit data-only messages on iOS
+					ContentAvailable: true,
+				},
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send notification: %w", err)
+	}
+	return nil
+}
+
+// GetCall returns a call and the join state of its participants.
+func (s *Service) GetCall(ctx context.Context, req *connect.Request[homecallv1alpha.GetCallRequest]) (*connect.Response[homecallv1alpha.GetCallResponse], error) {
+	call, err := s.getCall(ctx, req.Msg.GetCallId())
+	if err != nil {
+		return nil, err
+	}
+
+	// Callers without access get the same error as for a call that does not exist,
+	// so call IDs can not be probed
+	notFound := connect.NewError(connect.CodeNotFound, errors.New("call not found"))
+	err = s.tenantService.CanAccessTenant(ctx, call.GetTenantId(), false)
+	if err != nil {
+		if errors.Is(err, tenantapi.ErrNoAccess) {
+			return nil, notFound
+		}
+		return nil, fmt.Errorf("failed access tenant: %w", err)
+	}
+
+	err = s.canAccessCallDevices(ctx, call)
+	if err != nil {
+		if errors.Is(err, tenantapi.ErrNoAccess) {
+			return nil, notFound
+		}
+		return nil, err
+	}
+
+	return &connect.Response[homecallv1alpha.GetCallResponse]{
+		Msg: &homecallv1alpha.GetCallResponse{
+			Call: call,
+		},
+	}, nil
+}
+
+// canAccessCallDevices checks that a caller scoped to device groups can access every device in a call,
+// unless the caller takes part in the call.
+func (s *Service) canAccessCallDevices(ctx context.Context, call *homecallv1alpha.Call) error {
+	scopeCondition, err := s.deviceScopeCondition(ctx, call.GetTenantId())
+	if err != nil {
+		return err
+	}
+	if scopeCondition == nil {
+		return nil
+	}
+
+	memberId, err := s.tenantService.MemberID(ctx, call.GetTenantId())
+	if err != nil {
+		return fmt.Errorf("failed access tenant: %w", err)
+	}
+	for _, participant := range call.GetParticipants() {
+		if participant.GetMemberId() == memberId {
+			return nil
+		}
+	}
+
+	for _, participant := range call.GetParticipants() {
+		if participant.GetDeviceId() == "" {
+			continue
+		}
+		inScope, err := s.deviceInScope(ctx, participant.GetDeviceId(), scopeCondition)
+		if err != nil {
+			return err
+		}
+		if !inScope {
+			return fmt.Errorf("failed access device: %w", tenantapi.ErrNoAccess)
+		}
+	}
+	return nil
+}
+
+// InviteToCall invites another member of the tenant into an ongoing call, with its own token for the room.
+// Members that left or declined the call can be invited again.
+func (s *Service) InviteToCall(ctx context.Context, req *connect.Request[homecallv1alpha.InviteToCallRequest]) (*connect.Response[homecallv1alpha.InviteToCallResponse], error) {
+	callId := req.Msg.GetCallId()
+	memberId := req.Msg.GetMemberId()
+
+	callerMemberId, err := s.callMemberID(ctx, callId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only participants can invite others
+	var dbCall struct {
+		model.Call
+		model.Tenant
+	}
+	err = SELECT(
+		Call.ID,
+		Call.JitsiRoomID,
+		Tenant.TenantID,
+	).FROM(
+		Call.
+			INNER_JOIN(Tenant, Tenant.ID.EQ(Call.TenantID)).
+			INNER_JOIN(CallParticipant, CallParticipant.CallID.EQ(Call.ID)),
+	).WHERE(
+		Call.CallID.EQ(String(callId)).
+			AND(CallParticipant.MemberID.EQ(String(callerMemberId))).
+			AND(calls.Joinable()),
+	).LIMIT(1).QueryContext(ctx, s.db, &dbCall)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("call not found"))
+		}
+		return nil, fmt.Errorf("failed to query call: %w", err)
+	}
+	tenantId := dbCall.Tenant.TenantID
+
+	memberNames, err := s.memberDisplayNames(ctx, tenantId, []string{memberId})
+	if err != nil {
+		return nil, err
+	}
+
+	var dbParticipants []model.CallParticipant
+	err = SELECT(
+		CallParticipant.ParticipantID,
+		CallParticipant.MemberID,
+		CallParticipant.State,
+	).FROM(CallParticipant).
+		WHERE(CallParticipant.CallID.EQ(Int32(dbCall.Call.ID))).
+		QueryContext(ctx, s.db, &dbParticipants)
+	if err != nil && !errors.Is(err, qrm.ErrNoRows) {
+		return nil, fmt.Errorf("failed to query call participants: %w", err)
+	}
+
+	var existing *model.CallParticipant
+	for i, dbParticipant := range dbParticipants {
+		if dbParticipant.MemberID != nil && *dbParticipant.MemberID == memberId {
+			existing = &dbParticipants[i]
+		}
+	}
+	if existing == nil && len(dbParticipants) >= maxCallParticipants {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("a call can have at most %d participants", maxCallParticipants))
+	}
+	if existing != nil && (existing.State == model.CallParticipantState_Invited || existing.State == model.CallParticipantState_Joined) {
+		return nil, connect.NewError(connect.CodeAlreadyExists, errors.New("member is already invited to the call"))
+	}
+
+	// The invited member gets its own token for the room of the call
+	jitsiCall, err := s.jitsiApp.ExistingCall(dbCall.Call.JitsiRoomID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get call: %w", err)
+	}
+
+	participantId := uuid.New().String()
+	if existing != nil {
+		participantId = existing.ParticipantID
+	}
+	token, err := jitsiCall.OfficeJWT(participantId, memberNames[memberId])
+	if err != nil {
+		return nil, fmt.Errorf("failed to create office token: %w", err)
+	}
+
+	now := TimestampT(time.Now().UTC())
+	err = util.WithTransaction(s.db, func(tx util.DB) error {
+		if existing != nil {
+			_, err := CallParticipant.UPDATE().SET(
+				CallParticipant.State.SET(enum.CallParticipantState.Invited),
+				CallParticipant.JitsiJwt.SET(String(token)),
+				CallParticipant.NotifiedAt.SET(now),
+			).WHERE(CallParticipant.ParticipantID.EQ(String(participantId))).ExecContext(ctx, tx)
+			if err != nil {
+				return fmt.Errorf("failed to update call participant: %w", err)
+			}
+		} else {
+			_, err := CallParticipant.INSERT(
+				CallParticipant.ParticipantID,
+				CallParticipant.CallID,
+				CallParticipant.MemberID,
+				CallParticipant.DisplayName,
+				CallParticipant.JitsiJwt,
+				CallParticipant.State,
+				CallParticipant.NotifiedAt,
+			).VALUES(
+				String(participantId),
+				Int32(dbCall.Call.ID),
+				String(memberId),
+				String(memberNames[memberId]),
+				String(token),
+				enum.CallParticipantState.Invited,
+				now,
+			).ExecContext(ctx, tx)
+			if err != nil {
+				return fmt.Errorf("failed to insert call participant: %w", err)
+			}
+		}
+
+		return calls.RecordParticipantEvent(ctx, tx, participantId, model.CallParticipantState_Invited, callerMemberId)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.broker.PublishCallInvitation(messaging.CallInvitation{
+		CallID:   callId,
+		TenantID: tenantId,
+		MemberID: memberId,
+	})
+	if err != nil {
+		s.logger.Error("failed to publish call invitation", "error", err, "call_id", callId, "member_id", memberId)
+	}
+
+	call, err := s.getCall(ctx, callId)
+	if err != nil {
+		return nil, err
+	}
+	var participant *homecallv1alpha.CallParticipant
+	for _, callParticipant := range call.GetParticipants() {
+		if callParticipant.GetId() == participantId {
+			participant = callParticipant
+		}
+	}
+
+	return &connect.Response[homecallv1alpha.InviteToCallResponse]{
+		Msg: &homecallv1alpha.InviteToCallResponse{
+			Participant: participant,
+		},
+	}, nil
+}
+
+// JoinCall returns the token of the caller for a call it participates in.
+func (s *Service) JoinCall(ctx context.Context, req *connect.Request[homecallv1alpha.JoinCallRequest]) (*connect.Response[homecallv1alpha.JoinCallResponse], error) {
+	callId := req.Msg.GetCallId()
+
+	memberId, err := s.callMemberID(ctx, callId)
+	if err != nil {
+		return nil, err
+	}
+
+	var participant struct {
+		model.Call
+		model.CallParticipant
+	}
+	err = SELECT(
+		Call.JitsiRoomID,
+		CallParticipant.JitsiJwt,
+	).FROM(
+		CallParticipant.INNER_JOIN(Call, Call.ID.EQ(CallParticipant.CallID)),
+	).WHERE(
+		Call.CallID.EQ(String(callId)).
+			AND(CallParticipant.MemberID.EQ(String(memberId))).
+			AND(calls.Joinable()),
+	).LIMIT(1).QueryContext(ctx, s.db, &participant)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("call not found"))
+		}
+		return nil, fmt.Errorf("failed to query call participant: %w", err)
+	}
+
+	return &connect.Response[homecallv1alpha.JoinCallResponse]{
+		Msg: &homecallv1alpha.JoinCallResponse{
+			CallId:      callId,
+			JitsiRoomId: participant.Call.JitsiRoomID,
+			JitsiJwt:    participant.CallParticipant.JitsiJwt,
+		},
+	}, nil
+}
+
+// UpdateCallState updates the join state of the caller in a call.
+func (s *Service) UpdateCallState(ctx context.Context, req *connect.Request[homecallv1alpha.UpdateCallStateRequest]) (*connect.Response[homecallv1alpha.UpdateCallStateResponse], error) {
+	memberId, err := s.callMemberID(ctx, req.Msg.GetCallId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = calls.UpdateParticipantState(ctx, s.db, req.Msg.GetCallId(), CallParticipant.MemberID.EQ(String(memberId)), req.Msg.GetState())
+	if err != nil {
+		return nil, err
+	}
+
+	return &connect.Response[homecallv1alpha.UpdateCallStateResponse]{
+		Msg: &homecallv1alpha.UpdateCallStateResponse{},
+	}, nil
+}
+
+// WatchCallInvitations streams the calls of a tenant the caller is invited to,
+// starting with the recent invitations it has not responded to.
+func (s *Service) WatchCallInvitations(ctx context.Context, req *connect.Request[homecallv1alpha.WatchCallInvitationsRequest], stream *connect.ServerStream[homecallv1alpha.WatchCallInvitationsResponse]) error {
+	tenantId := req.Msg.GetTenantId()
+
+	memberId, err := s.tenantService.MemberID(ctx, tenantId)
+	if err != nil {
+		return fmt.Errorf("failed access tenant: %w", err)
+	}
+
+	// Subscribe before looking up pending invitations so that none are missed in between
+	invitations, err := s.broker.SubscribeToCallInvitations(ctx, tenantId, memberId)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to call invitations: %w", err)
+	}
+
+	var pending []model.Call
+	err = SELECT(Call.CallID).FROM(
+		Call.INNER_JOIN(CallParticipant, CallParticipant.CallID.EQ(Call.ID)),
+	).WHERE(
+		CallParticipant.MemberID.EQ(String(memberId)).
+			AND(CallParticipant.State.EQ(enum.CallParticipantState.Invited)).
+			AND(calls.Joinable()),
+	).ORDER_BY(Call.CreatedAt.ASC()).QueryContext(ctx, s.db, &pending)
+	if err != nil && !errors.Is(err, qrm.ErrNoRows) {
+		return fmt.Errorf("failed to query pending call invitations: %w", err)
+	}
+
+	sent := make(map[string]bool)
+	sendInvitation := func(callId string) error {
+		if sent[callId] {
+			return nil
+		}
+		sent[callId] = true
+
+		call, err := s.getCall(ctx, callId)
+		if err != nil {
+			if connect.CodeOf(err) == connect.CodeNotFound {
+				return nil
+			}
+			return err
+		}
+
+		err = stream.Send(&homecallv1alpha.WatchCallInvitationsResponse{
+			Call: call,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to send call invitation to client: %w", err)
+		}
+		return nil
+	}
+
+	for _, call := range pending {
+		err := sendInvitation(call.CallID)
+		if err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case invitation, ok := <-invitations:
+			if !ok {
+				return nil
+			}
+			err := sendInvitation(invitation.CallID)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// callMemberID returns the member ID of the caller in the tenant of a call.
+func (s *Service) callMemberID(ctx context.Context, callId string) (string, error) {
+	var dbCall struct {
+		model.Tenant
+	}
+	err := SELECT(Tenant.TenantID).FROM(
+		Call.INNER_JOIN(Tenant, Tenant.ID.EQ(Call.TenantID)),
+	).WHERE(Call.CallID.EQ(String(callId))).LIMIT(1).QueryContext(ctx, s.db, &dbCall)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return "", connect.NewError(connect.CodeNotFound, errors.New("call not found"))
+		}
+		return "", fmt.Errorf("failed to query call: %w", err)
+	}
+
+	memberId, err := s.tenantService.MemberID(ctx, dbCall.Tenant.TenantID)
+	if err != nil {
+		return "", fmt.Errorf("failed access tenant: %w", err)
+	}
+	return memberId, nil
+}
+
+func (s *Service) getCall(ctx context.Context, callId string) (*homecallv1alpha.Call, error) {
+	var dbCall struct {
+		model.Call
+		model.Tenant
+	}
+	err := SELECT(
+		Call.ID,
+		Call.CallID,
+		Call.JitsiRoomID,
+		Call.CreatedAt,
+		Tenant.TenantID,
+	).FROM(
+		Call.INNER_JOIN(Tenant, Tenant.ID.EQ(Call.TenantID)),
+	).WHERE(Call.CallID.EQ(String(callId))).LIMIT(1).QueryContext(ctx, s.db, &dbCall)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("call not found"))
+		}
+		return nil, fmt.Errorf("failed to query call: %w", err)
+	}
+
+	var dbParticipants []struct {
+		model.CallParticipant
+		model.Device
+	}
+	err = SELECT(
+		CallParticipant.AllColumns,
+		Device.DeviceID,
+	).FROM(
+		CallParticipant.LEFT_JOIN(Device, Device.ID.EQ(CallParticipant.DeviceID)),
+	).WHERE(
+		CallParticipant.CallID.EQ(Int32(dbCall.Call.ID)),
+	).ORDER_BY(
+		CallParticipant.DeviceID.IS_NULL().ASC(),
+		CallParticipant.ID.ASC(),
+	).QueryContext(ctx, s.db, &dbParticipants)
+	if err != nil && !errors.Is(err, qrm.ErrNoRows) {
+		return nil, fmt.Errorf("failed to query call participants: %w", err)
+	}
+
+	participants := make([]*homecallv1alpha.CallParticipant, len(dbParticipants))
+	for i, dbParticipant := range dbParticipants {
+		participants[i] = callParticipantToProto(dbParticipant.CallParticipant, dbParticipant.Device.DeviceID)
+	}
+
+	var dbEvents []struct {
+		model.CallParticipantEvent
+		model.CallParticipant
+	}
+	err = SELECT(
+		CallParticipantEvent.ID,
+		CallParticipantEvent.State,
+		CallParticipantEvent.InvitedBy,
+		CallParticipantEvent.CreatedAt,
+		CallParticipant.ParticipantID,
+	).FROM(
+		CallParticipantEvent.INNER_JOIN(CallParticipant, CallParticipant.ID.EQ(CallParticipantEvent.ParticipantID)),
+	).WHERE(
+		CallParticipant.CallID.EQ(Int32(dbCall.Call.ID)),
+	).ORDER_BY(
+		CallParticipantEvent.CreatedAt.ASC(),
+		CallParticipantEvent.ID.ASC(),
+	).QueryContext(ctx, s.db, &dbEvents)
+	if err != nil && !errors.Is(err, qrm.ErrNoRows) {
+		return nil, fmt.Errorf("failed to query call participant events: %w", err)
+	}
+
+	events := make([]*homecallv1alpha.CallParticipantEvent, len(dbEvents))
+	for i, dbEvent := range dbEvents {
+		events[i] = &homecallv1alpha.CallParticipantEvent{
+			ParticipantId: dbEvent.CallParticipant.ParticipantID,
+			State:         calls.StateToProto(dbEvent.CallParticipantEvent.State),
+			CreatedAt:     timestamppb.New(dbEvent.CallParticipantEvent.CreatedAt),
+		}
+		if dbEvent.CallParticipantEvent.InvitedBy != nil {
+			events[i].InvitedByMemberId = *dbEvent.CallParticipantEvent.InvitedBy
+		}
+	}
+
+	return &homecallv1alpha.Call{
+		Id:           dbCall.Call.CallID,
+		TenantId:     dbCall.Tenant.TenantID,
+		JitsiRoomId:  dbCall.Call.JitsiRoomID,
+		CreatedAt:    timestamppb.New(dbCall.Call.CreatedAt),
+		Participants: participants,
+		Events:       events,
+	}, nil
+}
+
+func callParticipantToProto(participant model.CallParticipant, deviceId string) *homecallv1alpha.CallParticipant {
+	callParticipant := &homecallv1alpha.CallParticipant{
+		Id:                   participant.ParticipantID,
+		DeviceId:             deviceId,
+		DisplayName:          participant.DisplayName,
+		State:                calls.StateToProto(participant.State),
+		QuietHoursOverridden: participant.QuietHoursOverridden,
+	}
+	if participant.AutoAnswerDelaySeconds != nil {
+		callParticipant.AutoAnswer = true
+		callParticipant.AutoAnswerDelaySeconds = int64(*participant.AutoAnswerDelaySeconds)
+	}
+	if participant.MemberID != nil {
+		callParticipant.MemberId = *participant.MemberID
+	}
+	if participant.NotifiedAt != nil {
+		callParticipant.NotifiedAt = timestamppb.New(*participant.NotifiedAt)
+	}
+	if participant.JoinedAt != nil {
+		callParticipant.JoinedAt = timestamppb.New(*participant.JoinedAt)
+	}
+	if participant.LeftAt != nil {
+		callParticipant.LeftAt = timestamppb.New(*participant.LeftAt)
+	}
+	return callParticipant
+}
+
+// uniqueIds returns the non-empty IDs in their original order without duplicates.
+func uniqueIds(ids []string) []string {
+	seen := make(map[string]bool, len(ids))
+	var unique []string
+	for _, id := range ids {
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		unique = append(unique, id)
+	}
+	return unique
+}
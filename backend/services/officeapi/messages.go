@@ -0,0 +1,226 @@
+package officeapi
+
+import (
+	"bytes"
+	"connectrpc.com/connect"
+	"context"
+	"errors"
+	fm "firebase.google.com/go/v4/messaging"
+	"fmt"
+	. "github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"sidus.io/home-call/devicemessage"
+	"sidus.io/home-call/gen/connect/homecall/v1alpha"
+	. "sidus.io/home-call/gen/jetdb/public/table"
+	"sidus.io/home-call/util"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// SendMessage stores a text or photo message for a device and notifies the device about it.
+func (s *Service) SendMessage(ctx context.Context, req *connect.Request[homecallv1alpha.SendMessageRequest]) (*connect.Response[homecallv1alpha.SendMessageResponse], error) {
+	deviceId := req.Msg.GetDeviceId()
+
+	err := s.tenantService.CanAccessDevice(ctx, deviceId, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed access device: %w", err)
+	}
+
+	var image []byte
+	var text, imageContentType Expression = NULL, NULL
+	switch content := req.Msg.GetContent().(type) {
+	case *homecallv1alpha.SendMessageRequest_Text:
+		if strings.TrimSpace(content.Text) == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("text is required"))
+		}
+		if utf8.RuneCountInString(content.Text) > devicemessage.MaxTextLength {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("text must be at most %d characters", devicemessage.MaxTextLength))
+		}
+		text = String(content.Text)
+	case *homecallv1alpha.SendMessageRequest_Image:
+		if int64(len(content.Image)) > s.messageImageMaxBytes {
+			return nil, connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("image is larger than %d bytes", s.messageImageMaxBytes))
+		}
+		contentType, ok := devicemessage.ImageContentType(content.Image)
+		if !ok {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("image must be a JPEG, PNG, GIF or WebP image"))
+		}
+		image, imageContentType = content.Image, String(contentType)
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("text or image is required"))
+	}
+
+	device, err := s.getDevice(ctx, deviceId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get device: %w", err)
+	}
+	senderMemberId, err := s.tenantService.MemberID(ctx, device.GetTenantId())
+	if err != nil {
+		return nil, fmt.Errorf("failed access tenant: %w", err)
+	}
+	senderNames, err := s.memberDisplayNames(ctx, device.GetTenantId(), []string{senderMemberId})
+	if err != nil {
+		return nil, err
+	}
+
+	messageId := uuid.New().String()
+	var imageBlobKey, imageSizeBytes Expression = NULL, NULL
+	if image != nil {
+		// The content is stored first, a message is never listed without it
+		err = s.blobStore.Put(ctx, messageId, bytes.NewReader(image))
+		if err != nil {
+			return nil, fmt.Errorf("failed to store image: %w", err)
+		}
+		imageBlobKey, imageSizeBytes = String(messageId), Int64(int64(len(image)))
+	}
+
+	var message devicemessage.Row
+	var prunedBlobKeys []string
+	err = util.WithTransaction(s.db, func(tx util.DB) error {
+		_, err := DeviceMessage.INSERT(
+			DeviceMessage.MessageID,
+			DeviceMessage.DeviceID,
+			DeviceMessage.SenderMemberID,
+			DeviceMessage.SenderDisplayName,
+			DeviceMessage.Text,
+			DeviceMessage.ImageBlobKey,
+			DeviceMessage.ImageContentType,
+			DeviceMessage.ImageSizeBytes,
+			DeviceMessage.CreatedAt,
+		).VALUES(
+			String(messageId),
+			SELECT(Device.ID).FROM(Device).WHERE(Device.DeviceID.EQ(String(deviceId))),
+			String(senderMemberId),
+			String(senderNames[senderMemberId]),
+			text,
+			imageBlobKey,
+			imageContentType,
+			imageSizeBytes,
+			TimestampT(time.Now().UTC()),
+		).ExecContext(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("failed to insert message: %w", err)
+		}
+
+		err = devicemessage.Select(DeviceMessage.MessageID.EQ(String(messageId))).QueryContext(ctx, tx, &message)
+		if err != nil {
+			return fmt.Errorf("failed to query message: %w", err)
+		}
+		prunedBlobKeys, err = devicemessage.Prune(ctx, tx, message.DeviceMessage.DeviceID)
+		return err
+	})
+	if err != nil {
+		if image != nil {
+			s.deleteBlob(ctx, messageId)
+		}
+		return nil, err
+	}
+	for _, key := range prunedBlobKeys {
+		s.deleteBlob(ctx, key)
+	}
+
+	// The message is kept even if the device can not be notified, it lists its messages when it starts
+	err = s.sendMessageNotification(ctx, deviceId, message)
+	if err != nil {
+		s.logger.Warn("failed to notify device about message", "error", err, "device_id", deviceId, "message_id", messageId)
+	}
+
+	return &connect.Response[homecallv1alpha.SendMessageResponse]{
+		Msg: &homecallv1alpha.SendMessageResponse{
+			Message: devicemessage.ToProto(message),
+		},
+	}, nil
+}
+
+func (s *Service) sendMessageNotification(ctx context.Context, deviceId string, message devicemessage.Row) error {
+	notificationToken, err := s.deviceNotificationToken(ctx, s.db, deviceId)
+	if err != nil {
+		return err
+	}
+
+	body := "Du har fått en bild"
+	if message.DeviceMessage.Text != nil {
+		body = *message.DeviceMessage.Text
+	}
+	title := "Nytt meddelande"
+	if message.DeviceMessage.SenderDisplayName != "" {
+		title = fmt.Sprintf("Meddelande från %s", message.DeviceMessage.SenderDisplayName)
+	}
+
+	err = s.notificationService.SendNotification(ctx, &fm.Message{
+		Token: notificationToken,
+		Data: map[string]string{
+			"messageId": message.DeviceMessage.MessageID,
+			"type":      "message",
+		},
+		Notification: &fm.Notification{
+			Title: title,
+			Body:  body,
+		},
+		Android: &fm.AndroidConfig{
+			Priority: "high",
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send notification: %w", err)
+	}
+	return nil
+}
+
+// ListDeviceMessages returns the messages of a device with their read times.
+func (s *Service) ListDeviceMessages(ctx context.Context, req *connect.Request[homecallv1alpha.ListDeviceMessagesRequest]) (*connect.Response[homecallv1alpha.ListDeviceMessagesResponse], error) {
+	deviceId := req.Msg.GetDeviceId()
+
+	err := s.tenantService.CanAccessDevice(ctx, deviceId, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed access device: %w", err)
+	}
+
+	var dbMessages []devicemessage.Row
+	err = devicemessage.Select(Device.DeviceID.EQ(String(deviceId))).QueryContext(ctx, s.db, &dbMessages)
+	if err != nil && !errors.Is(err, qrm.ErrNoRows) {
+		return nil, fmt.Errorf("failed to query messages: %w", err)
+	}
+
+	messages := make([]*homecallv1alpha.DeviceMessage, len(dbMessages))
+	for i, dbMessage := range dbMessages {
+		messages[i] = devicemessage.ToProto(dbMessage)
+	}
+
+	return &connect.Response[homecallv1alpha.ListDeviceMessagesResponse]{
+		Msg: &homecallv1alpha.ListDeviceMessagesResponse{
+			Messages: messages,
+		},
+	}, nil
+}
+
+// GetDeviceMessageImage returns the content of the photo of a message sent to a device.
+func (s *Service) GetDeviceMessageImage(ctx context.Context, req *connect.Request[homecallv1alpha.GetDeviceMessageImageRequest]) (*connect.Response[homecallv1alpha.GetDeviceMessageImageResponse], error) {
+	var message devicemessage.Row
+	err := devicemessage.Select(DeviceMessage.MessageID.EQ(String(req.Msg.GetMessageId()))).LIMIT(1).QueryContext(ctx, s.db, &message)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("message not found"))
+		}
+		return nil, fmt.Errorf("failed to query message: %w", err)
+	}
+
+	err = s.tenantService.CanAccessDevice(ctx, message.Device.DeviceID, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed access device: %w", err)
+	}
+
+	image, data, err := devicemessage.ReadImage(ctx, s.blobStore, message.DeviceMessage)
+	if err != nil {
+		return nil, err
+	}
+
+	return &connect.Response[homecallv1alpha.GetDeviceMessageImageResponse]{
+		Msg: &homecallv1alpha.GetDeviceMessageImageResponse{
+			Image: image,
+			Data:  data,
+		},
+	}, nil
+}
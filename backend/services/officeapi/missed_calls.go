@@ -0,0 +1,155 @@
+package officeapi
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"errors"
+	"fmt"
+	. "github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"sidus.io/home-call/calls"
+	"sidus.io/home-call/gen/connect/homecall/v1alpha"
+	. "sidus.io/home-call/gen/jetdb/public/table"
+	"time"
+)
+
+// WatchMissedCalls streams the missed calls of the calls the caller started in a tenant.
+func (s *Service) WatchMissedCalls(ctx context.Context, req *connect.Request[homecallv1alpha.WatchMissedCallsRequest], stream *connect.ServerStream[homecallv1alpha.WatchMissedCallsResponse]) error {
+	tenantId := req.Msg.GetTenantId()
+
+	memberId, err := s.tenantService.MemberID(ctx, tenantId)
+	if err != nil {
+		return fmt.Errorf("failed access tenant: %w", err)
+	}
+
+	events, err := s.broker.SubscribeToMissedCalls(ctx, tenantId, memberId)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to missed calls: %w", err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				return nil
+			}
+
+			var missedCall calls.MissedCallRow
+			err := calls.SelectMissedCalls(MissedCall.MissedCallID.EQ(String(event.MissedCallID))).
+				LIMIT(1).QueryContext(ctx, s.db, &missedCall)
+			if err != nil {
+				if errors.Is(err, qrm.ErrNoRows) {
+					continue
+				}
+				return fmt.Errorf("failed to query missed call: %w", err)
+			}
+
+			err = stream.Send(&homecallv1alpha.WatchMissedCallsResponse{
+				MissedCall: calls.MissedCallToProto(missedCall),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to send missed call to client: %w", err)
+			}
+		}
+	}
+}
+
+// ListCallbackRequests returns the callback requests of a tenant,
+// members scoped to device groups only see the requests of their devices.
+func (s *Service) ListCallbackRequests(ctx context.Context, req *connect.Request[homecallv1alpha.ListCallbackRequestsRequest]) (*connect.Response[homecallv1alpha.ListCallbackRequestsResponse], error) {
+	tenantId := req.Msg.GetTenantId()
+
+	err := s.tenantService.CanAccessTenant(ctx, tenantId, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed access tenant: %w", err)
+	}
+
+	conditions := Tenant.TenantID.EQ(String(tenantId))
+	if !req.Msg.GetIncludeResolved() {
+		conditions = conditions.AND(CallbackRequest.ResolvedAt.IS_NULL())
+	}
+
+	scopeCondition, err := s.deviceScopeCondition(ctx, tenantId)
+	if err != nil {
+		return nil, err
+	}
+	if scopeCondition != nil {
+		conditions = conditions.AND(scopeCondition)
+	}
+
+	var dbCallbackRequests []calls.CallbackRequestRow
+	err = calls.SelectCallbackRequests(conditions).
+		ORDER_BY(CallbackRequest.CreatedAt.ASC(), CallbackRequest.ID.ASC()).
+		QueryContext(ctx, s.db, &dbCallbackRequests)
+	if err != nil && !errors.Is(err, qrm.ErrNoRows) {
+		return nil, fmt.Errorf("failed to query callback requests: %w", err)
+	}
+
+	callbackRequests := make([]*homecallv1alpha.CallbackRequest, len(dbCallbackRequests))
+	for i, dbCallbackRequest := range dbCallbackRequests {
+		callbackRequests[i] = calls.CallbackRequestToProto(dbCallbackRequest)
+	}
+
+	return &connect.Response[homecallv1alpha.ListCallbackRequestsResponse]{
+		Msg: &homecallv1alpha.ListCallbackRequestsResponse{
+			CallbackRequests: callbackRequests,
+		},
+	}, nil
+}
+
+// ResolveCallbackRequest marks a callback request as handled by the caller.
+// Resolving a request again keeps who resolved it first.
+func (s *Service) ResolveCallbackRequest(ctx context.Context, req *connect.Request[homecallv1alpha.ResolveCallbackRequestRequest]) (*connect.Response[homecallv1alpha.ResolveCallbackRequestResponse], error) {
+	callbackRequestId := req.Msg.GetCallbackRequestId()
+
+	callbackRequest, err := s.getCallbackRequest(ctx, callbackRequestId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.tenantService.CanAccessDevice(ctx, callbackRequest.Device.DeviceID, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed access device: %w", err)
+	}
+
+	memberId, err := s.tenantService.MemberID(ctx, callbackRequest.Tenant.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed access tenant: %w", err)
+	}
+
+	_, err = CallbackRequest.UPDATE().SET(
+		CallbackRequest.ResolvedAt.SET(TimestampT(time.Now().UTC())),
+		CallbackRequest.ResolvedBy.SET(String(memberId)),
+	).WHERE(
+		CallbackRequest.ID.EQ(Int32(callbackRequest.CallbackRequest.ID)).AND(CallbackRequest.ResolvedAt.IS_NULL()),
+	).ExecContext(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve callback request: %w", err)
+	}
+
+	callbackRequest, err = s.getCallbackRequest(ctx, callbackRequestId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &connect.Response[homecallv1alpha.ResolveCallbackRequestResponse]{
+		Msg: &homecallv1alpha.ResolveCallbackRequestResponse{
+			CallbackRequest: calls.CallbackRequestToProto(callbackRequest),
+		},
+	}, nil
+}
+
+func (s *Service) getCallbackRequest(ctx context.Context, callbackRequestId string) (calls.CallbackRequestRow, error) {
+	var callbackRequest calls.CallbackRequestRow
+	err := calls.SelectCallbackRequests(CallbackRequest.CallbackRequestID.EQ(String(callbackRequestId))).
+		LIMIT(1).QueryContext(ctx, s.db, &callbackRequest)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return calls.CallbackRequestRow{}, connect.NewError(connect.CodeNotFound, errors.New("callback request not found"))
+		}
+		return calls.CallbackRequestRow{}, fmt.Errorf("failed to query callback request: %w", err)
+	}
+	return callbackRequest, nil
+}
@@ -0,0 +1,412 @@
+package officeapi
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	. "github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sidus.io/home-call/gen/connect/homecall/v1alpha"
+	"sidus.io/home-call/gen/jetdb/public/enum"
+	"sidus.io/home-call/gen/jetdb/public/model"
+	. "sidus.io/home-call/gen/jetdb/public/table"
+	"sidus.io/home-call/messaging"
+	"time"
+)
+
+// maxScheduledCallDuration limits how long a scheduled call can be planned to last.
+const maxScheduledCallDuration = 24 * time.Hour
+
+// ScheduleCall plans a call with a device, the scheduler reminds the device and the assigned member before it starts.
+func (s *Service) ScheduleCall(ctx context.Context, req *connect.Request[homecallv1alpha.ScheduleCallRequest]) (*connect.Response[homecallv1alpha.ScheduleCallResponse], error) {
+	deviceId := req.Msg.GetDeviceId()
+
+	err := s.tenantService.CanAccessDevice(ctx, deviceId, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed access device: %w", err)
+	}
+
+	device, err := s.getDevice(ctx, deviceId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get device: %w", err)
+	}
+	tenantId := device.GetTenantId()
+
+	callerMemberId, err := s.tenantService.MemberID(ctx, tenantId)
+	if err != nil {
+		return nil, fmt.Errorf("failed access tenant: %w", err)
+	}
+
+	startsAt, duration, err := validateScheduledCallTime(req.Msg.GetStartsAt(), req.Msg.GetDurationSeconds())
+	if err != nil {
+		return nil, err
+	}
+
+	assignedMemberId, err := s.assignedMemberID(ctx, tenantId, req.Msg.GetAssignedMemberId(), callerMemberId)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduledCallId := uuid.New().String()
+	insertStmt := ScheduledCall.INSERT(
+		ScheduledCall.ScheduledCallID,
+		ScheduledCall.TenantID,
+		ScheduledCall.DeviceID,
+		ScheduledCall.AssignedMemberID,
+		ScheduledCall.CreatedBy,
+		ScheduledCall.StartsAt,
+		ScheduledCall.DurationSeconds,
+	).VALUES(
+		scheduledCallId,
+		SELECT(Tenant.ID).FROM(Tenant).WHERE(Tenant.TenantID.EQ(String(tenantId))).LIMIT(1),
+		SELECT(Device.ID).FROM(Device).WHERE(Device.DeviceID.EQ(String(deviceId))).LIMIT(1),
+		assignedMemberId,
+		callerMemberId,
+		startsAt,
+		int32(duration.Seconds()),
+	)
+	_, err = insertStmt.ExecContext(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert scheduled call: %w", err)
+	}
+
+	scheduledCall, err := s.getScheduledCall(ctx, scheduledCallId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &connect.Response[homecallv1alpha.ScheduleCallResponse]{
+		Msg: &homecallv1alpha.ScheduleCallResponse{
+			ScheduledCall: scheduledCall,
+		},
+	}, nil
+}
+
+// ListScheduledCalls returns the scheduled calls of a tenant within a time range,
+// members scoped to device groups only see the calls with their devices.
+func (s *Service) ListScheduledCalls(ctx context.Context, req *connect.Request[homecallv1alpha.ListScheduledCallsRequest]) (*connect.Response[homecallv1alpha.ListScheduledCallsResponse], error) {
+	tenantId := req.Msg.GetTenantId()
+
+	err := s.tenantService.CanAccessTenant(ctx, tenantId, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed access tenant: %w", err)
+	}
+
+	from := time.Now().UTC()
+	if req.Msg.GetFrom() != nil {
+		from = req.Msg.GetFrom().AsTime()
+	}
+	conditions := Tenant.TenantID.EQ(String(tenantId)).
+		AND(ScheduledCall.StartsAt.GT_EQ(TimestampT(from)))
+	if req.Msg.GetTo() != nil {
+		conditions = conditions.AND(ScheduledCall.StartsAt.LT(TimestampT(req.Msg.GetTo().AsTime())))
+	}
+	if req.Msg.GetDeviceId() != "" {
+		conditions = conditions.AND(Device.DeviceID.EQ(String(req.Msg.GetDeviceId())))
+	}
+	if req.Msg.GetAssignedMemberId() != "" {
+		conditions = conditions.AND(ScheduledCall.AssignedMemberID.EQ(String(req.Msg.GetAssignedMemberId())))
+	}
+
+	scopeCondition, err := s.deviceScopeCondition(ctx, tenantId)
+	if err != nil {
+		return nil, err
+	}
+	if scopeCondition != nil {
+		conditions = conditions.AND(scopeCondition)
+	}
+
+	var dbScheduledCalls []dbScheduledCall
+	err = scheduledCallQuery().WHERE(conditions).
+		ORDER_BY(ScheduledCall.StartsAt.ASC(), ScheduledCall.ID.ASC()).
+		QueryContext(ctx, s.db, &dbScheduledCalls)
+	if err != nil && !errors.Is(err, qrm.ErrNoRows) {
+		return nil, fmt.Errorf("failed to query scheduled calls: %w", err)
+	}
+
+	scheduledCalls := make([]*homecallv1alpha.ScheduledCall, len(dbScheduledCalls))
+	for i, dbScheduledCall := range dbScheduledCalls {
+		scheduledCalls[i] = scheduledCallToProto(dbScheduledCall)
+	}
+
+	return &connect.Response[homecallv1alpha.ListScheduledCallsResponse]{
+		Msg: &homecallv1alpha.ListScheduledCallsResponse{
+			ScheduledCalls: scheduledCalls,
+		},
+	}, nil
+}
+
+// UpdateScheduledCall changes the time, duration or assigned member of a scheduled call that has not taken place yet.
+// Moving the call resets its reminders.
+func (s *Service) UpdateScheduledCall(ctx context.Context, req *connect.Request[homecallv1alpha.UpdateScheduledCallRequest]) (*connect.Response[homecallv1alpha.UpdateScheduledCallResponse], error) {
+	scheduledCallId := req.Msg.GetScheduledCallId()
+
+	current, err := s.accessScheduledCall(ctx, scheduledCallId)
+	if err != nil {
+		return nil, err
+	}
+
+	callerMemberId, err := s.tenantService.MemberID(ctx, current.GetTenantId())
+	if err != nil {
+		return nil, fmt.Errorf("failed access tenant: %w", err)
+	}
+
+	startsAt, duration, err := validateScheduledCallTime(req.Msg.GetStartsAt(), req.Msg.GetDurationSeconds())
+	if err != nil {
+		return nil, err
+	}
+
+	assignedMemberId, err := s.assignedMemberID(ctx, current.GetTenantId(), req.Msg.GetAssignedMemberId(), callerMemberId)
+	if err != nil {
+		return nil, err
+	}
+
+	var remindedAt Expression = NULL
+	if current.GetRemindedAt() != nil && startsAt.Equal(current.GetStartsAt().AsTime()) {
+		remindedAt = TimestampT(current.GetRemindedAt().AsTime())
+	}
+
+	result, err := ScheduledCall.UPDATE(
+		ScheduledCall.StartsAt,
+		ScheduledCall.DurationSeconds,
+		ScheduledCall.AssignedMemberID,
+		ScheduledCall.RemindedAt,
+	).SET(
+		startsAt,
+		int32(duration.Seconds()),
+		assignedMemberId,
+		remindedAt,
+	).WHERE(
+		ScheduledCall.ScheduledCallID.EQ(String(scheduledCallId)).
+			AND(ScheduledCall.Status.EQ(enum.ScheduledCallStatus.Scheduled)),
+	).ExecContext(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update scheduled call: %w", err)
+	}
+	err = scheduledCallUpdated(result)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduledCall, err := s.getScheduledCall(ctx, scheduledCallId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &connect.Response[homecallv1alpha.UpdateScheduledCallResponse]{
+		Msg: &homecallv1alpha.UpdateScheduledCallResponse{
+			ScheduledCall: scheduledCall,
+		},
+	}, nil
+}
+
+// CancelScheduledCall cancels a scheduled call that has not taken place yet.
+func (s *Service) CancelScheduledCall(ctx context.Context, req *connect.Request[homecallv1alpha.CancelScheduledCallRequest]) (*connect.Response[homecallv1alpha.CancelScheduledCallResponse], error) {
+	scheduledCallId := req.Msg.GetScheduledCallId()
+
+	_, err := s.accessScheduledCall(ctx, scheduledCallId)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := ScheduledCall.UPDATE(ScheduledCall.Status).SET(enum.ScheduledCallStatus.Cancelled).WHERE(
+		ScheduledCall.ScheduledCallID.EQ(String(scheduledCallId)).
+			AND(ScheduledCall.Status.EQ(enum.ScheduledCallStatus.Scheduled)),
+	).ExecContext(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel scheduled call: %w", err)
+	}
+	err = scheduledCallUpdated(result)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduledCall, err := s.getScheduledCall(ctx, scheduledCallId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &connect.Response[homecallv1alpha.CancelScheduledCallResponse]{
+		Msg: &homecallv1alpha.CancelScheduledCallResponse{
+			ScheduledCall: scheduledCall,
+		},
+	}, nil
+}
+
+// WatchScheduledCalls streams reminders and missed slots of the scheduled calls of a tenant the caller is assigned to.
+func (s *Service) WatchScheduledCalls(ctx context.Context, req *connect.Request[homecallv1alpha.WatchScheduledCallsRequest], stream *connect.ServerStream[homecallv1alpha.WatchScheduledCallsResponse]) error {
+	tenantId := req.Msg.GetTenantId()
+
+	memberId, err := s.tenantService.MemberID(ctx, tenantId)
+	if err != nil {
+		return fmt.Errorf("failed access tenant: %w", err)
+	}
+
+	events, err := s.broker.SubscribeToScheduledCallEvents(ctx, tenantId, memberId)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to scheduled call events: %w", err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				return nil
+			}
+
+			scheduledCall, err := s.getScheduledCall(ctx, event.ScheduledCallID)
+			if err != nil {
+				if connect.CodeOf(err) == connect.CodeNotFound {
+					continue
+				}
+				return err
+			}
+
+			err = stream.Send(&homecallv1alpha.WatchScheduledCallsResponse{
+				Type:          scheduledCallEventTypeToProto(event.Type),
+				ScheduledCall: scheduledCall,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to send scheduled call event to client: %w", err)
+			}
+		}
+	}
+}
+
+// validateScheduledCallTime checks that a scheduled call starts in the future and has a reasonable duration.
+func validateScheduledCallTime(startsAt *timestamppb.Timestamp, durationSeconds int64) (time.Time, time.Duration, error) {
+	if startsAt == nil {
+		return time.Time{}, 0, connect.NewError(connect.CodeInvalidArgument, errors.New("start time is required"))
+	}
+	if !startsAt.AsTime().After(time.Now()) {
+		return time.Time{}, 0, connect.NewError(connect.CodeInvalidArgument, errors.New("start time must be in the future"))
+	}
+	duration := time.Duration(durationSeconds) * time.Second
+	if duration <= 0 || duration > maxScheduledCallDuration {
+		return time.Time{}, 0, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("duration must be between 1 second and %s", maxScheduledCallDuration))
+	}
+	return startsAt.AsTime().UTC(), duration, nil
+}
+
+// assignedMemberID returns the member to assign a scheduled call to, the caller if none is given.
+func (s *Service) assignedMemberID(ctx context.Context, tenantId string, memberId string, callerMemberId string) (string, error) {
+	if memberId == "" || memberId == callerMemberId {
+		return callerMemberId, nil
+	}
+	_, err := s.memberDisplayNames(ctx, tenantId, []string{memberId})
+	if err != nil {
+		return "", err
+	}
+	return memberId, nil
+}
+
+// accessScheduledCall returns a scheduled call if the caller can access its device.
+func (s *Service) accessScheduledCall(ctx context.Context, scheduledCallId string) (*homecallv1alpha.ScheduledCall, error) {
+	scheduledCall, err := s.getScheduledCall(ctx, scheduledCallId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.tenantService.CanAccessDevice(ctx, scheduledCall.GetDeviceId(), false)
+	if err != nil {
+		return nil, fmt.Errorf("failed access device: %w", err)
+	}
+	return scheduledCall, nil
+}
+
+// scheduledCallUpdated returns an error if an update of a scheduled call that has not taken place yet changed nothing.
+func scheduledCallUpdated(result sql.Result) error {
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	if rows == 0 {
+		return connect.NewError(connect.CodeFailedPrecondition, errors.New("scheduled call has already taken place or was cancelled"))
+	}
+	return nil
+}
+
+type dbScheduledCall struct {
+	model.ScheduledCall
+	model.Tenant
+	model.Device
+	model.CallSchedule
+}
+
+func scheduledCallQuery() SelectStatement {
+	return SELECT(
+		ScheduledCall.AllColumns,
+		Tenant.TenantID,
+		Device.DeviceID,
+		CallSchedule.ScheduleID,
+	).FROM(
+		ScheduledCall.
+			INNER_JOIN(Tenant, Tenant.ID.EQ(ScheduledCall.TenantID)).
+			INNER_JOIN(Device, Device.ID.EQ(ScheduledCall.DeviceID)).
+			LEFT_JOIN(CallSchedule, CallSchedule.ID.EQ(ScheduledCall.ScheduleID)),
+	)
+}
+
+func (s *Service) getScheduledCall(ctx context.Context, scheduledCallId string) (*homecallv1alpha.ScheduledCall, error) {
+	var dbScheduledCall dbScheduledCall
+	err := scheduledCallQuery().
+		WHERE(ScheduledCall.ScheduledCallID.EQ(String(scheduledCallId))).
+		LIMIT(1).QueryContext(ctx, s.db, &dbScheduledCall)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("scheduled call not found"))
+		}
+		return nil, fmt.Errorf("failed to query scheduled call: %w", err)
+	}
+	return scheduledCallToProto(dbScheduledCall), nil
+}
+
+func scheduledCallToProto(dbScheduledCall dbScheduledCall) *homecallv1alpha.ScheduledCall {
+	scheduledCall := &homecallv1alpha.ScheduledCall{
+		Id:              dbScheduledCall.ScheduledCall.ScheduledCallID,
+		TenantId:        dbScheduledCall.Tenant.TenantID,
+		DeviceId:        dbScheduledCall.Device.DeviceID,
+		StartsAt:        timestamppb.New(dbScheduledCall.ScheduledCall.StartsAt),
+		DurationSeconds: int64(dbScheduledCall.ScheduledCall.DurationSeconds),
+		CreatedAt:       timestamppb.New(dbScheduledCall.ScheduledCall.CreatedAt),
+		ScheduleId:      dbScheduledCall.CallSchedule.ScheduleID,
+	}
+	if dbScheduledCall.ScheduledCall.AssignedMemberID != nil {
+		scheduledCall.AssignedMemberId = *dbScheduledCall.ScheduledCall.AssignedMemberID
+	}
+	if dbScheduledCall.ScheduledCall.CreatedBy != nil {
+		scheduledCall.CreatedByMemberId = *dbScheduledCall.ScheduledCall.CreatedBy
+	}
+	if dbScheduledCall.ScheduledCall.RemindedAt != nil {
+		scheduledCall.RemindedAt = timestamppb.New(*dbScheduledCall.ScheduledCall.RemindedAt)
+	}
+
+	switch dbScheduledCall.ScheduledCall.Status {
+	case model.ScheduledCallStatus_Scheduled:
+		scheduledCall.Status = homecallv1alpha.ScheduledCallStatus_SCHEDULED_CALL_STATUS_SCHEDULED
+	case model.ScheduledCallStatus_Completed:
+		scheduledCall.Status = homecallv1alpha.ScheduledCallStatus_SCHEDULED_CALL_STATUS_COMPLETED
+	case model.ScheduledCallStatus_Missed:
+		scheduledCall.Status = homecallv1alpha.ScheduledCallStatus_SCHEDULED_CALL_STATUS_MISSED
+	case model.ScheduledCallStatus_Cancelled:
+		scheduledCall.Status = homecallv1alpha.ScheduledCallStatus_SCHEDULED_CALL_STATUS_CANCELLED
+	}
+	return scheduledCall
+}
+
+func scheduledCallEventTypeToProto(eventType messaging.ScheduledCallEventType) homecallv1alpha.ScheduledCallEventType {
+	switch eventType {
+	case messaging.ScheduledCallEventReminder:
+		return homecallv1alpha.ScheduledCallEventType_SCHEDULED_CALL_EVENT_TYPE_REMINDER
+	case messaging.ScheduledCallEventMissed:
+		return homecallv1alpha.ScheduledCallEventType_SCHEDULED_CALL_EVENT_TYPE_MISSED
+	default:
+		return homecallv1alpha.ScheduledCallEventType_SCHEDULED_CALL_EVENT_TYPE_UNSPECIFIED
+	}
+}
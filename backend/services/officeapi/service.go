@@ -11,15 +11,22 @@ import (
 	"github.com/go-jet/jet/v2/qrm"
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"log/slog"
+	"sidus.io/home-call/blobstore"
+	"sidus.io/home-call/calendar"
+	"sidus.io/home-call/calls"
+	"sidus.io/home-call/enrollment"
 	"sidus.io/home-call/gen/connect/homecall/v1alpha"
 	"sidus.io/home-call/gen/connect/homecall/v1alpha/homecallv1alphaconnect"
+	"sidus.io/home-call/gen/jetdb/public/enum"
 	"sidus.io/home-call/gen/jetdb/public/model"
 	. "sidus.io/home-call/gen/jetdb/public/table"
 	"sidus.io/home-call/jitsi"
 	"sidus.io/home-call/messaging"
 	"sidus.io/home-call/notifications"
-	"sidus.io/home-call/services/auth"
+	"sidus.io/home-call/presence"
+	"sidus.io/home-call/schedules"
 	"sidus.io/home-call/services/tenantapi"
 	"sidus.io/home-call/util"
 	"time"
@@ -34,14 +41,28 @@ func NewService(
 	logger *slog.Logger,
 	tenantService *tenantapi.Service,
 	notificationService notifications.Service,
+	presenceModel *presence.Model,
+	watchDevicesInterval time.Duration,
+	enrollmentIssuer *enrollment.Issuer,
+	calendarFeed *calendar.Feed,
+	messageImageMaxBytes int64,
+	blobStore blobstore.Store,
+	albumImageMaxBytes int64,
 ) *Service {
 	return &Service{
-		db:                  db,
-		broker:              broker,
-		jitsiApp:            jitsiApp,
-		logger:              logger,
-		tenantService:       tenantService,
-		notificationService: notificationService,
+		db:                   db,
+		broker:               broker,
+		jitsiApp:             jitsiApp,
+		logger:               logger,
+		tenantService:        tenantService,
+		notificationService:  notificationService,
+		presenceModel:        presenceModel,
+		watchDevicesInterval: watchDevicesInterval,
+		enrollmentIssuer:     enrollmentIssuer,
+		calendarFeed:         calendarFeed,
+		messageImageMaxBytes: messageImageMaxBytes,
+		blobStore:            blobStore,
+		albumImageMaxBytes:   albumImageMaxBytes,
 	}
 }
 
@@ -52,6 +73,14 @@ type Service struct {
 	logger              *slog.Logger
 	tenantService       *tenantapi.Service
 	notificationService notifications.Service
+	presenceModel       *presence.Model
+	// watchDevicesInterval is how often WatchDevices re-checks tenant access and device presence.
+	watchDevicesInterval time.Duration
+	enrollmentIssuer     *enrollment.Issuer
+	calendarFeed         *calendar.Feed
+	messageImageMaxBytes int64
+	blobStore            blobstore.Store
+	albumImageMaxBytes   int64
 }
 
 func (s *Service) CreateDevice(ctx context.Context, req *connect.Request[homecallv1alpha.CreateDeviceRequest]) (*connect.Response[homecallv1alpha.CreateDeviceResponse], error) {
@@ -68,46 +97,128 @@ func (s *Service) CreateDevice(ctx context.Context, req *connect.Request[homecal
 		return nil, fmt.Errorf("failed to marshal device settings: %w", err)
 	}
 
-	enrollmentKey, err := util.RandomString(64)
+	timeZone := req.Msg.GetTimeZone()
+	if timeZone == "" {
+		timeZone = defaultTimeZone
+	}
+	err = validateTimeZone(timeZone)
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate enrollment key: %w", err)
+		return nil, err
 	}
 
-	err = util.WithTransaction(s.db, func(tx util.DB) error {
-		// Insert device
-		insertDeviceStmt := Device.INSERT(Device.DeviceID, Device.Name, Device.TenantID).VALUES(
-			deviceId,
-			req.Msg.GetName(),
-			SELECT(Tenant.ID).FROM(Tenant).WHERE(Tenant.TenantID.EQ(String(req.Msg.GetTenantId()))).LIMIT(1),
-		)
-		_, err = insertDeviceStmt.ExecContext(ctx, tx)
+	quietHours := req.Msg.GetDefaultSettings().GetQuietHours()
+	var quietHoursStart, quietHoursEnd Expression = NULL, NULL
+	if quietHours != nil {
+		err = calls.ValidateQuietHours(quietHours)
 		if err != nil {
-			return fmt.Errorf("failed to insert device: %w", err)
+			return nil, err
 		}
+		quietHoursStart, quietHoursEnd = Int32(quietHours.GetStartMinute()), Int32(quietHours.GetEndMinute())
+		quietHours = &homecallv1alpha.QuietHours{
+			StartMinute: quietHours.GetStartMinute(),
+			EndMinute:   quietHours.GetEndMinute(),
+			TimeZone:    timeZone,
+		}
+	}
 
-		// Insert enrollment
-		insertEnrollmentStmt := Enrollment.INSERT(Enrollment.ID, Enrollment.Key, Enrollment.DeviceSettings).QUERY(
-			SELECT(Device.ID, String(enrollmentKey), Json(string(deviceSettings))).FROM(Device).WHERE(Device.DeviceID.EQ(String(deviceId))))
-		_, err = insertEnrollmentStmt.ExecContext(ctx, tx)
+	autoAnswer := &homecallv1alpha.AutoAnswerPolicy{
+		Enabled:      req.Msg.GetDefaultSettings().GetAutoAnswer(),
+		DelaySeconds: req.Msg.GetDefaultSettings().GetAutoAnswerDelaySeconds(),
+	}
+	err = calls.ValidateAutoAnswerPolicy(autoAnswer)
+	if err != nil {
+		return nil, err
+	}
+
+	var groupId Expression = NULL
+	if req.Msg.GetGroupId() != "" {
+		err = s.tenantService.CanAccessDeviceGroup(ctx, req.Msg.GetGroupId(), true)
 		if err != nil {
-			return fmt.Errorf("failed to insert enrollment: %w", err)
+			return nil, fmt.Errorf("failed access device group: %w", err)
 		}
+		internalGroupId, err := s.deviceGroupID(ctx, req.Msg.GetTenantId(), req.Msg.GetGroupId())
+		if err != nil {
+			return nil, err
+		}
+		groupId = Int32(internalGroupId)
+	}
 
-		return nil
+	credentials, err := s.storeEnrollmentCredentials(deviceId, func(credentials enrollment.Credentials) error {
+		return util.WithTransaction(s.db, func(tx util.DB) error {
+			// Insert device
+			insertDeviceStmt := Device.INSERT(
+				Device.DeviceID,
+				Device.Name,
+				Device.TenantID,
+				Device.GroupID,
+				Device.TimeZone,
+				Device.QuietHoursStart,
+				Device.QuietHoursEnd,
+				Device.AutoAnswer,
+				Device.AutoAnswerDelaySeconds,
+			).VALUES(
+				deviceId,
+				req.Msg.GetName(),
+				SELECT(Tenant.ID).FROM(Tenant).WHERE(Tenant.TenantID.EQ(String(req.Msg.GetTenantId()))).LIMIT(1),
+				groupId,
+				timeZone,
+				quietHoursStart,
+				quietHoursEnd,
+				autoAnswer.GetEnabled(),
+				autoAnswer.GetDelaySeconds(),
+			)
+			_, err := insertDeviceStmt.ExecContext(ctx, tx)
+			if err != nil {
+				return fmt.Errorf("failed to insert device: %w", err)
+			}
+
+			// Insert enrollment
+			insertEnrollmentStmt := Enrollment.INSERT(
+				Enrollment.ID,
+				Enrollment.KeyHash,
+				Enrollment.ExpiresAt,
+				Enrollment.PairingCodeHash,
+				Enrollment.PairingCodeExpiresAt,
+				Enrollment.DeviceSettings,
+			).QUERY(
+				SELECT(
+					Device.ID,
+					String(credentials.KeyHash),
+					TimestampT(credentials.KeyExpiresAt),
+					String(credentials.PairingCodeHash),
+					TimestampT(credentials.PairingCodeExpiresAt),
+					Json(string(deviceSettings)),
+				).FROM(Device).WHERE(Device.DeviceID.EQ(String(deviceId))))
+			_, err = insertEnrollmentStmt.ExecContext(ctx, tx)
+			if err != nil {
+				return fmt.Errorf("failed to insert enrollment: %w", err)
+			}
+
+			return nil
+		})
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	s.publishDeviceEvent(messaging.DeviceEventAdded, req.Msg.GetTenantId(), deviceId)
+
+	device := &homecallv1alpha.Device{
+		Id:         deviceId,
+		Name:       req.Msg.GetName(),
+		Online:     false,
+		TenantId:   req.Msg.GetTenantId(),
+		Enrolled:   false,
+		GroupId:    req.Msg.GetGroupId(),
+		TimeZone:   timeZone,
+		QuietHours: quietHours,
+		AutoAnswer: autoAnswer,
+	}
+	setEnrollmentCredentials(device, credentials)
+
 	return &connect.Response[homecallv1alpha.CreateDeviceResponse]{
 		Msg: &homecallv1alpha.CreateDeviceResponse{
-			Device: &homecallv1alpha.Device{
-				Id:            deviceId,
-				Name:          req.Msg.GetName(),
-				EnrollmentKey: enrollmentKey,
-				Online:        false,
-				TenantId:      req.Msg.GetTenantId(),
-			},
+			Device: device,
 		},
 	}, nil
 }
@@ -123,15 +234,94 @@ func (s *Service) UpdateDevice(ctx context.Context, req *connect.Request[homecal
 		return nil, fmt.Errorf("failed to get device: %w", err)
 	}
 
-	newName := req.Msg.GetName()
+	newName := device.GetName()
+	if req.Msg.GetName() != "" {
+		newName = req.Msg.GetName()
+	}
+	newTimeZone := device.GetTimeZone()
+	if req.Msg.GetTimeZone() != "" {
+		newTimeZone = req.Msg.GetTimeZone()
+		err = validateTimeZone(newTimeZone)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	newQuietHours := device.GetQuietHours()
+	switch {
+	case req.Msg.GetQuietHours() != nil && req.Msg.GetClearQuietHours():
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("quiet hours can not be both set and cleared"))
+	case req.Msg.GetQuietHours() != nil:
+		err = calls.ValidateQuietHours(req.Msg.GetQuietHours())
+		if err != nil {
+			return nil, err
+		}
+		newQuietHours = &homecallv1alpha.QuietHours{
+			StartMinute: req.Msg.GetQuietHours().GetStartMinute(),
+			EndMinute:   req.Msg.GetQuietHours().GetEndMinute(),
+		}
+	case req.Msg.GetClearQuietHours():
+		newQuietHours = nil
+	}
+	var quietHoursStart, quietHoursEnd Expression = NULL, NULL
+	if newQuietHours != nil {
+		newQuietHours.TimeZone = newTimeZone
+		quietHoursStart, quietHoursEnd = Int32(newQuietHours.GetStartMinute()), Int32(newQuietHours.GetEndMinute())
+	}
+
+	newAutoAnswer := device.GetAutoAnswer()
+	if req.Msg.GetAutoAnswer() != nil {
+		newAutoAnswer = req.Msg.GetAutoAnswer()
+		err = calls.ValidateAutoAnswerPolicy(newAutoAnswer)
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	updateStmt := Device.UPDATE().SET(Device.Name.SET(String(newName))).WHERE(Device.DeviceID.EQ(String(device.GetId())))
-	_, err = updateStmt.ExecContext(ctx, s.db)
+	err = util.WithTransaction(s.db, func(tx util.DB) error {
+		updateStmt := Device.UPDATE().SET(
+			Device.Name.SET(String(newName)),
+			Device.TimeZone.SET(String(newTimeZone)),
+			Device.QuietHoursStart.SET(IntExp(quietHoursStart)),
+			Device.QuietHoursEnd.SET(IntExp(quietHoursEnd)),
+			Device.AutoAnswer.SET(Bool(newAutoAnswer.GetEnabled())),
+			Device.AutoAnswerDelaySeconds.SET(Int64(newAutoAnswer.GetDelaySeconds())),
+			Device.AutoAnswerAllowedCallersOnly.SET(Bool(newAutoAnswer.GetAllowedCallersOnly())),
+			Device.AutoAnswerStart.SET(Int32(newAutoAnswer.GetStartMinute())),
+			Device.AutoAnswerEnd.SET(Int32(newAutoAnswer.GetEndMinute())),
+		).WHERE(Device.DeviceID.EQ(String(device.GetId())))
+		_, err := updateStmt.ExecContext(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("failed to update device: %w", err)
+		}
+
+		if newTimeZone == device.GetTimeZone() {
+			return nil
+		}
+		// Recurring calls follow the wall clock of the device
+		schedulesOfDevice := CallSchedule.DeviceID.IN(
+			SELECT(Device.ID).FROM(Device).WHERE(Device.DeviceID.EQ(String(device.GetId()))),
+		)
+		now := time.Now().UTC()
+		err = schedules.Reset(ctx, tx, schedulesOfDevice, now)
+		if err != nil {
+			return err
+		}
+		return schedules.Expand(ctx, tx, schedulesOfDevice, now)
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to update device: %w", err)
+		return nil, err
 	}
 
+	renamed := newName != device.GetName()
 	device.Name = newName
+	device.TimeZone = newTimeZone
+	device.QuietHours = newQuietHours
+	device.AutoAnswer = newAutoAnswer
+
+	if renamed {
+		s.publishDeviceEvent(messaging.DeviceEventRenamed, device.GetTenantId(), device.GetId())
+	}
 
 	return &connect.Response[homecallv1alpha.UpdateDeviceResponse]{
 		Msg: &homecallv1alpha.UpdateDeviceResponse{
@@ -157,6 +347,8 @@ func (s *Service) RemoveDevice(ctx context.Context, req *connect.Request[homecal
 		return nil, fmt.Errorf("failed to delete device: %w", err)
 	}
 
+	s.publishDeviceEvent(messaging.DeviceEventRemoved, device.GetTenantId(), device.GetId())
+
 	return &connect.Response[homecallv1alpha.RemoveDeviceResponse]{
 		Msg: &homecallv1alpha.RemoveDeviceResponse{
 			Device: device,
@@ -164,27 +356,517 @@ func (s *Service) RemoveDevice(ctx context.Context, req *connect.Request[homecal
 	}, nil
 }
 
+// RegenerateEnrollmentKey replaces the enrollment key of a device that is not enrolled yet.
+func (s *Service) RegenerateEnrollmentKey(ctx context.Context, req *connect.Request[homecallv1alpha.RegenerateEnrollmentKeyRequest]) (*connect.Response[homecallv1alpha.RegenerateEnrollmentKeyResponse], error) {
+	err := s.tenantService.CanAccessDevice(ctx, req.Msg.GetDeviceId(), true)
+	if err != nil {
+		return nil, fmt.Errorf("failed access device: %w", err)
+	}
+
+	var affected int64
+	credentials, err := s.storeEnrollmentCredentials(req.Msg.GetDeviceId(), func(credentials enrollment.Credentials) error {
+		updateStmt := Enrollment.UPDATE(
+			Enrollment.KeyHash,
+			Enrollment.ExpiresAt,
+			Enrollment.PairingCodeHash,
+			Enrollment.PairingCodeExpiresAt,
+		).SET(
+			String(credentials.KeyHash),
+			TimestampT(credentials.KeyExpiresAt),
+			String(credentials.PairingCodeHash),
+			TimestampT(credentials.PairingCodeExpiresAt),
+		).WHERE(
+			Enrollment.ID.EQ(
+				IntExp(SELECT(Device.ID).FROM(Device).WHERE(Device.DeviceID.EQ(String(req.Msg.GetDeviceId())))),
+			),
+		)
+		res, err := updateStmt.ExecContext(ctx, s.db)
+		if err != nil {
+			return fmt.Errorf("failed to update enrollment: %w", err)
+		}
+		affected, err = res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get affected rows: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if affected == 0 {
+		// The enrollment is removed when the device enrolls
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("device is already enrolled"))
+	}
+
+	device, err := s.getDevice(ctx, req.Msg.GetDeviceId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get device: %w", err)
+	}
+	setEnrollmentCredentials(device, credentials)
+
+	return &connect.Response[homecallv1alpha.RegenerateEnrollmentKeyResponse]{
+		Msg: &homecallv1alpha.RegenerateEnrollmentKeyResponse{
+			Device: device,
+		},
+	}, nil
+}
+
+// ResetDeviceEnrollment issues a new enrollment for an existing device, keeping its ID and history.
+func (s *Service) ResetDeviceEnrollment(ctx context.Context, req *connect.Request[homecallv1alpha.ResetDeviceEnrollmentRequest]) (*connect.Response[homecallv1alpha.ResetDeviceEnrollmentResponse], error) {
+	err := s.tenantService.CanAccessDevice(ctx, req.Msg.GetDeviceId(), true)
+	if err != nil {
+		return nil, fmt.Errorf("failed access device: %w", err)
+	}
+
+	deviceSettings, err := protojson.Marshal(req.Msg.GetSettings())
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal device settings: %w", err)
+	}
+
+	deviceIdExpression := SELECT(Device.ID).FROM(Device).WHERE(Device.DeviceID.EQ(String(req.Msg.GetDeviceId())))
+
+	credentials, err := s.storeEnrollmentCredentials(req.Msg.GetDeviceId(), func(credentials enrollment.Credentials) error {
+		return util.WithTransaction(s.db, func(tx util.DB) error {
+			// Forget the key so the old installation can no longer authenticate
+			_, err := Device.UPDATE().
+				SET(
+					Device.PublicKey.SET(StringExp(NULL)),
+					Device.PublicKeyAlgorithm.SET(StringExp(NULL)),
+					Device.AttestationType.SET(StringExp(NULL)),
+					Device.AttestedAt.SET(TimestampExp(NULL)),
+				).
+				WHERE(Device.DeviceID.EQ(String(req.Msg.GetDeviceId()))).
+				ExecContext(ctx, tx)
+			if err != nil {
+				return fmt.Errorf("failed to reset device key: %w", err)
+			}
+
+			// The token belongs to the old installation, the new one registers its own after enrolling
+			_, err = DeviceNotificationToken.DELETE().
+				WHERE(DeviceNotificationToken.DeviceID.EQ(IntExp(deviceIdExpression))).
+				ExecContext(ctx, tx)
+			if err != nil {
+				return fmt.Errorf("failed to delete notification token: %w", err)
+			}
+
+			insertEnrollmentStmt := Enrollment.INSERT(
+				Enrollment.ID,
+				Enrollment.KeyHash,
+				Enrollment.ExpiresAt,
+				Enrollment.PairingCodeHash,
+				Enrollment.PairingCodeExpiresAt,
+				Enrollment.DeviceSettings,
+			).QUERY(
+				SELECT(
+					Device.ID,
+					String(credentials.KeyHash),
+					TimestampT(credentials.KeyExpiresAt),
+					String(credentials.PairingCodeHash),
+					TimestampT(credentials.PairingCodeExpiresAt),
+					Json(string(deviceSettings)),
+				).FROM(Device).WHERE(Device.DeviceID.EQ(String(req.Msg.GetDeviceId()))),
+			).ON_CONFLICT(Enrollment.ID).DO_UPDATE(SET(
+				Enrollment.KeyHash.SET(Enrollment.EXCLUDED.KeyHash),
+				Enrollment.ExpiresAt.SET(Enrollment.EXCLUDED.ExpiresAt),
+				Enrollment.PairingCodeHash.SET(Enrollment.EXCLUDED.PairingCodeHash),
+				Enrollment.PairingCodeExpiresAt.SET(Enrollment.EXCLUDED.PairingCodeExpiresAt),
+				Enrollment.DeviceSettings.SET(Enrollment.EXCLUDED.DeviceSettings),
+			))
+			_, err = insertEnrollmentStmt.ExecContext(ctx, tx)
+			if err != nil {
+				return fmt.Errorf("failed to insert enrollment: %w", err)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	device, err := s.getDevice(ctx, req.Msg.GetDeviceId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get device: %w", err)
+	}
+	setEnrollmentCredentials(device, credentials)
+
+	s.publishDeviceEvent(messaging.DeviceEventEnrollmentReset, device.GetTenantId(), device.GetId())
+
+	return &connect.Response[homecallv1alpha.ResetDeviceEnrollmentResponse]{
+		Msg: &homecallv1alpha.ResetDeviceEnrollmentResponse{
+			Device: device,
+		},
+	}, nil
+}
+
+// DisableDevice stops a device from authenticating and receiving calls, its history is kept.
+func (s *Service) DisableDevice(ctx context.Context, req *connect.Request[homecallv1alpha.DisableDeviceRequest]) (*connect.Response[homecallv1alpha.DisableDeviceResponse], error) {
+	device, err := s.setDeviceDisabled(ctx, req.Msg.GetDeviceId(), true)
+	if err != nil {
+		return nil, err
+	}
+	return &connect.Response[homecallv1alpha.DisableDeviceResponse]{
+		Msg: &homecallv1alpha.DisableDeviceResponse{
+			Device: device,
+		},
+	}, nil
+}
+
+// EnableDevice allows a disabled device to authenticate and receive calls again.
+func (s *Service) EnableDevice(ctx context.Context, req *connect.Request[homecallv1alpha.EnableDeviceRequest]) (*connect.Response[homecallv1alpha.EnableDeviceResponse], error) {
+	device, err := s.setDeviceDisabled(ctx, req.Msg.GetDeviceId(), false)
+	if err != nil {
+		return nil, err
+	}
+	return &connect.Response[homecallv1alpha.EnableDeviceResponse]{
+		Msg: &homecallv1alpha.EnableDeviceResponse{
+			Device: device,
+		},
+	}, nil
+}
+
+// setDeviceDisabled disables or enables a device, disabling an already disabled device keeps the original time.
+func (s *Service) setDeviceDisabled(ctx context.Context, deviceId string, disabled bool) (*homecallv1alpha.Device, error) {
+	err := s.tenantService.CanAccessDevice(ctx, deviceId, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed access device: %w", err)
+	}
+
+	updateStmt := Device.UPDATE().
+		SET(Device.DisabledAt.SET(TimestampT(time.Now().UTC()))).
+		WHERE(Device.DeviceID.EQ(String(deviceId)).AND(Device.DisabledAt.IS_NULL()))
+	eventType := messaging.DeviceEventDisabled
+	if !disabled {
+		updateStmt = Device.UPDATE().
+			SET(Device.DisabledAt.SET(TimestampExp(NULL))).
+			WHERE(Device.DeviceID.EQ(String(deviceId)).AND(Device.DisabledAt.IS_NOT_NULL()))
+		eventType = messaging.DeviceEventEnabled
+	}
+	res, err := updateStmt.ExecContext(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update device: %w", err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	device, err := s.getDevice(ctx, deviceId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get device: %w", err)
+	}
+	if affected > 0 {
+		s.publishDeviceEvent(eventType, device.GetTenantId(), device.GetId())
+	}
+	return device, nil
+}
+
+// CreateDeviceGroup creates a group of devices within a tenant.
+func (s *Service) CreateDeviceGroup(ctx context.Context, req *connect.Request[homecallv1alpha.CreateDeviceGroupRequest]) (*connect.Response[homecallv1alpha.CreateDeviceGroupResponse], error) {
+	tenantId := req.Msg.GetTenantId()
+
+	err := s.tenantService.CanAccessTenant(ctx, tenantId, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed access tenant: %w", err)
+	}
+
+	if req.Msg.GetName() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("name is required"))
+	}
+
+	groupId := uuid.New().String()
+	insertStmt := DeviceGroup.INSERT(DeviceGroup.GroupID, DeviceGroup.TenantID, DeviceGroup.Name).VALUES(
+		groupId,
+		SELECT(Tenant.ID).FROM(Tenant).WHERE(Tenant.TenantID.EQ(String(tenantId))).LIMIT(1),
+		req.Msg.GetName(),
+	)
+	_, err = insertStmt.ExecContext(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert device group: %w", err)
+	}
+
+	return &connect.Response[homecallv1alpha.CreateDeviceGroupResponse]{
+		Msg: &homecallv1alpha.CreateDeviceGroupResponse{
+			Group: &homecallv1alpha.DeviceGroup{
+				Id:       groupId,
+				TenantId: tenantId,
+				Name:     req.Msg.GetName(),
+			},
+		},
+	}, nil
+}
+
+// ListDeviceGroups returns the device groups of a tenant, members scoped to groups only see their own.
+func (s *Service) ListDeviceGroups(ctx context.Context, req *connect.Request[homecallv1alpha.ListDeviceGroupsRequest]) (*connect.Response[homecallv1alpha.ListDeviceGroupsResponse], error) {
+	tenantId := req.Msg.GetTenantId()
+
+	err := s.tenantService.CanAccessTenant(ctx, tenantId, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed access tenant: %w", err)
+	}
+
+	scope, err := s.tenantService.DeviceGroupScope(ctx, tenantId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get device group scope: %w", err)
+	}
+
+	conditions := Tenant.TenantID.EQ(String(tenantId))
+	if scope != nil {
+		conditions = conditions.AND(DeviceGroup.ID.IN(scope))
+	}
+
+	var dbGroups []model.DeviceGroup
+	err = SELECT(
+		DeviceGroup.GroupID,
+		DeviceGroup.Name,
+	).FROM(
+		DeviceGroup.INNER_JOIN(Tenant, DeviceGroup.TenantID.EQ(Tenant.ID)),
+	).WHERE(conditions).ORDER_BY(DeviceGroup.Name.ASC(), DeviceGroup.ID.ASC()).QueryContext(ctx, s.db, &dbGroups)
+	if err != nil && !errors.Is(err, qrm.ErrNoRows) {
+		return nil, fmt.Errorf("failed to query device groups: %w", err)
+	}
+
+	groups := make([]*homecallv1alpha.DeviceGroup, len(dbGroups))
+	for i, dbGroup := range dbGroups {
+		groups[i] = &homecallv1alpha.DeviceGroup{
+			Id:       dbGroup.GroupID,
+			TenantId: tenantId,
+			Name:     dbGroup.Name,
+		}
+	}
+
+	return &connect.Response[homecallv1alpha.ListDeviceGroupsResponse]{
+		Msg: &homecallv1alpha.ListDeviceGroupsResponse{
+			Groups: groups,
+		},
+	}, nil
+}
+
+// UpdateDeviceGroup renames a device group.
+func (s *Service) UpdateDeviceGroup(ctx context.Context, req *connect.Request[homecallv1alpha.UpdateDeviceGroupRequest]) (*connect.Response[homecallv1alpha.UpdateDeviceGroupResponse], error) {
+	groupId := req.Msg.GetGroupId()
+
+	err := s.tenantService.CanAccessDeviceGroup(ctx, groupId, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed access device group: %w", err)
+	}
+
+	if req.Msg.GetName() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("name is required"))
+	}
+
+	_, err = DeviceGroup.UPDATE().
+		SET(DeviceGroup.Name.SET(String(req.Msg.GetName()))).
+		WHERE(DeviceGroup.GroupID.EQ(String(groupId))).
+		ExecContext(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update device group: %w", err)
+	}
+
+	group, err := s.getDeviceGroup(ctx, groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &connect.Response[homecallv1alpha.UpdateDeviceGroupResponse]{
+		Msg: &homecallv1alpha.UpdateDeviceGroupResponse{
+			Group: group,
+		},
+	}, nil
+}
+
+// RemoveDeviceGroup removes a device group, its devices are kept without a group.
+func (s *Service) RemoveDeviceGroup(ctx context.Context, req *connect.Request[homecallv1alpha.RemoveDeviceGroupRequest]) (*connect.Response[homecallv1alpha.RemoveDeviceGroupResponse], error) {
+	groupId := req.Msg.GetGroupId()
+
+	err := s.tenantService.CanAccessDeviceGroup(ctx, groupId, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed access device group: %w", err)
+	}
+
+	group, err := s.getDeviceGroup(ctx, groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	// The devices are ungrouped by the foreign key, remember them to notify watchers
+	var dbDevices []model.Device
+	err = SELECT(Device.DeviceID).FROM(
+		Device.INNER_JOIN(DeviceGroup, Device.GroupID.EQ(DeviceGroup.ID)),
+	).WHERE(DeviceGroup.GroupID.EQ(String(groupId))).QueryContext(ctx, s.db, &dbDevices)
+	if err != nil && !errors.Is(err, qrm.ErrNoRows) {
+		return nil, fmt.Errorf("failed to query devices of group: %w", err)
+	}
+
+	_, err = DeviceGroup.DELETE().WHERE(DeviceGroup.GroupID.EQ(String(groupId))).ExecContext(ctx, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete device group: %w", err)
+	}
+
+	for _, dbDevice := range dbDevices {
+		s.publishDeviceEvent(messaging.DeviceEventGroupChanged, group.GetTenantId(), dbDevice.DeviceID)
+	}
+
+	return &connect.Response[homecallv1alpha.RemoveDeviceGroupResponse]{
+		Msg: &homecallv1alpha.RemoveDeviceGroupResponse{},
+	}, nil
+}
+
+// SetDeviceGroup assigns a device to a group of its tenant, or removes it from its group.
+func (s *Service) SetDeviceGroup(ctx context.Context, req *connect.Request[homecallv1alpha.SetDeviceGroupRequest]) (*connect.Response[homecallv1alpha.SetDeviceGroupResponse], error) {
+	deviceId := req.Msg.GetDeviceId()
+	groupId := req.Msg.GetGroupId()
+
+	err := s.tenantService.CanAccessDevice(ctx, deviceId, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed access device: %w", err)
+	}
+
+	device, err := s.getDevice(ctx, deviceId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get device: %w", err)
+	}
+
+	var internalGroupId Expression = NULL
+	if groupId != "" {
+		err = s.tenantService.CanAccessDeviceGroup(ctx, groupId, true)
+		if err != nil {
+			return nil, fmt.Errorf("failed access device group: %w", err)
+		}
+		id, err := s.deviceGroupID(ctx, device.GetTenantId(), groupId)
+		if err != nil {
+			return nil, err
+		}
+		internalGroupId = Int32(id)
+	}
+
+	if device.GetGroupId() != groupId {
+		_, err = Device.UPDATE().
+			SET(Device.GroupID.SET(IntExp(internalGroupId))).
+			WHERE(Device.DeviceID.EQ(String(deviceId))).
+			ExecContext(ctx, s.db)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update device: %w", err)
+		}
+		device.GroupId = groupId
+
+		s.publishDeviceEvent(messaging.DeviceEventGroupChanged, device.GetTenantId(), device.GetId())
+	}
+
+	return &connect.Response[homecallv1alpha.SetDeviceGroupResponse]{
+		Msg: &homecallv1alpha.SetDeviceGroupResponse{
+			Device: device,
+		},
+	}, nil
+}
+
+func (s *Service) getDeviceGroup(ctx context.Context, groupId string) (*homecallv1alpha.DeviceGroup, error) {
+	var dbGroup struct {
+		model.DeviceGroup
+		model.Tenant
+	}
+	err := SELECT(
+		DeviceGroup.GroupID,
+		DeviceGroup.Name,
+		Tenant.TenantID,
+	).FROM(
+		DeviceGroup.INNER_JOIN(Tenant, DeviceGroup.TenantID.EQ(Tenant.ID)),
+	).WHERE(DeviceGroup.GroupID.EQ(String(groupId))).LIMIT(1).QueryContext(ctx, s.db, &dbGroup)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("device group not found"))
+		}
+		return nil, fmt.Errorf("failed to query device group: %w", err)
+	}
+
+	return &homecallv1alpha.DeviceGroup{
+		Id:       dbGroup.DeviceGroup.GroupID,
+		TenantId: dbGroup.Tenant.TenantID,
+		Name:     dbGroup.DeviceGroup.Name,
+	}, nil
+}
+
+// deviceGroupID returns the internal ID of a device group, which must belong to the tenant.
+func (s *Service) deviceGroupID(ctx context.Context, tenantId string, groupId string) (int32, error) {
+	var dbGroup model.DeviceGroup
+	err := SELECT(DeviceGroup.ID).FROM(
+		DeviceGroup.INNER_JOIN(Tenant, DeviceGroup.TenantID.EQ(Tenant.ID)),
+	).WHERE(
+		DeviceGroup.GroupID.EQ(String(groupId)).
+			AND(Tenant.TenantID.EQ(String(tenantId))),
+	).LIMIT(1).QueryContext(ctx, s.db, &dbGroup)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return 0, connect.NewError(connect.CodeInvalidArgument, errors.New("unknown device group"))
+		}
+		return 0, fmt.Errorf("failed to query device group: %w", err)
+	}
+	return dbGroup.ID, nil
+}
+
+// deviceScopeCondition returns a condition limiting devices to the groups the caller is scoped to,
+// or nil if the caller has access to all devices of the tenant.
+func (s *Service) deviceScopeCondition(ctx context.Context, tenantId string) (BoolExpression, error) {
+	scope, err := s.tenantService.DeviceGroupScope(ctx, tenantId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get device group scope: %w", err)
+	}
+	if scope == nil {
+		return nil, nil
+	}
+	return Device.GroupID.IN(scope), nil
+}
+
+// deviceInScope checks whether a device matches a condition from deviceScopeCondition.
+func (s *Service) deviceInScope(ctx context.Context, deviceId string, scopeCondition BoolExpression) (bool, error) {
+	var dbDevice model.Device
+	err := SELECT(Device.ID).FROM(Device).
+		WHERE(Device.DeviceID.EQ(String(deviceId)).AND(scopeCondition)).
+		LIMIT(1).QueryContext(ctx, s.db, &dbDevice)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to query device scope: %w", err)
+	}
+	return true, nil
+}
+
 func (s *Service) getDevice(ctx context.Context, deviceID string) (*homecallv1alpha.Device, error) {
 	deviceStmt := SELECT(
 		Device.DeviceID,
 		Device.Name,
+		Device.PublicKey,
+		Device.DisabledAt,
+		Device.TimeZone,
+		Device.QuietHoursStart,
+		Device.QuietHoursEnd,
+		Device.AutoAnswer,
+		Device.AutoAnswerDelaySeconds,
+		Device.AutoAnswerAllowedCallersOnly,
+		Device.AutoAnswerStart,
+		Device.AutoAnswerEnd,
 		Enrollment.DeviceSettings,
-		Enrollment.Key,
+		Enrollment.ExpiresAt,
+		Enrollment.PairingCodeExpiresAt,
 		Tenant.TenantID,
-		DeviceNotificationToken.UpdatedAt.IS_NOT_NULL().
-			AND(DeviceNotificationToken.UpdatedAt.GT(CAST(NOW()).AS_TIMESTAMP().SUB(INTERVALd(time.Hour)))).
-			AS("Online"),
+		DevicePresence.AllColumns,
+		DeviceNotificationToken.UpdatedAt,
+		DeviceGroup.GroupID,
 	).FROM(
 		Device.
 			LEFT_JOIN(Enrollment, Device.ID.EQ(Enrollment.ID)).
 			LEFT_JOIN(Tenant, Device.TenantID.EQ(Tenant.ID)).
-			LEFT_JOIN(DeviceNotificationToken, Device.ID.EQ(DeviceNotificationToken.DeviceID)),
+			LEFT_JOIN(DevicePresence, Device.ID.EQ(DevicePresence.DeviceID)).
+			LEFT_JOIN(DeviceNotificationToken, Device.ID.EQ(DeviceNotificationToken.DeviceID)).
+			LEFT_JOIN(DeviceGroup, Device.GroupID.EQ(DeviceGroup.ID)),
 	).WHERE(Device.DeviceID.EQ(String(deviceID)))
 	var device struct {
 		model.Device
 		model.Enrollment
 		model.Tenant
-		Online bool
+		model.DevicePresence
+		model.DeviceNotificationToken
+		model.DeviceGroup
 	}
 	err := deviceStmt.QueryContext(ctx, s.db, &device)
 	if err != nil {
@@ -193,15 +875,159 @@ func (s *Service) getDevice(ctx context.Context, deviceID string) (*homecallv1al
 		}
 		return nil, fmt.Errorf("failed to query database: %w", err)
 	}
+	online, lastSeen, state := s.devicePresence(device.DevicePresence, device.DeviceNotificationToken)
+	enrolled, enrollmentKeyExpiresAt, pairingCodeExpiresAt := deviceEnrollment(device.Device, device.Enrollment)
+	disabled, disabledAt := deviceDisabled(device.Device)
 	return &homecallv1alpha.Device{
-		Id:            device.Device.DeviceID,
-		Name:          device.Device.Name,
-		EnrollmentKey: device.Enrollment.Key,
-		Online:        device.Online,
-		TenantId:      device.Tenant.TenantID,
+		Id:                     device.Device.DeviceID,
+		Name:                   device.Device.Name,
+		Online:                 online,
+		TenantId:               device.Tenant.TenantID,
+		LastSeen:               lastSeen,
+		State:                  state,
+		Enrolled:               enrolled,
+		EnrollmentKeyExpiresAt: enrollmentKeyExpiresAt,
+		PairingCodeExpiresAt:   pairingCodeExpiresAt,
+		Disabled:               disabled,
+		DisabledAt:             disabledAt,
+		GroupId:                device.DeviceGroup.GroupID,
+		TimeZone:               device.Device.TimeZone,
+		QuietHours:             calls.QuietHoursToProto(device.Device),
+		AutoAnswer:             calls.AutoAnswerPolicyToProto(device.Device),
 	}, nil
 }
 
+// deviceEnrollment returns whether a device is enrolled and, if not, when its enrollment key and pairing code expire.
+// The enrollment key and pairing code themselves are only stored hashed and can not be returned.
+func deviceEnrollment(device model.Device, dbEnrollment model.Enrollment) (bool, *timestamppb.Timestamp, *timestamppb.Timestamp) {
+	if device.PublicKey != nil {
+		return true, nil, nil
+	}
+	var keyExpiresAt, pairingCodeExpiresAt *timestamppb.Timestamp
+	if !dbEnrollment.ExpiresAt.IsZero() {
+		keyExpiresAt = timestamppb.New(dbEnrollment.ExpiresAt)
+	}
+	if dbEnrollment.PairingCodeExpiresAt != nil {
+		pairingCodeExpiresAt = timestamppb.New(*dbEnrollment.PairingCodeExpiresAt)
+	}
+	return false, keyExpiresAt, pairingCodeExpiresAt
+}
+
+// defaultTimeZone is the time zone of devices created without one.
+const defaultTimeZone = "UTC"
+
+// validateTimeZone checks that a time zone is a known IANA time zone.
+func validateTimeZone(timeZone string) error {
+	_, err := time.LoadLocation(timeZone)
+	// "Local" is the time zone of the server
+	if err != nil || timeZone == "Local" {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown time zone %q", timeZone))
+	}
+	return nil
+}
+
+// deviceDisabled returns whether a device is disabled and since when.
+func deviceDisabled(device model.Device) (bool, *timestamppb.Timestamp) {
+	if device.DisabledAt == nil {
+		return false, nil
+	}
+	return true, timestamppb.New(*device.DisabledAt)
+}
+
+const (
+	// pairingCodeHashIndex is the unique index that keeps pairing codes from being used by two devices.
+	pairingCodeHashIndex = "enrollment_pairing_code_hash_idx"
+	// maxPairingCodeAttempts is how often credentials are issued before giving up on finding an unused pairing code.
+	maxPairingCodeAttempts = 5
+)
+
+// storeEnrollmentCredentials issues enrollment credentials for a device and stores them with store.
+// Pairing codes are short enough to collide with the code of another device,
+// so new credentials are issued and stored again when the pairing code is already in use.
+func (s *Service) storeEnrollmentCredentials(deviceId string, store func(credentials enrollment.Credentials) error) (enrollment.Credentials, error) {
+	for attempt := 1; ; attempt++ {
+		credentials, err := s.enrollmentIssuer.Issue(deviceId)
+		if err != nil {
+			return enrollment.Credentials{}, fmt.Errorf("failed to issue enrollment credentials: %w", err)
+		}
+		err = store(credentials)
+		if err != nil {
+			if util.IsUniqueViolation(err, pairingCodeHashIndex) && attempt < maxPairingCodeAttempts {
+				s.logger.Warn("pairing code already in use, issuing a new one", "device_id", deviceId, "attempt", attempt)
+				continue
+			}
+			return enrollment.Credentials{}, err
+		}
+		return credentials, nil
+	}
+}
+
+// setEnrollmentCredentials sets freshly issued enrollment credentials on a device.
+func setEnrollmentCredentials(device *homecallv1alpha.Device, credentials enrollment.Credentials) {
+	device.EnrollmentKey = credentials.Key
+	device.EnrollmentKeyExpiresAt = timestamppb.New(credentials.KeyExpiresAt)
+	device.PairingCode = credentials.PairingCode
+	device.PairingCodeExpiresAt = timestamppb.New(credentials.PairingCodeExpiresAt)
+	device.EnrollmentQrPayload = credentials.QRPayload
+}
+
+// devicePresence evaluates the last heartbeat of a device.
+// The last seen time and state are not set if the device has never sent a heartbeat,
+// it is then online if it recently updated its notification token.
+func (s *Service) devicePresence(devicePresence model.DevicePresence, notificationToken model.DeviceNotificationToken) (bool, *timestamppb.Timestamp, *homecallv1alpha.DeviceState) {
+	if devicePresence.LastSeenAt.IsZero() {
+		return s.presenceModel.OnlineWithoutHeartbeats(notificationToken.UpdatedAt, time.Now().UTC()), nil, nil
+	}
+
+	appState := homecallv1alpha.AppState_APP_STATE_UNSPECIFIED
+	switch devicePresence.AppState {
+	case model.DeviceAppState_Foreground:
+		appState = homecallv1alpha.AppState_APP_STATE_FOREGROUND
+	case model.DeviceAppState_Background:
+		appState = homecallv1alpha.AppState_APP_STATE_BACKGROUND
+	}
+
+	networkType := homecallv1alpha.NetworkType_NETWORK_TYPE_UNSPECIFIED
+	switch devicePresence.NetworkType {
+	case model.DeviceNetworkType_None:
+		networkType = homecallv1alpha.NetworkType_NETWORK_TYPE_NONE
+	case model.DeviceNetworkType_Wifi:
+		networkType = homecallv1alpha.NetworkType_NETWORK_TYPE_WIFI
+	case model.DeviceNetworkType_Cellular:
+		networkType = homecallv1alpha.NetworkType_NETWORK_TYPE_CELLULAR
+	case model.DeviceNetworkType_Ethernet:
+		networkType = homecallv1alpha.NetworkType_NETWORK_TYPE_ETHERNET
+	}
+
+	online := s.presenceModel.Online(devicePresence.LastSeenAt, presenceAppState(appState), time.Now().UTC())
+	return online, timestamppb.New(devicePresence.LastSeenAt), &homecallv1alpha.DeviceState{
+		AppState:        appState,
+		BatteryLevel:    devicePresence.BatteryLevel,
+		BatteryCharging: devicePresence.BatteryCharging,
+		NetworkType:     networkType,
+	}
+}
+
+// deviceOnline re-evaluates the presence of a previously fetched device at the given time.
+// Devices that have never sent a heartbeat keep the presence they were fetched with.
+func (s *Service) deviceOnline(device *homecallv1alpha.Device, now time.Time) bool {
+	if device.GetLastSeen() == nil {
+		return device.GetOnline()
+	}
+	return s.presenceModel.Online(device.GetLastSeen().AsTime(), presenceAppState(device.GetState().GetAppState()), now)
+}
+
+func presenceAppState(appState homecallv1alpha.AppState) presence.AppState {
+	switch appState {
+	case homecallv1alpha.AppState_APP_STATE_FOREGROUND:
+		return presence.AppStateForeground
+	case homecallv1alpha.AppState_APP_STATE_BACKGROUND:
+		return presence.AppStateBackground
+	default:
+		return presence.AppStateUnknown
+	}
+}
+
 func (s *Service) WaitForEnrollment(ctx context.Context, req *connect.Request[homecallv1alpha.WaitForEnrollmentRequest], stream *connect.ServerStream[homecallv1alpha.WaitForEnrollmentResponse]) error {
 	err := s.tenantService.CanAccessDevice(ctx, req.Msg.GetDeviceId(), true)
 	if err != nil {
@@ -213,7 +1039,7 @@ func (s *Service) WaitForEnrollment(ctx context.Context, req *connect.Request[ho
 		return fmt.Errorf("failed to get device: %w", err)
 	}
 
-	if device.EnrollmentKey == "" {
+	if device.GetEnrolled() {
 		return connect.NewError(connect.CodeFailedPrecondition, errors.New("device is already enrolled"))
 	}
 
@@ -246,127 +1072,411 @@ func (s *Service) ListDevices(ctx context.Context, req *connect.Request[homecall
 		return nil, fmt.Errorf("failed access device: %w", err)
 	}
 
+	condition, err := s.deviceScopeCondition(ctx, tenantId)
+	if err != nil {
+		return nil, err
+	}
+	if req.Msg.GetGroupId() != "" {
+		groupCondition := DeviceGroup.GroupID.EQ(String(req.Msg.GetGroupId()))
+		if condition != nil {
+			groupCondition = condition.AND(groupCondition)
+		}
+		condition = groupCondition
+	}
+
+	devices, err := s.listDevices(ctx, tenantId, condition)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list devices: %w", err)
+	}
+
+	return &connect.Response[homecallv1alpha.ListDevicesResponse]{
+		Msg: &homecallv1alpha.ListDevicesResponse{
+			Devices: devices,
+		},
+	}, nil
+}
+
+// listDevices returns the devices of a tenant that match the condition, if there is one.
+func (s *Service) listDevices(ctx context.Context, tenantId string, condition BoolExpression) ([]*homecallv1alpha.Device, error) {
+	conditions := Tenant.TenantID.EQ(String(tenantId))
+	if condition != nil {
+		conditions = conditions.AND(condition)
+	}
+
 	devicesStmt := SELECT(
 		Device.DeviceID,
-		Device.Name, DeviceNotificationToken.UpdatedAt.IS_NOT_NULL().
-			AND(DeviceNotificationToken.UpdatedAt.GT(CAST(NOW()).AS_TIMESTAMP().SUB(INTERVALd(time.Hour)))).
-			AS("Online"),
-		Enrollment.Key,
+		Device.Name,
+		Device.PublicKey,
+		Device.DisabledAt,
+		Device.TimeZone,
+		Device.QuietHoursStart,
+		Device.QuietHoursEnd,
+		Device.AutoAnswer,
+		Device.AutoAnswerDelaySeconds,
+		Device.AutoAnswerAllowedCallersOnly,
+		Device.AutoAnswerStart,
+		Device.AutoAnswerEnd,
+		Enrollment.ExpiresAt,
+		Enrollment.PairingCodeExpiresAt,
+		DevicePresence.AllColumns,
+		DeviceNotificationToken.UpdatedAt,
+		DeviceGroup.GroupID,
 	).FROM(Device.
 		LEFT_JOIN(Enrollment, Device.ID.EQ(Enrollment.ID)).
 		LEFT_JOIN(Tenant, Device.TenantID.EQ(Tenant.ID)).
-		LEFT_JOIN(DeviceNotificationToken, Device.ID.EQ(DeviceNotificationToken.DeviceID)),
-	).WHERE(Tenant.TenantID.EQ(String(tenantId)))
+		LEFT_JOIN(DevicePresence, Device.ID.EQ(DevicePresence.DeviceID)).
+		LEFT_JOIN(DeviceNotificationToken, Device.ID.EQ(DeviceNotificationToken.DeviceID)).
+		LEFT_JOIN(DeviceGroup, Device.GroupID.EQ(DeviceGroup.ID)),
+	).WHERE(conditions)
 
 	var devices []struct {
 		model.Device
 		model.Enrollment
-		Online bool
+		model.DevicePresence
+		model.DeviceNotificationToken
+		model.DeviceGroup
 	}
-	err = devicesStmt.QueryContext(ctx, s.db, &devices)
+	err := devicesStmt.QueryContext(ctx, s.db, &devices)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query database: %w", err)
 	}
 
 	var deviceResponses []*homecallv1alpha.Device
 	for _, device := range devices {
+		online, lastSeen, state := s.devicePresence(device.DevicePresence, device.DeviceNotificationToken)
+		enrolled, enrollmentKeyExpiresAt, pairingCodeExpiresAt := deviceEnrollment(device.Device, device.Enrollment)
+		disabled, disabledAt := deviceDisabled(device.Device)
 		deviceResponses = append(deviceResponses, &homecallv1alpha.Device{
-			Id:            device.Device.DeviceID,
-			Name:          device.Device.Name,
-			EnrollmentKey: device.Enrollment.Key,
-			Online:        device.Online,
+			Id:                     device.Device.DeviceID,
+			Name:                   device.Device.Name,
+			Online:                 online,
+			TenantId:               tenantId,
+			LastSeen:               lastSeen,
+			State:                  state,
+			Enrolled:               enrolled,
+			EnrollmentKeyExpiresAt: enrollmentKeyExpiresAt,
+			PairingCodeExpiresAt:   pairingCodeExpiresAt,
+			Disabled:               disabled,
+			DisabledAt:             disabledAt,
+			GroupId:                device.DeviceGroup.GroupID,
+			TimeZone:               device.Device.TimeZone,
+			QuietHours:             calls.QuietHoursToProto(device.Device),
+			AutoAnswer:             calls.AutoAnswerPolicyToProto(device.Device),
 		})
 
 	}
-	return &connect.Response[homecallv1alpha.ListDevicesResponse]{
-		Msg: &homecallv1alpha.ListDevicesResponse{
-			Devices: deviceResponses,
+	return deviceResponses, nil
+}
+
+// WatchDevices streams a snapshot of all devices of a tenant followed by every change to them.
+func (s *Service) WatchDevices(ctx context.Context, req *connect.Request[homecallv1alpha.WatchDevicesRequest], stream *connect.ServerStream[homecallv1alpha.WatchDevicesResponse]) error {
+	tenantId := req.Msg.GetTenantId()
+
+	err := s.tenantService.CanAccessTenant(ctx, tenantId, false)
+	if err != nil {
+		return fmt.Errorf("failed access tenant: %w", err)
+	}
+
+	// Subscribe before taking the snapshot so that no changes are missed in between
+	events, err := s.broker.SubscribeToDeviceEvents(ctx, tenantId)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to device events: %w", err)
+	}
+
+	// Members scoped to device groups only see the devices in their groups
+	scopeCondition, err := s.deviceScopeCondition(ctx, tenantId)
+	if err != nil {
+		return err
+	}
+
+	devices, err := s.listDevices(ctx, tenantId, scopeCondition)
+	if err != nil {
+		return fmt.Errorf("failed to list devices: %w", err)
+	}
+
+	knownDevices := make(map[string]*homecallv1alpha.Device, len(devices))
+	for _, device := range devices {
+		knownDevices[device.GetId()] = device
+	}
+
+	err = stream.Send(&homecallv1alpha.WatchDevicesResponse{
+		Event: &homecallv1alpha.WatchDevicesResponse_Snapshot{
+			Snapshot: &homecallv1alpha.DeviceSnapshot{
+				Devices: devices,
+			},
 		},
-	}, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send snapshot to client: %w", err)
+	}
+
+	ticker := time.NewTicker(s.watchDevicesInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			// Access might have been revoked since the stream was opened
+			err := s.tenantService.CanAccessTenant(ctx, tenantId, false)
+			if err != nil {
+				if errors.Is(err, tenantapi.ErrNoAccess) {
+					return connect.NewError(connect.CodePermissionDenied, errors.New("no longer a member of the tenant"))
+				}
+				return fmt.Errorf("failed access tenant: %w", err)
+			}
+
+			// Devices that go offline stop sending heartbeats, so there is no event to react to
+			now := time.Now().UTC()
+			for _, device := range knownDevices {
+				if !device.GetOnline() || s.deviceOnline(device, now) {
+					continue
+				}
+				device.Online = false
+				err := sendDeviceEvent(stream, homecallv1alpha.DeviceEventType_DEVICE_EVENT_TYPE_OFFLINE, device)
+				if err != nil {
+					return err
+				}
+			}
+		case event, ok := <-events:
+			if !ok {
+				return nil
+			}
+			err := s.handleDeviceEvent(ctx, stream, knownDevices, scopeCondition, event)
+			if err != nil {
+				return err
+			}
+		}
+	}
 }
 
-// StartCall starts a call with the specified device.
-func (s *Service) StartCall(ctx context.Context, req *connect.Request[homecallv1alpha.StartCallRequest]) (*connect.Response[homecallv1alpha.StartCallResponse], error) {
-	err := s.tenantService.CanAccessDevice(ctx, req.Msg.GetDeviceId(), false)
+func (s *Service) handleDeviceEvent(
+	ctx context.Context,
+	stream *connect.ServerStream[homecallv1alpha.WatchDevicesResponse],
+	knownDevices map[string]*homecallv1alpha.Device,
+	scopeCondition BoolExpression,
+	event messaging.DeviceEvent,
+) error {
+	previous, known := knownDevices[event.DeviceID]
+
+	if event.Type == messaging.DeviceEventRemoved {
+		if !known {
+			return nil
+		}
+		delete(knownDevices, event.DeviceID)
+		return sendDeviceEvent(stream, homecallv1alpha.DeviceEventType_DEVICE_EVENT_TYPE_REMOVED, previous)
+	}
+
+	device, err := s.getDevice(ctx, event.DeviceID)
 	if err != nil {
-		return nil, fmt.Errorf("failed access device: %w", err)
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			// Removed in the meantime, the removal has its own event
+			return nil
+		}
+		return fmt.Errorf("failed to get device: %w", err)
 	}
 
-	device, err := s.getDevice(ctx, req.Msg.GetDeviceId())
+	if scopeCondition != nil {
+		inScope, err := s.deviceInScope(ctx, event.DeviceID, scopeCondition)
+		if err != nil {
+			return err
+		}
+		if !inScope {
+			// Moved out of the groups of the member
+			if !known {
+				return nil
+			}
+			delete(knownDevices, event.DeviceID)
+			return sendDeviceEvent(stream, homecallv1alpha.DeviceEventType_DEVICE_EVENT_TYPE_REMOVED, previous)
+		}
+	}
+	knownDevices[event.DeviceID] = device
+
+	var eventType homecallv1alpha.DeviceEventType
+	switch event.Type {
+	case messaging.DeviceEventAdded:
+		if known {
+			// Already part of the snapshot
+			return nil
+		}
+		eventType = homecallv1alpha.DeviceEventType_DEVICE_EVENT_TYPE_ADDED
+	case messaging.DeviceEventRenamed:
+		eventType = homecallv1alpha.DeviceEventType_DEVICE_EVENT_TYPE_RENAMED
+	case messaging.DeviceEventEnrolled:
+		eventType = homecallv1alpha.DeviceEventType_DEVICE_EVENT_TYPE_ENROLLED
+	case messaging.DeviceEventEnrollmentReset:
+		eventType = homecallv1alpha.DeviceEventType_DEVICE_EVENT_TYPE_ENROLLMENT_RESET
+	case messaging.DeviceEventDisabled:
+		eventType = homecallv1alpha.DeviceEventType_DEVICE_EVENT_TYPE_DISABLED
+	case messaging.DeviceEventEnabled:
+		eventType = homecallv1alpha.DeviceEventType_DEVICE_EVENT_TYPE_ENABLED
+	case messaging.DeviceEventGroupChanged:
+		if !known {
+			// Moved into the groups of the member
+			eventType = homecallv1alpha.DeviceEventType_DEVICE_EVENT_TYPE_ADDED
+		} else {
+			eventType = homecallv1alpha.DeviceEventType_DEVICE_EVENT_TYPE_GROUP_CHANGED
+		}
+	case messaging.DeviceEventHeartbeat:
+		if known && previous.GetOnline() == device.GetOnline() {
+			return nil
+		}
+		if !device.GetOnline() {
+			return nil
+		}
+		eventType = homecallv1alpha.DeviceEventType_DEVICE_EVENT_TYPE_ONLINE
+	default:
+		return nil
+	}
+
+	return sendDeviceEvent(stream, eventType, device)
+}
+
+func sendDeviceEvent(stream *connect.ServerStream[homecallv1alpha.WatchDevicesResponse], eventType homecallv1alpha.DeviceEventType, device *homecallv1alpha.Device) error {
+	err := stream.Send(&homecallv1alpha.WatchDevicesResponse{
+		Event: &homecallv1alpha.WatchDevicesResponse_DeviceEvent{
+			DeviceEvent: &homecallv1alpha.DeviceEvent{
+				Type:   eventType,
+				Device: device,
+			},
+		},
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to get device: %w", err)
+		return fmt.Errorf("failed to send device event to client: %w", err)
 	}
+	return nil
+}
 
-	if device.EnrollmentKey != "" {
-		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("device is not enrolled"))
+const (
+	defaultDiagnosticsLimit = 10
+	maxDiagnosticsLimit     = 100
+)
+
+// GetDeviceDiagnostics returns the latest diagnostics reports of a device.
+func (s *Service) GetDeviceDiagnostics(ctx context.Context, req *connect.Request[homecallv1alpha.GetDeviceDiagnosticsRequest]) (*connect.Response[homecallv1alpha.GetDeviceDiagnosticsResponse], error) {
+	err := s.tenantService.CanAccessDevice(ctx, req.Msg.GetDeviceId(), true)
+	if err != nil {
+		return nil, fmt.Errorf("failed access device: %w", err)
 	}
 
-	if !device.Online {
-		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("device is offline"))
+	limit := int64(req.Msg.GetLimit())
+	if limit <= 0 {
+		limit = defaultDiagnosticsLimit
 	}
+	limit = min(limit, maxDiagnosticsLimit)
+
+	stmt := SELECT(
+		DeviceDiagnostics.Report,
+		DeviceDiagnostics.ReportedAt,
+	).FROM(
+		DeviceDiagnostics.
+			INNER_JOIN(Device, DeviceDiagnostics.DeviceID.EQ(Device.ID)),
+	).WHERE(
+		Device.DeviceID.EQ(String(req.Msg.GetDeviceId())),
+	).ORDER_BY(
+		DeviceDiagnostics.ReportedAt.DESC(),
+	).LIMIT(limit)
 
-	// Create jitsi room
-	jitsiCall, err := s.jitsiApp.NewCall()
+	var dbReports []model.DeviceDiagnostics
+	err = stmt.QueryContext(ctx, s.db, &dbReports)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create call: %w", err)
+		return nil, fmt.Errorf("failed to query database: %w", err)
 	}
 
-	authDetails := auth.GetAuth(ctx)
-	if authDetails == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("unauthenticated"))
+	reports := make([]*homecallv1alpha.DeviceDiagnosticsReport, len(dbReports))
+	for i, dbReport := range dbReports {
+		var diagnostics homecallv1alpha.DeviceDiagnostics
+		err = protojson.Unmarshal([]byte(dbReport.Report), &diagnostics)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal diagnostics: %w", err)
+		}
+		reports[i] = &homecallv1alpha.DeviceDiagnosticsReport{
+			ReportedAt:  timestamppb.New(dbReport.ReportedAt),
+			Diagnostics: &diagnostics,
+		}
 	}
 
-	// Create jitsi jwt for office
-	officeToken, err := jitsiCall.OfficeJWT(authDetails.DisplayName)
+	return &connect.Response[homecallv1alpha.GetDeviceDiagnosticsResponse]{
+		Msg: &homecallv1alpha.GetDeviceDiagnosticsResponse{
+			Reports: reports,
+		},
+	}, nil
+}
+
+func (s *Service) publishDeviceEvent(eventType messaging.DeviceEventType, tenantId string, deviceId string) {
+	err := s.broker.PublishDeviceEvent(messaging.DeviceEvent{
+		Type:     eventType,
+		TenantID: tenantId,
+		DeviceID: deviceId,
+	})
+	if err != nil {
+		s.logger.Error("failed to publish device event", "error", err, "type", eventType, "device_id", deviceId)
+	}
+}
+
+func (s *Service) deviceNotificationToken(ctx context.Context, db util.DB, deviceId string) (string, error) {
+	tokenRow := model.DeviceNotificationToken{}
+	err := SELECT(DeviceNotificationToken.NotificationToken).
+		FROM(Device.LEFT_JOIN(DeviceNotificationToken, Device.ID.EQ(DeviceNotificationToken.DeviceID))).
+		WHERE(Device.DeviceID.EQ(String(deviceId))).LIMIT(1).QueryContext(ctx, db, &tokenRow)
+	if err != nil {
+		return "", fmt.Errorf("failed to get notification token: %w", err)
+	}
+	if tokenRow.NotificationToken == "" {
+		return "", connect.NewError(connect.CodeFailedPrecondition, errors.New("device has no notification token"))
+	}
+	return tokenRow.NotificationToken, nil
+}
+
+func (s *Service) RequestDeviceLogs(ctx context.Context, req *connect.Request[homecallv1alpha.RequestDeviceLogsRequest]) (*connect.Response[homecallv1alpha.RequestDeviceLogsResponse], error) {
+	err := s.tenantService.CanAccessDevice(ctx, req.Msg.GetDeviceId(), true)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create office token: %w", err)
+		return nil, fmt.Errorf("failed access device: %w", err)
 	}
 
-	// Create jitsi jwt for device
-	deviceToken, err := jitsiCall.DeviceJWT(device.Name)
+	device, err := s.getDevice(ctx, req.Msg.GetDeviceId())
 	if err != nil {
-		return nil, fmt.Errorf("failed to create device token: %w", err)
+		return nil, fmt.Errorf("failed to get device: %w", err)
 	}
 
-	callId := uuid.New().String()
+	if !device.GetEnrolled() {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("device is not enrolled"))
+	}
 
-	err = util.WithTransaction(s.db, func(db util.DB) error {
-		// Store call in device outbox
-		insertCallStmt := DeviceCallOutbox.
+	logId := uuid.New().String()
+	requestedAt := time.Now().UTC()
+
+	err = util.WithTransaction(s.db, func(tx util.DB) error {
+		insertStmt := DeviceLog.
 			INSERT(
-				DeviceCallOutbox.CallID,
-				DeviceCallOutbox.DeviceID,
-				DeviceCallOutbox.JitsiRoomID,
-				DeviceCallOutbox.JitsiJwt,
+				DeviceLog.LogID,
+				DeviceLog.DeviceID,
+				DeviceLog.Status,
+				DeviceLog.RequestedAt,
 			).
 			VALUES(
-				String(callId),
+				String(logId),
 				SELECT(Device.ID).FROM(Device).WHERE(Device.DeviceID.EQ(String(device.GetId()))),
-				String(jitsiCall.RoomName()),
-				String(deviceToken),
+				enum.DeviceLogStatus.Requested,
+				TimestampT(requestedAt),
 			)
-		_, err = insertCallStmt.ExecContext(ctx, s.db)
+		_, err := insertStmt.ExecContext(ctx, tx)
 		if err != nil {
-			return fmt.Errorf("failed to insert call: %w", err)
+			return fmt.Errorf("failed to insert log: %w", err)
 		}
 
-		tokenRow := model.DeviceNotificationToken{}
-		err = SELECT(DeviceNotificationToken.NotificationToken).
-			FROM(Device.LEFT_JOIN(DeviceNotificationToken, Device.ID.EQ(DeviceNotificationToken.DeviceID))).
-			WHERE(Device.DeviceID.EQ(String(device.GetId()))).LIMIT(1).QueryContext(ctx, s.db, &tokenRow)
+		notificationToken, err := s.deviceNotificationToken(ctx, tx, device.GetId())
 		if err != nil {
-			return fmt.Errorf("failed to get notification token: %w", err)
+			return err
 		}
 
+		// Data-only message, the device uploads the logs in the background
 		err = s.notificationService.SendNotification(ctx, &fm.Message{
-			Token: tokenRow.NotificationToken,
+			Token: notificationToken,
 			Data: map[string]string{
-				"callId": callId,
-				"type":   "call",
-			},
-			Notification: &fm.Notification{
-				Title: "Inkommande samtal",
-				Body:  "Du har ett inkommande samtal, klicka här för att svara",
+				"logId": logId,
+				"type":  "uploadLogs",
 			},
 			Android: &fm.AndroidConfig{
 				// Required for background/quit data-only messages on Android
@@ -390,11 +1500,140 @@ func (s *Service) StartCall(ctx context.Context, req *connect.Request[homecallv1
 		return nil, err
 	}
 
-	return &connect.Response[homecallv1alpha.StartCallResponse]{
-		Msg: &homecallv1alpha.StartCallResponse{
-			CallId:      callId,
-			JitsiJwt:    officeToken,
-			JitsiRoomId: jitsiCall.RoomName(),
+	return &connect.Response[homecallv1alpha.RequestDeviceLogsResponse]{
+		Msg: &homecallv1alpha.RequestDeviceLogsResponse{
+			Log: &homecallv1alpha.DeviceLog{
+				Id:          logId,
+				DeviceId:    device.GetId(),
+				Status:      homecallv1alpha.DeviceLogStatus_DEVICE_LOG_STATUS_REQUESTED,
+				RequestedAt: timestamppb.New(requestedAt),
+			},
+		},
+	}, nil
+}
+
+func (s *Service) ListDeviceLogs(ctx context.Context, req *connect.Request[homecallv1alpha.ListDeviceLogsRequest]) (*connect.Response[homecallv1alpha.ListDeviceLogsResponse], error) {
+	err := s.tenantService.CanAccessDevice(ctx, req.Msg.GetDeviceId(), true)
+	if err != nil {
+		return nil, fmt.Errorf("failed access device: %w", err)
+	}
+
+	// The content is left out on purpose, it is only needed when downloading
+	stmt := SELECT(
+		DeviceLog.LogID,
+		DeviceLog.Status,
+		DeviceLog.RequestedAt,
+		DeviceLog.UploadedAt,
+		DeviceLog.ContentEncoding,
+		DeviceLog.SizeBytes,
+	).FROM(
+		DeviceLog.
+			INNER_JOIN(Device, DeviceLog.DeviceID.EQ(Device.ID)),
+	).WHERE(
+		Device.DeviceID.EQ(String(req.Msg.GetDeviceId())),
+	).ORDER_BY(
+		DeviceLog.RequestedAt.DESC(),
+	)
+
+	var dbLogs []model.DeviceLog
+	err = stmt.QueryContext(ctx, s.db, &dbLogs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query database: %w", err)
+	}
+
+	logs := make([]*homecallv1alpha.DeviceLog, len(dbLogs))
+	for i, dbLog := range dbLogs {
+		logs[i] = deviceLogToProto(dbLog, req.Msg.GetDeviceId())
+	}
+
+	return &connect.Response[homecallv1alpha.ListDeviceLogsResponse]{
+		Msg: &homecallv1alpha.ListDeviceLogsResponse{
+			Logs: logs,
 		},
 	}, nil
 }
+
+func (s *Service) DownloadDeviceLog(ctx context.Context, req *connect.Request[homecallv1alpha.DownloadDeviceLogRequest]) (*connect.Response[homecallv1alpha.DownloadDeviceLogResponse], error) {
+	// Logs of devices the caller can not access are reported as not found,
+	// so that log IDs can not be probed
+	notFound := connect.NewError(connect.CodeNotFound, errors.New("log not found"))
+
+	// The content is only loaded once access has been checked
+	stmt := SELECT(
+		DeviceLog.AllColumns.Except(DeviceLog.Content),
+		Device.DeviceID,
+	).FROM(
+		DeviceLog.
+			INNER_JOIN(Device, DeviceLog.DeviceID.EQ(Device.ID)),
+	).WHERE(
+		DeviceLog.LogID.EQ(String(req.Msg.GetLogId())),
+	).LIMIT(1)
+
+	var dbLog struct {
+		model.DeviceLog
+		Device model.Device
+	}
+	err := stmt.QueryContext(ctx, s.db, &dbLog)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, notFound
+		}
+		return nil, fmt.Errorf("failed to query database: %w", err)
+	}
+
+	err = s.tenantService.CanAccessDevice(ctx, dbLog.Device.DeviceID, true)
+	if err != nil {
+		if errors.Is(err, tenantapi.ErrNoAccess) {
+			return nil, notFound
+		}
+		return nil, fmt.Errorf("failed access device: %w", err)
+	}
+
+	if dbLog.Status != model.DeviceLogStatus_Uploaded {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("log has not been uploaded yet"))
+	}
+
+	err = SELECT(DeviceLog.Content).
+		FROM(DeviceLog).
+		WHERE(DeviceLog.ID.EQ(Int32(dbLog.DeviceLog.ID))).
+		QueryContext(ctx, s.db, &dbLog.DeviceLog)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			// Removed by the scheduler in the meantime
+			return nil, notFound
+		}
+		return nil, fmt.Errorf("failed to query database: %w", err)
+	}
+	if dbLog.Content == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("log has not been uploaded yet"))
+	}
+
+	return &connect.Response[homecallv1alpha.DownloadDeviceLogResponse]{
+		Msg: &homecallv1alpha.DownloadDeviceLogResponse{
+			Log:     deviceLogToProto(dbLog.DeviceLog, dbLog.Device.DeviceID),
+			Content: *dbLog.Content,
+		},
+	}, nil
+}
+
+func deviceLogToProto(dbLog model.DeviceLog, deviceId string) *homecallv1alpha.DeviceLog {
+	log := &homecallv1alpha.DeviceLog{
+		Id:          dbLog.LogID,
+		DeviceId:    deviceId,
+		Status:      homecallv1alpha.DeviceLogStatus_DEVICE_LOG_STATUS_REQUESTED,
+		RequestedAt: timestamppb.New(dbLog.RequestedAt),
+	}
+	if dbLog.Status == model.DeviceLogStatus_Uploaded {
+		log.Status = homecallv1alpha.DeviceLogStatus_DEVICE_LOG_STATUS_UPLOADED
+	}
+	if dbLog.UploadedAt != nil {
+		log.UploadedAt = timestamppb.New(*dbLog.UploadedAt)
+	}
+	if dbLog.ContentEncoding != nil {
+		log.ContentEncoding = *dbLog.ContentEncoding
+	}
+	if dbLog.SizeBytes != nil {
+		log.SizeBytes = *dbLog.SizeBytes
+	}
+	return log
+}
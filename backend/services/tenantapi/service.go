@@ -119,6 +119,7 @@ func (s *Service) ListTenants(ctx context.Context, req *connect.Request[homecall
 		Tenant.TenantID,
 		Tenant.Name,
 		Tenant.MaxDevices,
+		Tenant.RequireDeviceAttestation,
 	).FROM(
 		Tenant.
 			LEFT_JOIN(UserTenant, UserTenant.TenantID.EQ(Tenant.ID)).
@@ -133,11 +134,7 @@ func (s *Service) ListTenants(ctx context.Context, req *connect.Request[homecall
 
 	tenants := make([]*homecallv1alpha.Tenant, len(dbTenants))
 	for i, dbTenant := range dbTenants {
-		tenants[i] = &homecallv1alpha.Tenant{
-			Id:         dbTenant.TenantID,
-			Name:       dbTenant.Name,
-			MaxDevices: int64(dbTenant.MaxDevices),
-		}
+		tenants[i] = tenantToProto(dbTenant)
 
 	}
 
@@ -148,6 +145,50 @@ func (s *Service) ListTenants(ctx context.Context, req *connect.Request[homecall
 	}, nil
 }
 
+func (s *Service) UpdateTenant(ctx context.Context, req *connect.Request[homecallv1alpha.UpdateTenantRequest]) (*connect.Response[homecallv1alpha.UpdateTenantResponse], error) {
+	err := s.CanAccessTenant(ctx, req.Msg.GetId(), true)
+	if err != nil {
+		return nil, fmt.Errorf("failed access tenant: %w", err)
+	}
+
+	var dbTenant model.Tenant
+	err = util.WithTransaction(s.db, func(tx util.DB) error {
+		if req.Msg.RequireDeviceAttestation != nil {
+			_, err := Tenant.UPDATE().
+				SET(Tenant.RequireDeviceAttestation.SET(Bool(req.Msg.GetRequireDeviceAttestation()))).
+				WHERE(Tenant.TenantID.EQ(String(req.Msg.GetId()))).
+				ExecContext(ctx, tx)
+			if err != nil {
+				return fmt.Errorf("failed to update tenant: %w", err)
+			}
+		}
+
+		err := SELECT(
+			Tenant.TenantID,
+			Tenant.Name,
+			Tenant.MaxDevices,
+			Tenant.RequireDeviceAttestation,
+		).FROM(
+			Tenant,
+		).WHERE(
+			Tenant.TenantID.EQ(String(req.Msg.GetId())),
+		).QueryContext(ctx, tx, &dbTenant)
+		if err != nil {
+			return fmt.Errorf("failed to query tenant: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &connect.Response[homecallv1alpha.UpdateTenantResponse]{
+		Msg: &homecallv1alpha.UpdateTenantResponse{
+			Tenant: tenantToProto(dbTenant),
+		},
+	}, nil
+}
+
 func (s *Service) RemoveTenant(ctx context.Context, req *connect.Request[homecallv1alpha.RemoveTenantRequest]) (*connect.Response[homecallv1alpha.RemoveTenantResponse], error) {
 	err := s.CanAccessTenant(ctx, req.Msg.GetId(), true)
 	if err != nil {
@@ -463,6 +504,83 @@ func (s *Service) UpdateTenantMember(ctx context.Context, req *connect.Request[h
 	}, nil
 }
 
+// SetTenantMemberDeviceGroups scopes a tenant member to device groups of its tenant.
+func (s *Service) SetTenantMemberDeviceGroups(ctx context.Context, req *connect.Request[homecallv1alpha.SetTenantMemberDeviceGroupsRequest]) (*connect.Response[homecallv1alpha.SetTenantMemberDeviceGroupsResponse], error) {
+	memberId := req.Msg.GetMemberId()
+	err := s.CanAccessTenantMember(ctx, memberId, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed access tenant: %w", err)
+	}
+
+	var groupIds []Expression
+	if req.Msg.GetDeviceGroupScoped() {
+		seen := make(map[string]bool)
+		for _, groupId := range req.Msg.GetDeviceGroupIds() {
+			if seen[groupId] {
+				continue
+			}
+			seen[groupId] = true
+			groupIds = append(groupIds, String(groupId))
+		}
+	}
+
+	err = util.WithTransaction(s.db, func(tx util.DB) error {
+		_, err := UserTenant.UPDATE().
+			SET(UserTenant.DeviceGroupScoped.SET(Bool(req.Msg.GetDeviceGroupScoped()))).
+			WHERE(UserTenant.MemberID.EQ(String(memberId))).
+			ExecContext(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("failed to update user tenant: %w", err)
+		}
+
+		_, err = MemberDeviceGroup.DELETE().
+			WHERE(MemberDeviceGroup.MemberID.EQ(String(memberId))).
+			ExecContext(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("failed to delete member device groups: %w", err)
+		}
+		if len(groupIds) == 0 {
+			return nil
+		}
+
+		// Only groups of the tenant of the member can be assigned
+		res, err := MemberDeviceGroup.INSERT(
+			MemberDeviceGroup.MemberID,
+			MemberDeviceGroup.DeviceGroupID,
+		).QUERY(
+			SELECT(
+				String(memberId),
+				DeviceGroup.ID,
+			).FROM(
+				DeviceGroup,
+			).WHERE(
+				DeviceGroup.GroupID.IN(groupIds...).
+					AND(DeviceGroup.TenantID.EQ(IntExp(
+						SELECT(UserTenant.TenantID).FROM(UserTenant).WHERE(UserTenant.MemberID.EQ(String(memberId))),
+					))),
+			),
+		).ExecContext(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("failed to insert member device groups: %w", err)
+		}
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get affected rows: %w", err)
+		}
+		if affected != int64(len(groupIds)) {
+			return connect.NewError(connect.CodeInvalidArgument, errors.New("unknown device group"))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &connect.Response[homecallv1alpha.SetTenantMemberDeviceGroupsResponse]{
+		Msg: &homecallv1alpha.SetTenantMemberDeviceGroupsResponse{},
+	}, nil
+}
+
 func (s *Service) ListTenantMembers(ctx context.Context, req *connect.Request[homecallv1alpha.ListTenantMembersRequest]) (*connect.Response[homecallv1alpha.ListTenantMembersResponse], error) {
 	err := s.CanAccessTenant(ctx, req.Msg.GetTenantId(), true)
 	if err != nil {
@@ -475,6 +593,7 @@ func (s *Service) ListTenantMembers(ctx context.Context, req *connect.Request[ho
 		User.DisplayName,
 		UserTenant.MemberID,
 		UserTenant.Role,
+		UserTenant.DeviceGroupScoped,
 	).FROM(
 		UserTenant.
 			LEFT_JOIN(User, UserTenant.UserID.EQ(User.ID)).
@@ -490,6 +609,29 @@ func (s *Service) ListTenantMembers(ctx context.Context, req *connect.Request[ho
 		return nil, fmt.Errorf("failed to list tenant members: %w", err)
 	}
 
+	var dbMemberGroups []struct {
+		model.MemberDeviceGroup
+		model.DeviceGroup
+	}
+	err = SELECT(
+		MemberDeviceGroup.AllColumns,
+		DeviceGroup.ID,
+		DeviceGroup.GroupID,
+	).FROM(
+		MemberDeviceGroup.
+			INNER_JOIN(DeviceGroup, DeviceGroup.ID.EQ(MemberDeviceGroup.DeviceGroupID)).
+			INNER_JOIN(Tenant, Tenant.ID.EQ(DeviceGroup.TenantID)),
+	).WHERE(
+		Tenant.TenantID.EQ(String(req.Msg.GetTenantId())),
+	).ORDER_BY(DeviceGroup.Name).QueryContext(ctx, s.db, &dbMemberGroups)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list member device groups: %w", err)
+	}
+	memberGroupIds := make(map[string][]string)
+	for _, dbMemberGroup := range dbMemberGroups {
+		memberGroupIds[dbMemberGroup.MemberID] = append(memberGroupIds[dbMemberGroup.MemberID], dbMemberGroup.GroupID)
+	}
+
 	members := make([]*homecallv1alpha.TenantMember, len(dbMembers))
 	for i, dbMember := range dbMembers {
 		role := homecallv1alpha.Role_ROLE_MEMBER
@@ -498,12 +640,14 @@ func (s *Service) ListTenantMembers(ctx context.Context, req *connect.Request[ho
 		}
 
 		members[i] = &homecallv1alpha.TenantMember{
-			Id:            dbMember.UserTenant.MemberID,
-			TenantId:      req.Msg.GetTenantId(),
-			Subject:       dbMember.IdpUserID,
-			VerifiedEmail: normalizeEmail(dbMember.Email),
-			DisplayName:   dbMember.DisplayName,
-			Role:          role,
+			Id:                dbMember.UserTenant.MemberID,
+			TenantId:          req.Msg.GetTenantId(),
+			Subject:           dbMember.IdpUserID,
+			VerifiedEmail:     normalizeEmail(dbMember.Email),
+			DisplayName:       dbMember.DisplayName,
+			Role:              role,
+			DeviceGroupScoped: dbMember.DeviceGroupScoped,
+			DeviceGroupIds:    memberGroupIds[dbMember.UserTenant.MemberID],
 		}
 	}
 
@@ -657,7 +801,8 @@ func (s *Service) CanAccessDevice(ctx context.Context, deviceID string, adminReq
 	}
 
 	conditions := Device.DeviceID.EQ(String(deviceID)).
-		AND(User.IdpUserID.EQ(String(authDetails.Subject)))
+		AND(User.IdpUserID.EQ(String(authDetails.Subject))).
+		AND(memberDeviceGroupCondition(Device.GroupID))
 	if adminRequired {
 		conditions = conditions.AND(UserTenant.Role.EQ(enum.TenantRole.Admin))
 	}
@@ -685,6 +830,118 @@ func (s *Service) CanAccessDevice(ctx context.Context, deviceID string, adminReq
 	return nil
 }
 
+func tenantToProto(tenant model.Tenant) *homecallv1alpha.Tenant {
+	return &homecallv1alpha.Tenant{
+		Id:                       tenant.TenantID,
+		Name:                     tenant.Name,
+		MaxDevices:               int64(tenant.MaxDevices),
+		RequireDeviceAttestation: tenant.RequireDeviceAttestation,
+	}
+}
+
+// CanAccessDeviceGroup checks that the caller is a member of the tenant of a device group,
+// and for scoped members that the group is one of theirs.
+func (s *Service) CanAccessDeviceGroup(ctx context.Context, groupID string, adminRequired bool) error {
+	authDetails := auth.GetAuth(ctx)
+	if authDetails == nil {
+		return ErrNoAccess
+	}
+
+	conditions := DeviceGroup.GroupID.EQ(String(groupID)).
+		AND(User.IdpUserID.EQ(String(authDetails.Subject))).
+		AND(memberDeviceGroupCondition(DeviceGroup.ID))
+	if adminRequired {
+		conditions = conditions.AND(UserTenant.Role.EQ(enum.TenantRole.Admin))
+	}
+
+	stmt := SELECT(COUNT(User.ID).AS("count")).FROM(
+		DeviceGroup.
+			LEFT_JOIN(Tenant, DeviceGroup.TenantID.EQ(Tenant.ID)).
+			LEFT_JOIN(UserTenant, UserTenant.TenantID.EQ(Tenant.ID)).
+			LEFT_JOIN(User, User.ID.EQ(UserTenant.UserID)),
+	).WHERE(conditions).GROUP_BY(User.ID).LIMIT(1)
+	var result struct{ Count int }
+	err := stmt.QueryContext(ctx, s.db, &result)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return ErrNoAccess
+		}
+		return fmt.Errorf("failed to query device group access: %w", err)
+	}
+
+	if result.Count == 0 {
+		return ErrNoAccess
+	}
+
+	return nil
+}
+
+// DeviceGroupScope returns a query selecting the internal IDs of the device groups the caller is scoped to,
+// or nil if the caller has access to all devices of the tenant.
+func (s *Service) DeviceGroupScope(ctx context.Context, tenantID string) (SelectStatement, error) {
+	member, err := s.currentMember(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !member.DeviceGroupScoped {
+		return nil, nil
+	}
+	return SELECT(MemberDeviceGroup.DeviceGroupID).
+		FROM(MemberDeviceGroup).
+		WHERE(MemberDeviceGroup.MemberID.EQ(String(member.MemberID))), nil
+}
+
+// MemberID returns the member ID of the caller in a tenant.
+func (s *Service) MemberID(ctx context.Context, tenantID string) (string, error) {
+	member, err := s.currentMember(ctx, tenantID)
+	if err != nil {
+		return "", err
+	}
+	return member.MemberID, nil
+}
+
+// currentMember returns the membership of the caller in a tenant.
+func (s *Service) currentMember(ctx context.Context, tenantID string) (model.UserTenant, error) {
+	authDetails := auth.GetAuth(ctx)
+	if authDetails == nil {
+		return model.UserTenant{}, ErrNoAccess
+	}
+
+	var member model.UserTenant
+	err := SELECT(
+		UserTenant.MemberID,
+		UserTenant.Role,
+		UserTenant.DeviceGroupScoped,
+	).FROM(
+		UserTenant.
+			INNER_JOIN(User, User.ID.EQ(UserTenant.UserID)).
+			INNER_JOIN(Tenant, Tenant.ID.EQ(UserTenant.TenantID)),
+	).WHERE(
+		Tenant.TenantID.EQ(String(tenantID)).
+			AND(User.IdpUserID.EQ(String(authDetails.Subject))),
+	).LIMIT(1).QueryContext(ctx, s.db, &member)
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return model.UserTenant{}, ErrNoAccess
+		}
+		return model.UserTenant{}, fmt.Errorf("failed to query tenant member: %w", err)
+	}
+	return member, nil
+}
+
+// memberDeviceGroupCondition limits members that are scoped to device groups to their groups,
+// it must be used in queries that join UserTenant.
+func memberDeviceGroupCondition(groupColumn ColumnInteger) BoolExpression {
+	return UserTenant.DeviceGroupScoped.IS_FALSE().OR(
+		groupColumn.IN(
+			SELECT(MemberDeviceGroup.DeviceGroupID).
+				FROM(MemberDeviceGroup).
+				WHERE(MemberDeviceGroup.MemberID.EQ(UserTenant.MemberID)),
+		),
+	)
+}
+
 func generateTenantID(name string) (string, error) {
 	allowed := "abcdefghijklmnopqrstuvwxyz0123456789-"
 	tenantID := ""
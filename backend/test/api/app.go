@@ -20,6 +20,7 @@ type TestApp struct {
 	runError chan error
 	cancel   context.CancelFunc
 	pg       *TestPostgres
+	blobDir  string
 }
 
 type TestAppConfig struct {
@@ -101,9 +102,24 @@ func (a *TestApp) Start(ctx context.Context) error {
 	cfg.DBPassword = dbConfig.Password
 	cfg.DBName = dbConfig.Database
 	cfg.Port = port
+	cfg.PublicURL = fmt.Sprintf("http://localhost:%s", port)
 	cfg.AuthDisabled = true
 	cfg.JitsiKeyRaw = dummyPemKey
 	cfg.MockNotificationsDir = a.config.NotificationDir
+	a.blobDir, err = os.MkdirTemp("", "homecall-blobs")
+	if err != nil {
+		return fmt.Errorf("failed to create blob store directory: %w", err)
+	}
+	cfg.BlobStoreDir = a.blobDir
+	cfg.SchedulerInterval = 200 * time.Millisecond
+	cfg.MissedCallTimeout = 3 * time.Second
+	// Lets tests see devices go offline without waiting for long
+	cfg.PresenceBackgroundTimeout = 2 * time.Second
+	cfg.DeviceWatchInterval = 200 * time.Millisecond
+	// Lets tests act as different clients when pairing
+	cfg.PairingCodeSourceHeader = "X-Forwarded-For"
+	// Lets tests reach the limit of failed pairing code attempts from all sources
+	cfg.PairingCodeMaxFailedAttempts = 30
 
 	a.port = port
 
@@ -126,7 +142,8 @@ func (a *TestApp) Start(ctx context.Context) error {
 func (a *TestApp) Stop() error {
 	a.cancel()
 	pgErr := a.pg.Stop()
-	return errors.Join(pgErr, <-a.runError)
+	runErr := <-a.runError
+	return errors.Join(pgErr, runErr, os.RemoveAll(a.blobDir))
 }
 
 func waitForAddress(ctx context.Context, address string) error {
@@ -0,0 +1,1266 @@
+package api
+
+import (
+	"connectrpc.com/connect"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	homecallv1alpha "sidus.io/home-call/gen/connect/homecall/v1alpha"
+	"sidus.io/home-call/gen/connect/homecall/v1alpha/homecallv1alphaconnect"
+	"sidus.io/home-call/services/auth"
+	"sidus.io/home-call/util"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWatchDevices(t *testing.T) {
+	t.Parallel()
+	ctx := testContext(t)
+	adminUser := randomUser()
+	tenant, err := createTestTenant(t.Name(), adminUser, globalTestApp.TenantClient())
+	require.NoError(t, err)
+
+	stream, err := globalTestApp.OfficeClient().WatchDevices(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.WatchDevicesRequest]{
+		Msg: &homecallv1alpha.WatchDevicesRequest{
+			TenantId: tenant.Id,
+		},
+	}))
+	require.NoError(t, err)
+	defer stream.Close()
+
+	// Initial snapshot
+	require.True(t, stream.Receive(), stream.Err())
+	require.NotNil(t, stream.Msg().GetSnapshot())
+	assert.Empty(t, stream.Msg().GetSnapshot().GetDevices())
+
+	nextEvent := func(t *testing.T) *homecallv1alpha.DeviceEvent {
+		t.Helper()
+		require.True(t, stream.Receive(), stream.Err())
+		require.NotNil(t, stream.Msg().GetDeviceEvent())
+		return stream.Msg().GetDeviceEvent()
+	}
+
+	device, err := globalTestApp.OfficeClient().CreateDevice(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.CreateDeviceRequest]{
+		Msg: &homecallv1alpha.CreateDeviceRequest{
+			Name:            "before",
+			TenantId:        tenant.Id,
+			DefaultSettings: &homecallv1alpha.DeviceSettings{},
+		},
+	}))
+	require.NoError(t, err)
+	deviceId := device.Msg.GetDevice().GetId()
+
+	event := nextEvent(t)
+	assert.Equal(t, homecallv1alpha.DeviceEventType_DEVICE_EVENT_TYPE_ADDED, event.GetType())
+	assert.Equal(t, deviceId, event.GetDevice().GetId())
+
+	_, err = globalTestApp.OfficeClient().UpdateDevice(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.UpdateDeviceRequest]{
+		Msg: &homecallv1alpha.UpdateDeviceRequest{
+			DeviceId: deviceId,
+			Name:     "after",
+		},
+	}))
+	require.NoError(t, err)
+
+	event = nextEvent(t)
+	assert.Equal(t, homecallv1alpha.DeviceEventType_DEVICE_EVENT_TYPE_RENAMED, event.GetType())
+	assert.Equal(t, "after", event.GetDevice().GetName())
+
+	// Updates that keep the name are not reported as renames
+	_, err = globalTestApp.OfficeClient().UpdateDevice(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.UpdateDeviceRequest]{
+		Msg: &homecallv1alpha.UpdateDeviceRequest{
+			DeviceId: deviceId,
+			TimeZone: "Europe/Stockholm",
+		},
+	}))
+	require.NoError(t, err)
+
+	_, err = globalTestApp.OfficeClient().RemoveDevice(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.RemoveDeviceRequest]{
+		Msg: &homecallv1alpha.RemoveDeviceRequest{
+			DeviceId: deviceId,
+		},
+	}))
+	require.NoError(t, err)
+
+	event = nextEvent(t)
+	assert.Equal(t, homecallv1alpha.DeviceEventType_DEVICE_EVENT_TYPE_REMOVED, event.GetType())
+	assert.Equal(t, deviceId, event.GetDevice().GetId())
+}
+
+func TestWatchDevicesEnrollmentAndPresence(t *testing.T) {
+	t.Parallel()
+	ctx := testContext(t)
+	adminUser := randomUser()
+	tenant, err := createTestTenant(t.Name(), adminUser, globalTestApp.TenantClient())
+	require.NoError(t, err)
+
+	stream, err := globalTestApp.OfficeClient().WatchDevices(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.WatchDevicesRequest]{
+		Msg: &homecallv1alpha.WatchDevicesRequest{
+			TenantId: tenant.Id,
+		},
+	}))
+	require.NoError(t, err)
+	defer stream.Close()
+
+	require.True(t, stream.Receive(), stream.Err())
+	require.NotNil(t, stream.Msg().GetSnapshot())
+
+	nextEvent := func(t *testing.T) *homecallv1alpha.DeviceEvent {
+		t.Helper()
+		require.True(t, stream.Receive(), stream.Err())
+		require.NotNil(t, stream.Msg().GetDeviceEvent())
+		return stream.Msg().GetDeviceEvent()
+	}
+
+	device, err := createEnrolledTestDevice(ctx, tenant.Id, adminUser, globalTestApp.OfficeClient(), globalTestApp.DeviceClient())
+	require.NoError(t, err)
+
+	event := nextEvent(t)
+	assert.Equal(t, homecallv1alpha.DeviceEventType_DEVICE_EVENT_TYPE_ADDED, event.GetType())
+	assert.False(t, event.GetDevice().GetEnrolled())
+
+	event = nextEvent(t)
+	assert.Equal(t, homecallv1alpha.DeviceEventType_DEVICE_EVENT_TYPE_ENROLLED, event.GetType())
+	assert.Equal(t, device.ID, event.GetDevice().GetId())
+	assert.True(t, event.GetDevice().GetEnrolled())
+	assert.False(t, event.GetDevice().GetOnline())
+
+	_, err = globalTestApp.DeviceClient().Heartbeat(ctx, auth.WithToken(device.mustToken(t), &connect.Request[homecallv1alpha.HeartbeatRequest]{
+		Msg: &homecallv1alpha.HeartbeatRequest{
+			State: &homecallv1alpha.DeviceState{AppState: homecallv1alpha.AppState_APP_STATE_BACKGROUND},
+		},
+	}))
+	require.NoError(t, err)
+
+	event = nextEvent(t)
+	assert.Equal(t, homecallv1alpha.DeviceEventType_DEVICE_EVENT_TYPE_ONLINE, event.GetType())
+	assert.Equal(t, device.ID, event.GetDevice().GetId())
+	assert.True(t, event.GetDevice().GetOnline())
+
+	// The test app considers devices in the background offline two seconds after their last heartbeat
+	event = nextEvent(t)
+	assert.Equal(t, homecallv1alpha.DeviceEventType_DEVICE_EVENT_TYPE_OFFLINE, event.GetType())
+	assert.Equal(t, device.ID, event.GetDevice().GetId())
+	assert.False(t, event.GetDevice().GetOnline())
+}
+
+func TestWatchDevicesNonMember(t *testing.T) {
+	t.Parallel()
+	ctx := testContext(t)
+	adminUser := randomUser()
+	tenant, err := createTestTenant(t.Name(), adminUser, globalTestApp.TenantClient())
+	require.NoError(t, err)
+
+	stream, err := globalTestApp.OfficeClient().WatchDevices(ctx, auth.WithDummyToken(randomUser(), &connect.Request[homecallv1alpha.WatchDevicesRequest]{
+		Msg: &homecallv1alpha.WatchDevicesRequest{
+			TenantId: tenant.Id,
+		},
+	}))
+	require.NoError(t, err)
+	defer stream.Close()
+
+	require.False(t, stream.Receive())
+	require.Error(t, stream.Err())
+}
+
+func TestDeviceDiagnostics(t *testing.T) {
+	t.Parallel()
+	ctx := testContext(t)
+	adminUser := randomUser()
+	tenant, err := createTestTenant(t.Name(), adminUser, globalTestApp.TenantClient())
+	require.NoError(t, err)
+
+	device, err := createEnrolledTestDevice(ctx, tenant.Id, adminUser, globalTestApp.OfficeClient(), globalTestApp.DeviceClient())
+	require.NoError(t, err)
+	deviceToken, err := device.Token()
+	require.NoError(t, err)
+
+	diagnostics := &homecallv1alpha.DeviceDiagnostics{
+		AppVersion: "1.2.3",
+		OsName:     "android",
+		OsVersion:  "14",
+		Permissions: &homecallv1alpha.DevicePermissions{
+			Notifications: homecallv1alpha.PermissionState_PERMISSION_STATE_DENIED,
+			Camera:        homecallv1alpha.PermissionState_PERMISSION_STATE_GRANTED,
+			Microphone:    homecallv1alpha.PermissionState_PERMISSION_STATE_GRANTED,
+		},
+		LastPushReceived: timestamppb.Now(),
+		RecentErrors: []*homecallv1alpha.DiagnosticsError{
+			{
+				OccurredAt: timestamppb.Now(),
+				Source:     "push",
+				Message:    "notification permission denied",
+			},
+		},
+	}
+	_, err = globalTestApp.DeviceClient().ReportDiagnostics(ctx, auth.WithToken(deviceToken, &connect.Request[homecallv1alpha.ReportDiagnosticsRequest]{
+		Msg: &homecallv1alpha.ReportDiagnosticsRequest{
+			Diagnostics: diagnostics,
+		},
+	}))
+	require.NoError(t, err)
+
+	reports, err := globalTestApp.OfficeClient().GetDeviceDiagnostics(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.GetDeviceDiagnosticsRequest]{
+		Msg: &homecallv1alpha.GetDeviceDiagnosticsRequest{
+			DeviceId: device.ID,
+		},
+	}))
+	require.NoError(t, err)
+	require.Len(t, reports.Msg.GetReports(), 1)
+	assert.True(t, proto.Equal(diagnostics, reports.Msg.GetReports()[0].GetDiagnostics()))
+
+	// Non-members can not read diagnostics
+	_, err = globalTestApp.OfficeClient().GetDeviceDiagnostics(ctx, auth.WithDummyToken(randomUser(), &connect.Request[homecallv1alpha.GetDeviceDiagnosticsRequest]{
+		Msg: &homecallv1alpha.GetDeviceDiagnosticsRequest{
+			DeviceId: device.ID,
+		},
+	}))
+	require.Error(t, err)
+}
+
+func TestDeviceLogs(t *testing.T) {
+	t.Parallel()
+	ctx := testContext(t)
+	adminUser := randomUser()
+	tenant, err := createTestTenant(t.Name(), adminUser, globalTestApp.TenantClient())
+	require.NoError(t, err)
+
+	device, err := createEnrolledTestDevice(ctx, tenant.Id, adminUser, globalTestApp.OfficeClient(), globalTestApp.DeviceClient())
+	require.NoError(t, err)
+
+	_, err = globalTestApp.DeviceClient().UpdateNotificationToken(ctx, auth.WithToken(device.mustToken(t), &connect.Request[homecallv1alpha.UpdateNotificationTokenRequest]{
+		Msg: &homecallv1alpha.UpdateNotificationTokenRequest{
+			NotificationToken: randomUser(),
+		},
+	}))
+	require.NoError(t, err)
+
+	requested, err := globalTestApp.OfficeClient().RequestDeviceLogs(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.RequestDeviceLogsRequest]{
+		Msg: &homecallv1alpha.RequestDeviceLogsRequest{
+			DeviceId: device.ID,
+		},
+	}))
+	require.NoError(t, err)
+	logId := requested.Msg.GetLog().GetId()
+	require.NotEmpty(t, logId)
+
+	upload := func(logId string, chunks ...[]byte) (*connect.Response[homecallv1alpha.UploadLogsResponse], error) {
+		stream := globalTestApp.DeviceClient().UploadLogs(ctx)
+		stream.RequestHeader().Set("Authorization", "Bearer "+device.mustToken(t))
+		err := stream.Send(&homecallv1alpha.UploadLogsRequest{
+			Data: &homecallv1alpha.UploadLogsRequest_Metadata{
+				Metadata: &homecallv1alpha.UploadLogsMetadata{
+					LogId:           logId,
+					ContentEncoding: "identity",
+				},
+			},
+		})
+		if err != nil {
+			return stream.CloseAndReceive()
+		}
+		for _, chunk := range chunks {
+			err = stream.Send(&homecallv1alpha.UploadLogsRequest{
+				Data: &homecallv1alpha.UploadLogsRequest_Chunk{
+					Chunk: chunk,
+				},
+			})
+			if err != nil {
+				return stream.CloseAndReceive()
+			}
+		}
+		return stream.CloseAndReceive()
+	}
+
+	// Unknown logs can not be uploaded
+	_, err = upload("unknown", []byte("hello"))
+	require.Error(t, err)
+
+	uploaded, err := upload(logId, []byte("hello "), []byte("world"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(len("hello world")), uploaded.Msg.GetSizeBytes())
+
+	// A log can only be uploaded once
+	_, err = upload(logId, []byte("again"))
+	require.Error(t, err)
+
+	logs, err := globalTestApp.OfficeClient().ListDeviceLogs(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.ListDeviceLogsRequest]{
+		Msg: &homecallv1alpha.ListDeviceLogsRequest{
+			DeviceId: device.ID,
+		},
+	}))
+	require.NoError(t, err)
+	require.Len(t, logs.Msg.GetLogs(), 1)
+	assert.Equal(t, homecallv1alpha.DeviceLogStatus_DEVICE_LOG_STATUS_UPLOADED, logs.Msg.GetLogs()[0].GetStatus())
+
+	downloaded, err := globalTestApp.OfficeClient().DownloadDeviceLog(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.DownloadDeviceLogRequest]{
+		Msg: &homecallv1alpha.DownloadDeviceLogRequest{
+			LogId: logId,
+		},
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, "hello world", string(downloaded.Msg.GetContent()))
+	assert.Equal(t, "identity", downloaded.Msg.GetLog().GetContentEncoding())
+
+	// Non-members can not download logs, or tell them apart from logs that do not exist
+	_, err = globalTestApp.OfficeClient().DownloadDeviceLog(ctx, auth.WithDummyToken(randomUser(), &connect.Request[homecallv1alpha.DownloadDeviceLogRequest]{
+		Msg: &homecallv1alpha.DownloadDeviceLogRequest{
+			LogId: logId,
+		},
+	}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	_, err = globalTestApp.OfficeClient().DownloadDeviceLog(ctx, auth.WithDummyToken(randomUser(), &connect.Request[homecallv1alpha.DownloadDeviceLogRequest]{
+		Msg: &homecallv1alpha.DownloadDeviceLogRequest{
+			LogId: uuid.New().String(),
+		},
+	}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+func TestRegenerateEnrollmentKey(t *testing.T) {
+	t.Parallel()
+	ctx := testContext(t)
+	adminUser := randomUser()
+	tenant, err := createTestTenant(t.Name(), adminUser, globalTestApp.TenantClient())
+	require.NoError(t, err)
+
+	device, err := globalTestApp.OfficeClient().CreateDevice(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.CreateDeviceRequest]{
+		Msg: &homecallv1alpha.CreateDeviceRequest{
+			Name:            "device",
+			TenantId:        tenant.Id,
+			DefaultSettings: &homecallv1alpha.DeviceSettings{},
+		},
+	}))
+	require.NoError(t, err)
+	oldKey := device.Msg.GetDevice().GetEnrollmentKey()
+	assert.NotNil(t, device.Msg.GetDevice().GetEnrollmentKeyExpiresAt())
+
+	// Keys are stored hashed and never returned again
+	devices, err := globalTestApp.OfficeClient().ListDevices(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.ListDevicesRequest]{
+		Msg: &homecallv1alpha.ListDevicesRequest{
+			TenantId: tenant.Id,
+		},
+	}))
+	require.NoError(t, err)
+	require.Len(t, devices.Msg.GetDevices(), 1)
+	assert.Empty(t, devices.Msg.GetDevices()[0].GetEnrollmentKey())
+	assert.False(t, devices.Msg.GetDevices()[0].GetEnrolled())
+	assert.NotNil(t, devices.Msg.GetDevices()[0].GetEnrollmentKeyExpiresAt())
+
+	_, publicKey, err := generateTestKey()
+	require.NoError(t, err)
+
+	regenerated, err := globalTestApp.OfficeClient().RegenerateEnrollmentKey(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.RegenerateEnrollmentKeyRequest]{
+		Msg: &homecallv1alpha.RegenerateEnrollmentKeyRequest{
+			DeviceId: device.Msg.GetDevice().GetId(),
+		},
+	}))
+	require.NoError(t, err)
+	newKey := regenerated.Msg.GetDevice().GetEnrollmentKey()
+	require.NotEmpty(t, newKey)
+	assert.NotEqual(t, oldKey, newKey)
+
+	_, err = globalTestApp.DeviceClient().Enroll(ctx, &connect.Request[homecallv1alpha.EnrollRequest]{
+		Msg: &homecallv1alpha.EnrollRequest{
+			EnrollmentKey: oldKey,
+			PublicKey:     publicKey,
+		},
+	})
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+
+	_, err = globalTestApp.DeviceClient().Enroll(ctx, &connect.Request[homecallv1alpha.EnrollRequest]{
+		Msg: &homecallv1alpha.EnrollRequest{
+			EnrollmentKey: newKey,
+			PublicKey:     publicKey,
+		},
+	})
+	require.NoError(t, err)
+
+	// Enrolled devices have no enrollment key to regenerate
+	_, err = globalTestApp.OfficeClient().RegenerateEnrollmentKey(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.RegenerateEnrollmentKeyRequest]{
+		Msg: &homecallv1alpha.RegenerateEnrollmentKeyRequest{
+			DeviceId: device.Msg.GetDevice().GetId(),
+		},
+	}))
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+}
+
+func TestEnrollWithQRPayload(t *testing.T) {
+	t.Parallel()
+	ctx := testContext(t)
+	adminUser := randomUser()
+	tenant, err := createTestTenant(t.Name(), adminUser, globalTestApp.TenantClient())
+	require.NoError(t, err)
+
+	device, err := globalTestApp.OfficeClient().CreateDevice(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.CreateDeviceRequest]{
+		Msg: &homecallv1alpha.CreateDeviceRequest{
+			Name:            "device",
+			TenantId:        tenant.Id,
+			DefaultSettings: &homecallv1alpha.DeviceSettings{},
+		},
+	}))
+	require.NoError(t, err)
+	require.NotEmpty(t, device.Msg.GetDevice().GetEnrollmentQrPayload())
+
+	_, publicKey, err := generateTestKey()
+	require.NoError(t, err)
+
+	// Tampered payloads are rejected
+	_, err = globalTestApp.DeviceClient().Enroll(ctx, &connect.Request[homecallv1alpha.EnrollRequest]{
+		Msg: &homecallv1alpha.EnrollRequest{
+			QrPayload: device.Msg.GetDevice().GetEnrollmentQrPayload() + "x",
+			PublicKey: publicKey,
+		},
+	})
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+
+	enrolled, err := globalTestApp.DeviceClient().Enroll(ctx, &connect.Request[homecallv1alpha.EnrollRequest]{
+		Msg: &homecallv1alpha.EnrollRequest{
+			QrPayload: device.Msg.GetDevice().GetEnrollmentQrPayload(),
+			PublicKey: publicKey,
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, device.Msg.GetDevice().GetId(), enrolled.Msg.GetDeviceId())
+}
+
+func TestEnrollConcurrently(t *testing.T) {
+	t.Parallel()
+	ctx := testContext(t)
+	adminUser := randomUser()
+	tenant, err := createTestTenant(t.Name(), adminUser, globalTestApp.TenantClient())
+	require.NoError(t, err)
+
+	device, err := globalTestApp.OfficeClient().CreateDevice(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.CreateDeviceRequest]{
+		Msg: &homecallv1alpha.CreateDeviceRequest{
+			Name:            "device",
+			TenantId:        tenant.Id,
+			DefaultSettings: &homecallv1alpha.DeviceSettings{},
+		},
+	}))
+	require.NoError(t, err)
+
+	// The same enrollment key can only be used once, even when requests race
+	const attempts = 5
+	errs := make(chan error, attempts)
+	for i := 0; i < attempts; i++ {
+		_, publicKey, err := generateTestKey()
+		require.NoError(t, err)
+		go func() {
+			_, err := globalTestApp.DeviceClient().Enroll(ctx, &connect.Request[homecallv1alpha.EnrollRequest]{
+				Msg: &homecallv1alpha.EnrollRequest{
+					EnrollmentKey: device.Msg.GetDevice().GetEnrollmentKey(),
+					PublicKey:     publicKey,
+				},
+			})
+			errs <- err
+		}()
+	}
+
+	succeeded := 0
+	for i := 0; i < attempts; i++ {
+		err := <-errs
+		if err == nil {
+			succeeded++
+			continue
+		}
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	}
+	assert.Equal(t, 1, succeeded)
+}
+
+func TestEnrollWithPairingCode(t *testing.T) {
+	// Not parallel, failed pairing code attempts are rate limited per source
+	ctx := testContext(t)
+	adminUser := randomUser()
+	tenant, err := createTestTenant(t.Name(), adminUser, globalTestApp.TenantClient())
+	require.NoError(t, err)
+
+	device, err := globalTestApp.OfficeClient().CreateDevice(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.CreateDeviceRequest]{
+		Msg: &homecallv1alpha.CreateDeviceRequest{
+			Name:            "device",
+			TenantId:        tenant.Id,
+			DefaultSettings: &homecallv1alpha.DeviceSettings{},
+		},
+	}))
+	require.NoError(t, err)
+	pairingCode := device.Msg.GetDevice().GetPairingCode()
+	require.Len(t, pairingCode, 8)
+	assert.NotNil(t, device.Msg.GetDevice().GetPairingCodeExpiresAt())
+
+	_, publicKey, err := generateTestKey()
+	require.NoError(t, err)
+
+	enrolled, err := globalTestApp.DeviceClient().Enroll(ctx, &connect.Request[homecallv1alpha.EnrollRequest]{
+		Msg: &homecallv1alpha.EnrollRequest{
+			PairingCode: pairingCode,
+			PublicKey:   publicKey,
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, device.Msg.GetDevice().GetId(), enrolled.Msg.GetDeviceId())
+
+	// The test app takes the client address from X-Forwarded-For, like it would behind a load balancer
+	enrollFrom := func(forwardedFor string) error {
+		req := &connect.Request[homecallv1alpha.EnrollRequest]{
+			Msg: &homecallv1alpha.EnrollRequest{
+				PairingCode: pairingCode,
+				PublicKey:   publicKey,
+			},
+		}
+		req.Header().Set("X-Forwarded-For", forwardedFor)
+		_, err := globalTestApp.DeviceClient().Enroll(ctx, req)
+		return err
+	}
+
+	// Guessing codes is cut off after a number of failed attempts
+	var lastErr error
+	for i := 0; i < 20; i++ {
+		lastErr = enrollFrom("203.0.113.1")
+		require.Error(t, lastErr)
+		if connect.CodeOf(lastErr) == connect.CodeResourceExhausted {
+			break
+		}
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(lastErr))
+	}
+	assert.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(lastErr))
+
+	// Addresses added by the client before the one of the proxy are ignored
+	err = enrollFrom("203.0.113.2, 203.0.113.1")
+	assert.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
+
+	// Other clients behind the same load balancer can still pair
+	err = enrollFrom("203.0.113.2")
+	assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+}
+
+func TestPairingCodeUnderAttack(t *testing.T) {
+	// Not parallel, failed pairing code attempts are counted across all sources
+	ctx := testContext(t)
+	adminUser := randomUser()
+	tenant, err := createTestTenant(t.Name(), adminUser, globalTestApp.TenantClient())
+	require.NoError(t, err)
+
+	device, err := globalTestApp.OfficeClient().CreateDevice(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.CreateDeviceRequest]{
+		Msg: &homecallv1alpha.CreateDeviceRequest{
+			Name:            "device",
+			TenantId:        tenant.Id,
+			DefaultSettings: &homecallv1alpha.DeviceSettings{},
+		},
+	}))
+	require.NoError(t, err)
+	_, publicKey, err := generateTestKey()
+	require.NoError(t, err)
+
+	enrollFrom := func(forwardedFor string, pairingCode string) error {
+		req := &connect.Request[homecallv1alpha.EnrollRequest]{
+			Msg: &homecallv1alpha.EnrollRequest{
+				PairingCode: pairingCode,
+				PublicKey:   publicKey,
+			},
+		}
+		req.Header().Set("X-Forwarded-For", forwardedFor)
+		_, err := globalTestApp.DeviceClient().Enroll(ctx, req)
+		return err
+	}
+
+	// Guess from many sources until the attempts from all sources together are limited
+	throttled := false
+	for i := 1; i <= 10 && !throttled; i++ {
+		source := fmt.Sprintf("198.51.100.%d", i)
+		for attempt := 0; attempt < 20; attempt++ {
+			err := enrollFrom(source, "wrong-code")
+			if connect.CodeOf(err) == connect.CodeResourceExhausted {
+				throttled = attempt == 1
+				break
+			}
+			require.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+		}
+	}
+	require.True(t, throttled, "sources should only be allowed one failed attempt")
+
+	// Sources that have not failed can still pair with a valid code
+	err = enrollFrom("198.51.100.200", device.Msg.GetDevice().GetPairingCode())
+	require.NoError(t, err)
+}
+
+func TestRotateKey(t *testing.T) {
+	t.Parallel()
+	ctx := testContext(t)
+	adminUser := randomUser()
+	tenant, err := createTestTenant(t.Name(), adminUser, globalTestApp.TenantClient())
+	require.NoError(t, err)
+
+	device, err := createEnrolledTestDevice(ctx, tenant.Id, adminUser, globalTestApp.OfficeClient(), globalTestApp.DeviceClient())
+	require.NoError(t, err)
+	oldToken := device.mustToken(t)
+	unusedOldToken := device.mustToken(t)
+
+	newKey, newPublicKey, err := generateTestKey()
+	require.NoError(t, err)
+
+	_, err = globalTestApp.DeviceClient().RotateKey(ctx, auth.WithToken(oldToken, &connect.Request[homecallv1alpha.RotateKeyRequest]{
+		Msg: &homecallv1alpha.RotateKeyRequest{
+			PublicKey: newPublicKey,
+		},
+	}))
+	require.NoError(t, err)
+
+	// The old key is no longer accepted
+	_, err = globalTestApp.DeviceClient().Heartbeat(ctx, auth.WithToken(unusedOldToken, &connect.Request[homecallv1alpha.HeartbeatRequest]{
+		Msg: &homecallv1alpha.HeartbeatRequest{},
+	}))
+	require.Error(t, err)
+
+	device.key = newKey
+	newToken, err := device.Token()
+	require.NoError(t, err)
+	_, err = globalTestApp.DeviceClient().Heartbeat(ctx, auth.WithToken(newToken, &connect.Request[homecallv1alpha.HeartbeatRequest]{
+		Msg: &homecallv1alpha.HeartbeatRequest{},
+	}))
+	require.NoError(t, err)
+}
+
+func TestResetDeviceEnrollment(t *testing.T) {
+	t.Parallel()
+	ctx := testContext(t)
+	adminUser := randomUser()
+	tenant, err := createTestTenant(t.Name(), adminUser, globalTestApp.TenantClient())
+	require.NoError(t, err)
+
+	device, err := createEnrolledTestDevice(ctx, tenant.Id, adminUser, globalTestApp.OfficeClient(), globalTestApp.DeviceClient())
+	require.NoError(t, err)
+	oldToken, err := device.Token()
+	require.NoError(t, err)
+
+	reset, err := globalTestApp.OfficeClient().ResetDeviceEnrollment(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.ResetDeviceEnrollmentRequest]{
+		Msg: &homecallv1alpha.ResetDeviceEnrollmentRequest{
+			DeviceId: device.ID,
+			Settings: &homecallv1alpha.DeviceSettings{},
+		},
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, device.ID, reset.Msg.GetDevice().GetId())
+	assert.False(t, reset.Msg.GetDevice().GetEnrolled())
+	require.NotEmpty(t, reset.Msg.GetDevice().GetEnrollmentKey())
+
+	// The old installation can no longer authenticate
+	_, err = globalTestApp.DeviceClient().Heartbeat(ctx, auth.WithToken(oldToken, &connect.Request[homecallv1alpha.HeartbeatRequest]{
+		Msg: &homecallv1alpha.HeartbeatRequest{},
+	}))
+	require.Error(t, err)
+
+	_, publicKey, err := generateTestKey()
+	require.NoError(t, err)
+	enrolled, err := globalTestApp.DeviceClient().Enroll(ctx, &connect.Request[homecallv1alpha.EnrollRequest]{
+		Msg: &homecallv1alpha.EnrollRequest{
+			EnrollmentKey: reset.Msg.GetDevice().GetEnrollmentKey(),
+			PublicKey:     publicKey,
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, device.ID, enrolled.Msg.GetDeviceId())
+}
+
+func TestEnrollKeyAlgorithms(t *testing.T) {
+	t.Parallel()
+	ctx := testContext(t)
+	adminUser := randomUser()
+	tenant, err := createTestTenant(t.Name(), adminUser, globalTestApp.TenantClient())
+	require.NoError(t, err)
+
+	p256Key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	p384Key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+	_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	for name, tc := range map[string]struct {
+		key    crypto.Signer
+		method jwt.SigningMethod
+	}{
+		"ecdsa p256": {key: p256Key, method: jwt.SigningMethodES256},
+		"ecdsa p384": {key: p384Key, method: jwt.SigningMethodES384},
+		"ed25519":    {key: ed25519Key, method: jwt.SigningMethodEdDSA},
+	} {
+		t.Run(name, func(t *testing.T) {
+			device, err := globalTestApp.OfficeClient().CreateDevice(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.CreateDeviceRequest]{
+				Msg: &homecallv1alpha.CreateDeviceRequest{
+					Name:            name,
+					TenantId:        tenant.Id,
+					DefaultSettings: &homecallv1alpha.DeviceSettings{},
+				},
+			}))
+			require.NoError(t, err)
+
+			publicKey, err := x509.MarshalPKIXPublicKey(tc.key.Public())
+			require.NoError(t, err)
+			_, err = globalTestApp.DeviceClient().Enroll(ctx, &connect.Request[homecallv1alpha.EnrollRequest]{
+				Msg: &homecallv1alpha.EnrollRequest{
+					EnrollmentKey: device.Msg.GetDevice().GetEnrollmentKey(),
+					PublicKey:     string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey})),
+				},
+			})
+			require.NoError(t, err)
+
+			enrolledDevice := &testDevice{
+				ID:     device.Msg.GetDevice().GetId(),
+				key:    tc.key,
+				method: tc.method,
+			}
+			deviceToken, err := enrolledDevice.Token()
+			require.NoError(t, err)
+			_, err = globalTestApp.DeviceClient().Heartbeat(ctx, auth.WithToken(deviceToken, &connect.Request[homecallv1alpha.HeartbeatRequest]{
+				Msg: &homecallv1alpha.HeartbeatRequest{},
+			}))
+			require.NoError(t, err)
+
+			// Tokens signed with another algorithm than the key's are rejected
+			rsaDevice := &testDevice{
+				ID:  enrolledDevice.ID,
+				key: mustGenerateRSAKey(t),
+			}
+			rsaToken, err := rsaDevice.Token()
+			require.NoError(t, err)
+			_, err = globalTestApp.DeviceClient().Heartbeat(ctx, auth.WithToken(rsaToken, &connect.Request[homecallv1alpha.HeartbeatRequest]{
+				Msg: &homecallv1alpha.HeartbeatRequest{},
+			}))
+			require.Error(t, err)
+		})
+	}
+}
+
+func mustGenerateRSAKey(t *testing.T) crypto.Signer {
+	t.Helper()
+	key, _, err := generateTestKey()
+	require.NoError(t, err)
+	return key
+}
+
+func TestEnrollInvalidPublicKey(t *testing.T) {
+	t.Parallel()
+	ctx := testContext(t)
+	adminUser := randomUser()
+	tenant, err := createTestTenant(t.Name(), adminUser, globalTestApp.TenantClient())
+	require.NoError(t, err)
+
+	device, err := globalTestApp.OfficeClient().CreateDevice(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.CreateDeviceRequest]{
+		Msg: &homecallv1alpha.CreateDeviceRequest{
+			Name:            "device",
+			TenantId:        tenant.Id,
+			DefaultSettings: &homecallv1alpha.DeviceSettings{},
+		},
+	}))
+	require.NoError(t, err)
+
+	smallKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+	p224Key, err := ecdsa.GenerateKey(elliptic.P224(), rand.Reader)
+	require.NoError(t, err)
+	p224PublicKey, err := x509.MarshalPKIXPublicKey(&p224Key.PublicKey)
+	require.NoError(t, err)
+
+	for name, publicKey := range map[string]string{
+		"empty":       "",
+		"not pem":     "public-key",
+		"garbage":     string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: []byte("garbage")})),
+		"small rsa":   string(pem.EncodeToMemory(&pem.Block{Type: "RSA PUBLIC KEY", Bytes: x509.MarshalPKCS1PublicKey(&smallKey.PublicKey)})),
+		"ecdsa p224":  string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: p224PublicKey})),
+		"private key": string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(smallKey)})),
+	} {
+		_, err = globalTestApp.DeviceClient().Enroll(ctx, &connect.Request[homecallv1alpha.EnrollRequest]{
+			Msg: &homecallv1alpha.EnrollRequest{
+				EnrollmentKey: device.Msg.GetDevice().GetEnrollmentKey(),
+				PublicKey:     publicKey,
+			},
+		})
+		require.Error(t, err, name)
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), name)
+	}
+
+	// The enrollment key can still be used with a valid key
+	_, publicKey, err := generateTestKey()
+	require.NoError(t, err)
+	_, err = globalTestApp.DeviceClient().Enroll(ctx, &connect.Request[homecallv1alpha.EnrollRequest]{
+		Msg: &homecallv1alpha.EnrollRequest{
+			EnrollmentKey: device.Msg.GetDevice().GetEnrollmentKey(),
+			PublicKey:     publicKey,
+		},
+	})
+	require.NoError(t, err)
+}
+
+func TestDevicePresence(t *testing.T) {
+	t.Parallel()
+	ctx := testContext(t)
+	adminUser := randomUser()
+	tenant, err := createTestTenant(t.Name(), adminUser, globalTestApp.TenantClient())
+	require.NoError(t, err)
+
+	device, err := createEnrolledTestDevice(ctx, tenant.Id, adminUser, globalTestApp.OfficeClient(), globalTestApp.DeviceClient())
+	require.NoError(t, err)
+
+	getDevice := func() *homecallv1alpha.Device {
+		devices, err := globalTestApp.OfficeClient().ListDevices(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.ListDevicesRequest]{
+			Msg: &homecallv1alpha.ListDevicesRequest{TenantId: tenant.Id},
+		}))
+		require.NoError(t, err)
+		require.Len(t, devices.Msg.GetDevices(), 1)
+		return devices.Msg.GetDevices()[0]
+	}
+
+	// A device that has not been heard from is offline
+	assert.False(t, getDevice().GetOnline())
+
+	// Apps that don't send heartbeats are online while their notification token is fresh
+	_, err = globalTestApp.DeviceClient().UpdateNotificationToken(ctx, auth.WithToken(device.mustToken(t), &connect.Request[homecallv1alpha.UpdateNotificationTokenRequest]{
+		Msg: &homecallv1alpha.UpdateNotificationTokenRequest{NotificationToken: "presence"},
+	}))
+	require.NoError(t, err)
+	assert.True(t, getDevice().GetOnline())
+	assert.Nil(t, getDevice().GetLastSeen())
+
+	// Heartbeats report the state of the app
+	heartbeat, err := globalTestApp.DeviceClient().Heartbeat(ctx, auth.WithToken(device.mustToken(t), &connect.Request[homecallv1alpha.HeartbeatRequest]{
+		Msg: &homecallv1alpha.HeartbeatRequest{
+			State: &homecallv1alpha.DeviceState{
+				AppState:    homecallv1alpha.AppState_APP_STATE_FOREGROUND,
+				NetworkType: homecallv1alpha.NetworkType_NETWORK_TYPE_WIFI,
+			},
+		},
+	}))
+	require.NoError(t, err)
+	assert.Positive(t, heartbeat.Msg.GetNextHeartbeatSeconds())
+
+	listed := getDevice()
+	assert.True(t, listed.GetOnline())
+	assert.NotNil(t, listed.GetLastSeen())
+	assert.Equal(t, homecallv1alpha.AppState_APP_STATE_FOREGROUND, listed.GetState().GetAppState())
+	assert.Equal(t, homecallv1alpha.NetworkType_NETWORK_TYPE_WIFI, listed.GetState().GetNetworkType())
+}
+
+func TestDeviceTokenReplay(t *testing.T) {
+	t.Parallel()
+	ctx := testContext(t)
+	adminUser := randomUser()
+	tenant, err := createTestTenant(t.Name(), adminUser, globalTestApp.TenantClient())
+	require.NoError(t, err)
+
+	device, err := createEnrolledTestDevice(ctx, tenant.Id, adminUser, globalTestApp.OfficeClient(), globalTestApp.DeviceClient())
+	require.NoError(t, err)
+
+	heartbeat := func(token string) error {
+		_, err := globalTestApp.DeviceClient().Heartbeat(ctx, auth.WithToken(token, &connect.Request[homecallv1alpha.HeartbeatRequest]{
+			Msg: &homecallv1alpha.HeartbeatRequest{},
+		}))
+		return err
+	}
+	signedToken := func(claims jwt.Claims) string {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodRS256, claims).SignedString(device.key)
+		require.NoError(t, err)
+		return token
+	}
+	claims := func(id string, lifetime time.Duration) jwt.RegisteredClaims {
+		return jwt.RegisteredClaims{
+			ID:        id,
+			Subject:   device.ID,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(lifetime)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			Issuer:    "homecall-device",
+			Audience:  jwt.ClaimStrings{"homecall"},
+		}
+	}
+
+	// Tokens can only be used once
+	token := device.mustToken(t)
+	require.NoError(t, heartbeat(token))
+	err = heartbeat(token)
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+
+	// Tokens must have an ID
+	err = heartbeat(signedToken(claims("", time.Minute)))
+	assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+
+	// Tokens must not live too long
+	err = heartbeat(signedToken(claims(uuid.New().String(), 24*time.Hour)))
+	assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+
+	// Tokens bound to a procedure can only be used for that procedure
+	type boundClaims struct {
+		jwt.RegisteredClaims
+		Procedure string `json:"procedure"`
+	}
+	err = heartbeat(signedToken(boundClaims{
+		RegisteredClaims: claims(uuid.New().String(), time.Minute),
+		Procedure:        homecallv1alphaconnect.DeviceServiceReportDiagnosticsProcedure,
+	}))
+	assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	err = heartbeat(signedToken(boundClaims{
+		RegisteredClaims: claims(uuid.New().String(), time.Minute),
+		Procedure:        homecallv1alphaconnect.DeviceServiceHeartbeatProcedure,
+	}))
+	assert.NoError(t, err)
+}
+
+func TestDeviceAppTokenFlow(t *testing.T) {
+	t.Parallel()
+	ctx := testContext(t)
+	adminUser := randomUser()
+	tenant, err := createTestTenant(t.Name(), adminUser, globalTestApp.TenantClient())
+	require.NoError(t, err)
+
+	device, err := createEnrolledTestDevice(ctx, tenant.Id, adminUser, globalTestApp.OfficeClient(), globalTestApp.DeviceClient())
+	require.NoError(t, err)
+
+	// The app mints a new token for every request
+	firstToken := appDeviceToken(t, device)
+	_, err = globalTestApp.DeviceClient().UpdateNotificationToken(ctx, auth.WithToken(firstToken, &connect.Request[homecallv1alpha.UpdateNotificationTokenRequest]{
+		Msg: &homecallv1alpha.UpdateNotificationTokenRequest{NotificationToken: "app-token-flow"},
+	}))
+	require.NoError(t, err)
+
+	_, err = globalTestApp.DeviceClient().Heartbeat(ctx, auth.WithToken(appDeviceToken(t, device), &connect.Request[homecallv1alpha.HeartbeatRequest]{
+		Msg: &homecallv1alpha.HeartbeatRequest{},
+	}))
+	require.NoError(t, err)
+
+	// Reusing a token the way the app used to is rejected
+	_, err = globalTestApp.DeviceClient().UpdateNotificationToken(ctx, auth.WithToken(firstToken, &connect.Request[homecallv1alpha.UpdateNotificationTokenRequest]{
+		Msg: &homecallv1alpha.UpdateNotificationTokenRequest{NotificationToken: "app-token-flow"},
+	}))
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+}
+
+// appDeviceToken signs a device token the way app/lib/auth.ts does,
+// the header, the claims and the jti are put together by hand rather than by a JWT library.
+func appDeviceToken(t *testing.T, device *testDevice) string {
+	t.Helper()
+	now := time.Now()
+
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT"})
+	require.NoError(t, err)
+	random, err := util.RandomString(16)
+	require.NoError(t, err)
+	payload, err := json.Marshal(map[string]any{
+		"iss": "homecall-device",
+		"sub": device.ID,
+		"aud": "homecall",
+		"exp": now.Add(5 * time.Minute).Unix(),
+		"iat": now.Unix(),
+		"jti": strconv.FormatInt(now.UnixMilli(), 36) + "-" + strings.ToLower(random),
+	})
+	require.NoError(t, err)
+
+	headerPayload := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(headerPayload))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, device.key.(*rsa.PrivateKey), crypto.SHA256, digest[:])
+	require.NoError(t, err)
+	return headerPayload + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func TestEnrollAttestationRequired(t *testing.T) {
+	t.Parallel()
+	ctx := testContext(t)
+	adminUser := randomUser()
+	tenant, err := createTestTenant(t.Name(), adminUser, globalTestApp.TenantClient())
+	require.NoError(t, err)
+	assert.False(t, tenant.GetRequireDeviceAttestation())
+
+	updated, err := globalTestApp.TenantClient().UpdateTenant(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.UpdateTenantRequest]{
+		Msg: &homecallv1alpha.UpdateTenantRequest{
+			Id:                       tenant.Id,
+			RequireDeviceAttestation: proto.Bool(true),
+		},
+	}))
+	require.NoError(t, err)
+	assert.True(t, updated.Msg.GetTenant().GetRequireDeviceAttestation())
+
+	// Only admins can change the requirement
+	_, err = globalTestApp.TenantClient().UpdateTenant(ctx, auth.WithDummyToken(randomUser(), &connect.Request[homecallv1alpha.UpdateTenantRequest]{
+		Msg: &homecallv1alpha.UpdateTenantRequest{
+			Id:                       tenant.Id,
+			RequireDeviceAttestation: proto.Bool(false),
+		},
+	}))
+	require.Error(t, err)
+
+	device, err := globalTestApp.OfficeClient().CreateDevice(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.CreateDeviceRequest]{
+		Msg: &homecallv1alpha.CreateDeviceRequest{
+			Name:            "device",
+			TenantId:        tenant.Id,
+			DefaultSettings: &homecallv1alpha.DeviceSettings{},
+		},
+	}))
+	require.NoError(t, err)
+	_, publicKey, err := generateTestKey()
+	require.NoError(t, err)
+
+	// Without an attestation the enrollment is rejected but not consumed
+	_, err = globalTestApp.DeviceClient().Enroll(ctx, &connect.Request[homecallv1alpha.EnrollRequest]{
+		Msg: &homecallv1alpha.EnrollRequest{
+			EnrollmentKey: device.Msg.GetDevice().GetEnrollmentKey(),
+			PublicKey:     publicKey,
+		},
+	})
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+	// The test app has no attestation roots configured
+	_, err = globalTestApp.DeviceClient().Enroll(ctx, &connect.Request[homecallv1alpha.EnrollRequest]{
+		Msg: &homecallv1alpha.EnrollRequest{
+			EnrollmentKey: device.Msg.GetDevice().GetEnrollmentKey(),
+			PublicKey:     publicKey,
+			Attestation: &homecallv1alpha.DeviceAttestation{
+				Statement: &homecallv1alpha.DeviceAttestation_PlayIntegrityToken{PlayIntegrityToken: "token"},
+			},
+		},
+	})
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+	// Once the requirement is lifted the same key can be used
+	_, err = globalTestApp.TenantClient().UpdateTenant(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.UpdateTenantRequest]{
+		Msg: &homecallv1alpha.UpdateTenantRequest{
+			Id:                       tenant.Id,
+			RequireDeviceAttestation: proto.Bool(false),
+		},
+	}))
+	require.NoError(t, err)
+	_, err = globalTestApp.DeviceClient().Enroll(ctx, &connect.Request[homecallv1alpha.EnrollRequest]{
+		Msg: &homecallv1alpha.EnrollRequest{
+			EnrollmentKey: device.Msg.GetDevice().GetEnrollmentKey(),
+			PublicKey:     publicKey,
+		},
+	})
+	require.NoError(t, err)
+}
+
+func TestDisableDevice(t *testing.T) {
+	t.Parallel()
+	ctx := testContext(t)
+	adminUser := randomUser()
+	tenant, err := createTestTenant(t.Name(), adminUser, globalTestApp.TenantClient())
+	require.NoError(t, err)
+
+	device, err := createEnrolledTestDevice(ctx, tenant.Id, adminUser, globalTestApp.OfficeClient(), globalTestApp.DeviceClient())
+	require.NoError(t, err)
+	_, err = globalTestApp.DeviceClient().Heartbeat(ctx, auth.WithToken(device.mustToken(t), &connect.Request[homecallv1alpha.HeartbeatRequest]{
+		Msg: &homecallv1alpha.HeartbeatRequest{
+			State: &homecallv1alpha.DeviceState{AppState: homecallv1alpha.AppState_APP_STATE_FOREGROUND},
+		},
+	}))
+	require.NoError(t, err)
+
+	// Only admins can disable devices
+	member := randomUser()
+	_, err = globalTestApp.OfficeClient().DisableDevice(ctx, auth.WithDummyToken(member, &connect.Request[homecallv1alpha.DisableDeviceRequest]{
+		Msg: &homecallv1alpha.DisableDeviceRequest{DeviceId: device.ID},
+	}))
+	require.Error(t, err)
+
+	disabled, err := globalTestApp.OfficeClient().DisableDevice(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.DisableDeviceRequest]{
+		Msg: &homecallv1alpha.DisableDeviceRequest{DeviceId: device.ID},
+	}))
+	require.NoError(t, err)
+	assert.True(t, disabled.Msg.GetDevice().GetDisabled())
+	assert.NotNil(t, disabled.Msg.GetDevice().GetDisabledAt())
+	assert.True(t, disabled.Msg.GetDevice().GetEnrolled())
+
+	// The device gets a distinct error on every call
+	_, err = globalTestApp.DeviceClient().Heartbeat(ctx, auth.WithToken(device.mustToken(t), &connect.Request[homecallv1alpha.HeartbeatRequest]{
+		Msg: &homecallv1alpha.HeartbeatRequest{},
+	}))
+	require.Error(t, err)
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	assertDeviceDisabledError(t, err, device.ID)
+
+	// Calls to the device are refused
+	_, err = globalTestApp.OfficeClient().StartCall(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.StartCallRequest]{
+		Msg: &homecallv1alpha.StartCallRequest{DeviceId: device.ID},
+	}))
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	assertDeviceDisabledError(t, err, device.ID)
+
+	// Calls to several devices leave the disabled device out
+	otherDevice, _ := createCallableTestDevice(ctx, t, tenant.Id, adminUser)
+	started, err := globalTestApp.OfficeClient().StartCall(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.StartCallRequest]{
+		Msg: &homecallv1alpha.StartCallRequest{DeviceIds: []string{device.ID, otherDevice.ID}},
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, []string{device.ID}, started.Msg.GetDisabledDeviceIds())
+	var participantDeviceIds []string
+	for _, participant := range started.Msg.GetCall().GetParticipants() {
+		if participant.GetDeviceId() != "" {
+			participantDeviceIds = append(participantDeviceIds, participant.GetDeviceId())
+		}
+	}
+	assert.Equal(t, []string{otherDevice.ID}, participantDeviceIds)
+
+	// The device is kept
+	devices, err := globalTestApp.OfficeClient().ListDevices(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.ListDevicesRequest]{
+		Msg: &homecallv1alpha.ListDevicesRequest{TenantId: tenant.Id},
+	}))
+	require.NoError(t, err)
+	require.Len(t, devices.Msg.GetDevices(), 2)
+	for _, listed := range devices.Msg.GetDevices() {
+		assert.Equal(t, listed.GetId() == device.ID, listed.GetDisabled())
+	}
+
+	enabled, err := globalTestApp.OfficeClient().EnableDevice(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.EnableDeviceRequest]{
+		Msg: &homecallv1alpha.EnableDeviceRequest{DeviceId: device.ID},
+	}))
+	require.NoError(t, err)
+	assert.False(t, enabled.Msg.GetDevice().GetDisabled())
+	assert.Nil(t, enabled.Msg.GetDevice().GetDisabledAt())
+
+	_, err = globalTestApp.DeviceClient().Heartbeat(ctx, auth.WithToken(device.mustToken(t), &connect.Request[homecallv1alpha.HeartbeatRequest]{
+		Msg: &homecallv1alpha.HeartbeatRequest{},
+	}))
+	require.NoError(t, err)
+}
+
+func assertDeviceDisabledError(t *testing.T, err error, deviceId string) {
+	t.Helper()
+	var connectErr *connect.Error
+	require.ErrorAs(t, err, &connectErr)
+	for _, detail := range connectErr.Details() {
+		value, err := detail.Value()
+		require.NoError(t, err)
+		if disabledErr, ok := value.(*homecallv1alpha.DeviceDisabledError); ok {
+			assert.Equal(t, deviceId, disabledErr.GetDeviceId())
+			assert.NotNil(t, disabledErr.GetDisabledAt())
+			return
+		}
+	}
+	t.Fatal("missing DeviceDisabledError detail")
+}
+
+func TestDeviceGroups(t *testing.T) {
+	t.Parallel()
+	ctx := testContext(t)
+	adminUser := randomUser()
+	tenant, err := createTestTenant(t.Name(), adminUser, globalTestApp.TenantClient())
+	require.NoError(t, err)
+
+	createGroup := func(name string) *homecallv1alpha.DeviceGroup {
+		resp, err := globalTestApp.OfficeClient().CreateDeviceGroup(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.CreateDeviceGroupRequest]{
+			Msg: &homecallv1alpha.CreateDeviceGroupRequest{TenantId: tenant.Id, Name: name},
+		}))
+		require.NoError(t, err)
+		return resp.Msg.GetGroup()
+	}
+	createDevice := func(name string, groupId string) *homecallv1alpha.Device {
+		resp, err := globalTestApp.OfficeClient().CreateDevice(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.CreateDeviceRequest]{
+			Msg: &homecallv1alpha.CreateDeviceRequest{TenantId: tenant.Id, Name: name, GroupId: groupId},
+		}))
+		require.NoError(t, err)
+		return resp.Msg.GetDevice()
+	}
+	listDevices := func(user string, groupId string) []string {
+		resp, err := globalTestApp.OfficeClient().ListDevices(ctx, auth.WithDummyToken(user, &connect.Request[homecallv1alpha.ListDevicesRequest]{
+			Msg: &homecallv1alpha.ListDevicesRequest{TenantId: tenant.Id, GroupId: groupId},
+		}))
+		require.NoError(t, err)
+		var ids []string
+		for _, device := range resp.Msg.GetDevices() {
+			ids = append(ids, device.GetId())
+		}
+		return ids
+	}
+
+	north := createGroup("North")
+	south := createGroup("South")
+	northDevice := createDevice("north", north.GetId())
+	southDevice := createDevice("south", south.GetId())
+	ungroupedDevice := createDevice("ungrouped", "")
+	assert.Equal(t, north.GetId(), northDevice.GetGroupId())
+
+	groups, err := globalTestApp.OfficeClient().ListDeviceGroups(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.ListDeviceGroupsRequest]{
+		Msg: &homecallv1alpha.ListDeviceGroupsRequest{TenantId: tenant.Id},
+	}))
+	require.NoError(t, err)
+	require.Len(t, groups.Msg.GetGroups(), 2)
+	assert.Equal(t, "North", groups.Msg.GetGroups()[0].GetName())
+
+	assert.ElementsMatch(t, []string{northDevice.GetId(), southDevice.GetId(), ungroupedDevice.GetId()}, listDevices(adminUser, ""))
+	assert.ElementsMatch(t, []string{southDevice.GetId()}, listDevices(adminUser, south.GetId()))
+
+	// Groups of other tenants can not be used
+	otherAdmin := randomUser()
+	otherTenant, err := createTestTenant(t.Name()+" other", otherAdmin, globalTestApp.TenantClient())
+	require.NoError(t, err)
+	otherGroup, err := globalTestApp.OfficeClient().CreateDeviceGroup(ctx, auth.WithDummyToken(otherAdmin, &connect.Request[homecallv1alpha.CreateDeviceGroupRequest]{
+		Msg: &homecallv1alpha.CreateDeviceGroupRequest{TenantId: otherTenant.Id, Name: "Other"},
+	}))
+	require.NoError(t, err)
+	_, err = globalTestApp.OfficeClient().SetDeviceGroup(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.SetDeviceGroupRequest]{
+		Msg: &homecallv1alpha.SetDeviceGroupRequest{DeviceId: ungroupedDevice.GetId(), GroupId: otherGroup.Msg.GetGroup().GetId()},
+	}))
+	require.Error(t, err)
+
+	// Scope a member to the north group
+	memberUser := randomUser()
+	memberId := addTestMember(ctx, t, tenant.Id, adminUser, memberUser, homecallv1alpha.Role_ROLE_MEMBER)
+
+	assert.Len(t, listDevices(memberUser, ""), 3)
+	_, err = globalTestApp.TenantClient().SetTenantMemberDeviceGroups(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.SetTenantMemberDeviceGroupsRequest]{
+		Msg: &homecallv1alpha.SetTenantMemberDeviceGroupsRequest{
+			MemberId:          memberId,
+			DeviceGroupScoped: true,
+			DeviceGroupIds:    []string{north.GetId()},
+		},
+	}))
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{northDevice.GetId()}, listDevices(memberUser, ""))
+
+	memberGroups, err := globalTestApp.OfficeClient().ListDeviceGroups(ctx, auth.WithDummyToken(memberUser, &connect.Request[homecallv1alpha.ListDeviceGroupsRequest]{
+		Msg: &homecallv1alpha.ListDeviceGroupsRequest{TenantId: tenant.Id},
+	}))
+	require.NoError(t, err)
+	require.Len(t, memberGroups.Msg.GetGroups(), 1)
+	assert.Equal(t, north.GetId(), memberGroups.Msg.GetGroups()[0].GetId())
+
+	_, err = globalTestApp.OfficeClient().StartCall(ctx, auth.WithDummyToken(memberUser, &connect.Request[homecallv1alpha.StartCallRequest]{
+		Msg: &homecallv1alpha.StartCallRequest{DeviceId: southDevice.GetId()},
+	}))
+	require.Error(t, err)
+
+	// Moving a device into the group makes it visible
+	moved, err := globalTestApp.OfficeClient().SetDeviceGroup(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.SetDeviceGroupRequest]{
+		Msg: &homecallv1alpha.SetDeviceGroupRequest{DeviceId: southDevice.GetId(), GroupId: north.GetId()},
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, north.GetId(), moved.Msg.GetDevice().GetGroupId())
+	assert.ElementsMatch(t, []string{northDevice.GetId(), southDevice.GetId()}, listDevices(memberUser, ""))
+
+	// Removing the group ungroups its devices, which does not widen the access of the member
+	_, err = globalTestApp.OfficeClient().RemoveDeviceGroup(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.RemoveDeviceGroupRequest]{
+		Msg: &homecallv1alpha.RemoveDeviceGroupRequest{GroupId: north.GetId()},
+	}))
+	require.NoError(t, err)
+	assert.Empty(t, listDevices(memberUser, ""))
+	assert.Len(t, listDevices(adminUser, ""), 3)
+}
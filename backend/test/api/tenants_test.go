@@ -10,10 +10,13 @@ import (
 	"encoding/pem"
 	"firebase.google.com/go/v4/messaging"
 	"fmt"
-	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"io"
 	"io/fs"
+	"net/http"
 	"os"
 	"path"
 	"path/filepath"
@@ -91,14 +94,10 @@ func TestDeviceCall(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	deviceToken, err := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.RegisteredClaims{
-		Subject:   device.Msg.GetDevice().GetId(),
-		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
-		IssuedAt:  jwt.NewNumericDate(time.Now()),
-		Issuer:    "homecall-device",
-		Audience:  jwt.ClaimStrings{"homecall"},
-	}).SignedString(key)
-	require.NoError(t, err)
+	enrolledDevice := &testDevice{
+		ID:  device.Msg.GetDevice().GetId(),
+		key: key,
+	}
 
 	// attempt call before enrollment
 	_, err = globalTestApp.OfficeClient().StartCall(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.StartCallRequest]{
@@ -133,7 +132,7 @@ func TestDeviceCall(t *testing.T) {
 	deviceNotificationToken, err := util.RandomString(10)
 	require.NoError(t, err)
 
-	_, err = globalTestApp.DeviceClient().UpdateNotificationToken(ctx, auth.WithToken(deviceToken, &connect.Request[homecallv1alpha.UpdateNotificationTokenRequest]{
+	_, err = globalTestApp.DeviceClient().UpdateNotificationToken(ctx, auth.WithToken(enrolledDevice.mustToken(t), &connect.Request[homecallv1alpha.UpdateNotificationTokenRequest]{
 		Msg: &homecallv1alpha.UpdateNotificationTokenRequest{
 			NotificationToken: deviceNotificationToken,
 		},
@@ -171,7 +170,7 @@ func TestDeviceCall(t *testing.T) {
 	assert.Equal(t, call.Msg.GetCallId(), message.Data["callId"])
 
 	// Get call details
-	callDetails, err := globalTestApp.DeviceClient().GetCallDetails(ctx, auth.WithToken(deviceToken, &connect.Request[homecallv1alpha.GetCallDetailsRequest]{
+	callDetails, err := globalTestApp.DeviceClient().GetCallDetails(ctx, auth.WithToken(enrolledDevice.mustToken(t), &connect.Request[homecallv1alpha.GetCallDetailsRequest]{
 		Msg: &homecallv1alpha.GetCallDetailsRequest{
 			CallId: message.Data["callId"],
 		},
@@ -184,6 +183,342 @@ func TestDeviceCall(t *testing.T) {
 	assert.NotEmpty(t, callDetails.Msg.GetJitsiJwt())
 }
 
+func TestGroupCall(t *testing.T) {
+	t.Parallel()
+	ctx := testContext(t)
+	adminUser := randomUser()
+	tenant, err := createTestTenant(t.Name(), adminUser, globalTestApp.TenantClient())
+	require.NoError(t, err)
+
+	// Two devices that can be called
+	type callableDevice struct {
+		*testDevice
+		notificationToken string
+	}
+	var devices []callableDevice
+	for i := 0; i < 2; i++ {
+		device, notificationToken := createCallableTestDevice(ctx, t, tenant.Id, adminUser)
+		devices = append(devices, callableDevice{testDevice: device, notificationToken: notificationToken})
+	}
+
+	// A second office user
+	carerUser := randomUser()
+	carerMemberId := addTestMember(ctx, t, tenant.Id, adminUser, carerUser, homecallv1alpha.Role_ROLE_MEMBER)
+
+	watchCtx, cancelWatch := context.WithCancel(ctx)
+	defer cancelWatch()
+	invitations, err := globalTestApp.OfficeClient().WatchCallInvitations(watchCtx, auth.WithDummyToken(carerUser, &connect.Request[homecallv1alpha.WatchCallInvitationsRequest]{
+		Msg: &homecallv1alpha.WatchCallInvitationsRequest{TenantId: tenant.Id},
+	}))
+	require.NoError(t, err)
+
+	call, err := globalTestApp.OfficeClient().StartCall(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.StartCallRequest]{
+		Msg: &homecallv1alpha.StartCallRequest{
+			DeviceIds: []string{devices[0].ID, devices[1].ID},
+			MemberIds: []string{carerMemberId},
+		},
+	}))
+	require.NoError(t, err)
+	require.Len(t, call.Msg.GetCall().GetParticipants(), 4)
+
+	// The carer is notified and gets its own token
+	require.True(t, invitations.Receive())
+	assert.Equal(t, call.Msg.GetCallId(), invitations.Msg().GetCall().GetId())
+	joined, err := globalTestApp.OfficeClient().JoinCall(ctx, auth.WithDummyToken(carerUser, &connect.Request[homecallv1alpha.JoinCallRequest]{
+		Msg: &homecallv1alpha.JoinCallRequest{CallId: call.Msg.GetCallId()},
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, call.Msg.GetJitsiRoomId(), joined.Msg.GetJitsiRoomId())
+	tokens := map[string]bool{call.Msg.GetJitsiJwt(): true, joined.Msg.GetJitsiJwt(): true}
+
+	// Every device is notified and gets its own token
+	for _, device := range devices {
+		notificationDir := path.Join(globalTestApp.NotificationsDir(), directorynotifications.DevicesDirectory, device.notificationToken)
+		entries, err := os.ReadDir(notificationDir)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		content, err := os.ReadFile(path.Join(notificationDir, entries[0].Name()))
+		require.NoError(t, err)
+		message := &messaging.Message{}
+		require.NoError(t, message.UnmarshalJSON(content))
+		assert.Equal(t, call.Msg.GetCallId(), message.Data["callId"])
+
+		details, err := globalTestApp.DeviceClient().GetCallDetails(ctx, auth.WithToken(device.mustToken(t), &connect.Request[homecallv1alpha.GetCallDetailsRequest]{
+			Msg: &homecallv1alpha.GetCallDetailsRequest{CallId: call.Msg.GetCallId()},
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, call.Msg.GetJitsiRoomId(), details.Msg.GetJitsiRoomId())
+		tokens[details.Msg.GetJitsiJwt()] = true
+	}
+	assert.Len(t, tokens, 4)
+
+	// Each participant tracks its own join state
+	_, err = globalTestApp.DeviceClient().UpdateCallState(ctx, auth.WithToken(devices[0].mustToken(t), &connect.Request[homecallv1alpha.UpdateCallStateRequest]{
+		Msg: &homecallv1alpha.UpdateCallStateRequest{CallId: call.Msg.GetCallId(), State: homecallv1alpha.CallParticipantState_CALL_PARTICIPANT_STATE_JOINED},
+	}))
+	require.NoError(t, err)
+	_, err = globalTestApp.DeviceClient().UpdateCallState(ctx, auth.WithToken(devices[1].mustToken(t), &connect.Request[homecallv1alpha.UpdateCallStateRequest]{
+		Msg: &homecallv1alpha.UpdateCallStateRequest{CallId: call.Msg.GetCallId(), State: homecallv1alpha.CallParticipantState_CALL_PARTICIPANT_STATE_DECLINED},
+	}))
+	require.NoError(t, err)
+	_, err = globalTestApp.OfficeClient().UpdateCallState(ctx, auth.WithDummyToken(carerUser, &connect.Request[homecallv1alpha.UpdateCallStateRequest]{
+		Msg: &homecallv1alpha.UpdateCallStateRequest{CallId: call.Msg.GetCallId(), State: homecallv1alpha.CallParticipantState_CALL_PARTICIPANT_STATE_JOINED},
+	}))
+	require.NoError(t, err)
+
+	// A joined participant can not decline
+	_, err = globalTestApp.DeviceClient().UpdateCallState(ctx, auth.WithToken(devices[0].mustToken(t), &connect.Request[homecallv1alpha.UpdateCallStateRequest]{
+		Msg: &homecallv1alpha.UpdateCallStateRequest{CallId: call.Msg.GetCallId(), State: homecallv1alpha.CallParticipantState_CALL_PARTICIPANT_STATE_DECLINED},
+	}))
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+	got, err := globalTestApp.OfficeClient().GetCall(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.GetCallRequest]{
+		Msg: &homecallv1alpha.GetCallRequest{CallId: call.Msg.GetCallId()},
+	}))
+	require.NoError(t, err)
+	states := make(map[string]homecallv1alpha.CallParticipantState)
+	for _, participant := range got.Msg.GetCall().GetParticipants() {
+		key := participant.GetDeviceId()
+		if key == "" {
+			key = participant.GetMemberId()
+		}
+		states[key] = participant.GetState()
+	}
+	assert.Equal(t, homecallv1alpha.CallParticipantState_CALL_PARTICIPANT_STATE_JOINED, states[devices[0].ID])
+	assert.Equal(t, homecallv1alpha.CallParticipantState_CALL_PARTICIPANT_STATE_DECLINED, states[devices[1].ID])
+	assert.Equal(t, homecallv1alpha.CallParticipantState_CALL_PARTICIPANT_STATE_JOINED, states[carerMemberId])
+
+	// Outsiders can not see or join the call, or tell it apart from a call that does not exist
+	outsider := randomUser()
+	_, err = globalTestApp.OfficeClient().GetCall(ctx, auth.WithDummyToken(outsider, &connect.Request[homecallv1alpha.GetCallRequest]{
+		Msg: &homecallv1alpha.GetCallRequest{CallId: call.Msg.GetCallId()},
+	}))
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	_, err = globalTestApp.OfficeClient().GetCall(ctx, auth.WithDummyToken(outsider, &connect.Request[homecallv1alpha.GetCallRequest]{
+		Msg: &homecallv1alpha.GetCallRequest{CallId: uuid.New().String()},
+	}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	_, err = globalTestApp.OfficeClient().JoinCall(ctx, auth.WithDummyToken(outsider, &connect.Request[homecallv1alpha.JoinCallRequest]{
+		Msg: &homecallv1alpha.JoinCallRequest{CallId: call.Msg.GetCallId()},
+	}))
+	require.Error(t, err)
+}
+
+func TestStartCallWithUnreachableDevice(t *testing.T) {
+	t.Parallel()
+	ctx := testContext(t)
+	adminUser := randomUser()
+	tenant, err := createTestTenant(t.Name(), adminUser, globalTestApp.TenantClient())
+	require.NoError(t, err)
+
+	reachable, notificationToken := createCallableTestDevice(ctx, t, tenant.Id, adminUser)
+
+	// Online, but without a notification token the device can not be rung
+	unreachable, err := createEnrolledTestDevice(ctx, tenant.Id, adminUser, globalTestApp.OfficeClient(), globalTestApp.DeviceClient())
+	require.NoError(t, err)
+	_, err = globalTestApp.DeviceClient().Heartbeat(ctx, auth.WithToken(unreachable.mustToken(t), &connect.Request[homecallv1alpha.HeartbeatRequest]{
+		Msg: &homecallv1alpha.HeartbeatRequest{
+			State: &homecallv1alpha.DeviceState{AppState: homecallv1alpha.AppState_APP_STATE_FOREGROUND},
+		},
+	}))
+	require.NoError(t, err)
+
+	// The call is started and the device that could not be rung is reported
+	call, err := globalTestApp.OfficeClient().StartCall(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.StartCallRequest]{
+		Msg: &homecallv1alpha.StartCallRequest{DeviceIds: []string{reachable.ID, unreachable.ID}},
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, []string{unreachable.ID}, call.Msg.GetUnreachableDeviceIds())
+	assert.Len(t, call.Msg.GetCall().GetParticipants(), 3)
+
+	notificationDir := path.Join(globalTestApp.NotificationsDir(), directorynotifications.DevicesDirectory, notificationToken)
+	entries, err := os.ReadDir(notificationDir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestGetCallDeviceGroupScope(t *testing.T) {
+	t.Parallel()
+	ctx := testContext(t)
+	adminUser := randomUser()
+	tenant, err := createTestTenant(t.Name(), adminUser, globalTestApp.TenantClient())
+	require.NoError(t, err)
+
+	createGroup := func(name string) string {
+		group, err := globalTestApp.OfficeClient().CreateDeviceGroup(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.CreateDeviceGroupRequest]{
+			Msg: &homecallv1alpha.CreateDeviceGroupRequest{TenantId: tenant.Id, Name: name},
+		}))
+		require.NoError(t, err)
+		return group.Msg.GetGroup().GetId()
+	}
+	north := createGroup("North")
+	south := createGroup("South")
+
+	device, _ := createCallableTestDevice(ctx, t, tenant.Id, adminUser)
+	_, err = globalTestApp.OfficeClient().SetDeviceGroup(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.SetDeviceGroupRequest]{
+		Msg: &homecallv1alpha.SetDeviceGroupRequest{DeviceId: device.ID, GroupId: south},
+	}))
+	require.NoError(t, err)
+
+	// A member that only sees the devices of the north group
+	memberUser := randomUser()
+	memberId := addTestMember(ctx, t, tenant.Id, adminUser, memberUser, homecallv1alpha.Role_ROLE_MEMBER)
+	_, err = globalTestApp.TenantClient().SetTenantMemberDeviceGroups(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.SetTenantMemberDeviceGroupsRequest]{
+		Msg: &homecallv1alpha.SetTenantMemberDeviceGroupsRequest{MemberId: memberId, DeviceGroupScoped: true, DeviceGroupIds: []string{north}},
+	}))
+	require.NoError(t, err)
+
+	getCall := func(user string, callId string) error {
+		_, err := globalTestApp.OfficeClient().GetCall(ctx, auth.WithDummyToken(user, &connect.Request[homecallv1alpha.GetCallRequest]{
+			Msg: &homecallv1alpha.GetCallRequest{CallId: callId},
+		}))
+		return err
+	}
+
+	// Calls with devices outside the scope of the member are hidden
+	call, err := globalTestApp.OfficeClient().StartCall(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.StartCallRequest]{
+		Msg: &homecallv1alpha.StartCallRequest{DeviceId: device.ID},
+	}))
+	require.NoError(t, err)
+	require.NoError(t, getCall(adminUser, call.Msg.GetCallId()))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(getCall(memberUser, call.Msg.GetCallId())))
+
+	// Unless the member is invited to the call
+	invited, err := globalTestApp.OfficeClient().StartCall(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.StartCallRequest]{
+		Msg: &homecallv1alpha.StartCallRequest{DeviceId: device.ID, MemberIds: []string{memberId}},
+	}))
+	require.NoError(t, err)
+	require.NoError(t, getCall(memberUser, invited.Msg.GetCallId()))
+}
+
+func TestInviteToCall(t *testing.T) {
+	t.Parallel()
+	ctx := testContext(t)
+	adminUser := randomUser()
+	tenant, err := createTestTenant(t.Name(), adminUser, globalTestApp.TenantClient())
+	require.NoError(t, err)
+	adminMemberId := testMemberID(ctx, t, tenant.Id, adminUser, adminUser)
+
+	device, _ := createCallableTestDevice(ctx, t, tenant.Id, adminUser)
+	nurseUser := randomUser()
+	nurseMemberId := addTestMember(ctx, t, tenant.Id, adminUser, nurseUser, homecallv1alpha.Role_ROLE_MEMBER)
+	bystanderUser := randomUser()
+	addTestMember(ctx, t, tenant.Id, adminUser, bystanderUser, homecallv1alpha.Role_ROLE_MEMBER)
+
+	call, err := globalTestApp.OfficeClient().StartCall(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.StartCallRequest]{
+		Msg: &homecallv1alpha.StartCallRequest{DeviceId: device.ID},
+	}))
+	require.NoError(t, err)
+	callId := call.Msg.GetCallId()
+
+	// The nurse is not part of the call yet
+	_, err = globalTestApp.OfficeClient().JoinCall(ctx, auth.WithDummyToken(nurseUser, &connect.Request[homecallv1alpha.JoinCallRequest]{
+		Msg: &homecallv1alpha.JoinCallRequest{CallId: callId},
+	}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	// Only participants can invite
+	_, err = globalTestApp.OfficeClient().InviteToCall(ctx, auth.WithDummyToken(bystanderUser, &connect.Request[homecallv1alpha.InviteToCallRequest]{
+		Msg: &homecallv1alpha.InviteToCallRequest{CallId: callId, MemberId: nurseMemberId},
+	}))
+	require.Error(t, err)
+
+	watchCtx, cancelWatch := context.WithCancel(ctx)
+	defer cancelWatch()
+	invitations, err := globalTestApp.OfficeClient().WatchCallInvitations(watchCtx, auth.WithDummyToken(nurseUser, &connect.Request[homecallv1alpha.WatchCallInvitationsRequest]{
+		Msg: &homecallv1alpha.WatchCallInvitationsRequest{TenantId: tenant.Id},
+	}))
+	require.NoError(t, err)
+
+	invited, err := globalTestApp.OfficeClient().InviteToCall(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.InviteToCallRequest]{
+		Msg: &homecallv1alpha.InviteToCallRequest{CallId: callId, MemberId: nurseMemberId},
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, nurseMemberId, invited.Msg.GetParticipant().GetMemberId())
+	assert.Equal(t, homecallv1alpha.CallParticipantState_CALL_PARTICIPANT_STATE_INVITED, invited.Msg.GetParticipant().GetState())
+
+	_, err = globalTestApp.OfficeClient().InviteToCall(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.InviteToCallRequest]{
+		Msg: &homecallv1alpha.InviteToCallRequest{CallId: callId, MemberId: nurseMemberId},
+	}))
+	assert.Equal(t, connect.CodeAlreadyExists, connect.CodeOf(err))
+
+	require.True(t, invitations.Receive())
+	assert.Equal(t, callId, invitations.Msg().GetCall().GetId())
+
+	joined, err := globalTestApp.OfficeClient().JoinCall(ctx, auth.WithDummyToken(nurseUser, &connect.Request[homecallv1alpha.JoinCallRequest]{
+		Msg: &homecallv1alpha.JoinCallRequest{CallId: callId},
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, call.Msg.GetJitsiRoomId(), joined.Msg.GetJitsiRoomId())
+	assert.NotEqual(t, call.Msg.GetJitsiJwt(), joined.Msg.GetJitsiJwt())
+
+	// The invitation is part of the history of the call
+	got, err := globalTestApp.OfficeClient().GetCall(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.GetCallRequest]{
+		Msg: &homecallv1alpha.GetCallRequest{CallId: callId},
+	}))
+	require.NoError(t, err)
+	events := got.Msg.GetCall().GetEvents()
+	require.NotEmpty(t, events)
+	last := events[len(events)-1]
+	assert.Equal(t, invited.Msg.GetParticipant().GetId(), last.GetParticipantId())
+	assert.Equal(t, homecallv1alpha.CallParticipantState_CALL_PARTICIPANT_STATE_INVITED, last.GetState())
+	assert.Equal(t, adminMemberId, last.GetInvitedByMemberId())
+}
+
+// createCallableTestDevice creates an enrolled device with a notification token that is online,
+// it returns the device and its notification token.
+func createCallableTestDevice(ctx context.Context, t *testing.T, tenantID string, adminUser string) (*testDevice, string) {
+	t.Helper()
+	device, err := createEnrolledTestDevice(ctx, tenantID, adminUser, globalTestApp.OfficeClient(), globalTestApp.DeviceClient())
+	require.NoError(t, err)
+
+	notificationToken, err := util.RandomString(10)
+	require.NoError(t, err)
+	_, err = globalTestApp.DeviceClient().UpdateNotificationToken(ctx, auth.WithToken(device.mustToken(t), &connect.Request[homecallv1alpha.UpdateNotificationTokenRequest]{
+		Msg: &homecallv1alpha.UpdateNotificationTokenRequest{NotificationToken: notificationToken},
+	}))
+	require.NoError(t, err)
+
+	_, err = globalTestApp.DeviceClient().Heartbeat(ctx, auth.WithToken(device.mustToken(t), &connect.Request[homecallv1alpha.HeartbeatRequest]{
+		Msg: &homecallv1alpha.HeartbeatRequest{
+			State: &homecallv1alpha.DeviceState{AppState: homecallv1alpha.AppState_APP_STATE_FOREGROUND},
+		},
+	}))
+	require.NoError(t, err)
+	return device, notificationToken
+}
+
+// addTestMember invites user to the tenant, accepts the invite and returns the member ID.
+func addTestMember(ctx context.Context, t *testing.T, tenantID string, adminUser string, user string, role homecallv1alpha.Role) string {
+	t.Helper()
+	invite, err := globalTestApp.TenantClient().CreateTenantInvite(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.CreateTenantInviteRequest]{
+		Msg: &homecallv1alpha.CreateTenantInviteRequest{TenantId: tenantID, Email: user, Role: role},
+	}))
+	require.NoError(t, err)
+	_, err = globalTestApp.TenantClient().AcceptTenantInvite(ctx, auth.WithDummyToken(user, &connect.Request[homecallv1alpha.AcceptTenantInviteRequest]{
+		Msg: &homecallv1alpha.AcceptTenantInviteRequest{Id: invite.Msg.GetTenantInvite().GetId()},
+	}))
+	require.NoError(t, err)
+	return testMemberID(ctx, t, tenantID, adminUser, user)
+}
+
+// testMemberID returns the member ID of user in the tenant.
+func testMemberID(ctx context.Context, t *testing.T, tenantID string, adminUser string, user string) string {
+	t.Helper()
+	members, err := globalTestApp.TenantClient().ListTenantMembers(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.ListTenantMembersRequest]{
+		Msg: &homecallv1alpha.ListTenantMembersRequest{TenantId: tenantID},
+	}))
+	require.NoError(t, err)
+	for _, member := range members.Msg.GetTenantMembers() {
+		if member.GetVerifiedEmail() == user {
+			return member.GetId()
+		}
+	}
+	t.Fatalf("%s is not a member of the tenant", user)
+	return ""
+}
+
 func TestTenantMemberAdmin(t *testing.T) {
 	t.Parallel()
 	ctx := testContext(t)
@@ -491,3 +826,1072 @@ func TestTenantDelete(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, isDeleted(t))
 }
+
+func TestScheduledCalls(t *testing.T) {
+	t.Parallel()
+	ctx := testContext(t)
+	adminUser := randomUser()
+	tenant, err := createTestTenant(t.Name(), adminUser, globalTestApp.TenantClient())
+	require.NoError(t, err)
+
+	device, notificationToken := createCallableTestDevice(ctx, t, tenant.Id, adminUser)
+	adminMemberId := testMemberID(ctx, t, tenant.Id, adminUser, adminUser)
+	carerUser := randomUser()
+	carerMemberId := addTestMember(ctx, t, tenant.Id, adminUser, carerUser, homecallv1alpha.Role_ROLE_MEMBER)
+
+	watchCtx, cancelWatch := context.WithCancel(ctx)
+	defer cancelWatch()
+	events, err := globalTestApp.OfficeClient().WatchScheduledCalls(watchCtx, auth.WithDummyToken(carerUser, &connect.Request[homecallv1alpha.WatchScheduledCallsRequest]{
+		Msg: &homecallv1alpha.WatchScheduledCallsRequest{TenantId: tenant.Id},
+	}))
+	require.NoError(t, err)
+
+	scheduleCall := func(startsAt time.Time, durationSeconds int64) (*homecallv1alpha.ScheduledCall, error) {
+		scheduled, err := globalTestApp.OfficeClient().ScheduleCall(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.ScheduleCallRequest]{
+			Msg: &homecallv1alpha.ScheduleCallRequest{
+				DeviceId:         device.ID,
+				StartsAt:         timestamppb.New(startsAt),
+				DurationSeconds:  durationSeconds,
+				AssignedMemberId: carerMemberId,
+			},
+		}))
+		if err != nil {
+			return nil, err
+		}
+		return scheduled.Msg.GetScheduledCall(), nil
+	}
+
+	// Calls can only be scheduled in the future
+	_, err = scheduleCall(time.Now().Add(-time.Minute), 600)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	_, err = scheduleCall(time.Now().Add(time.Hour), 0)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	later, err := scheduleCall(time.Now().Add(time.Hour), 1800)
+	require.NoError(t, err)
+	assert.Equal(t, homecallv1alpha.ScheduledCallStatus_SCHEDULED_CALL_STATUS_SCHEDULED, later.GetStatus())
+	assert.Equal(t, carerMemberId, later.GetAssignedMemberId())
+	assert.Equal(t, adminMemberId, later.GetCreatedByMemberId())
+
+	// A call starting right away is reminded and, as the device never joins, missed
+	soon, err := scheduleCall(time.Now().Add(2*time.Second), 1)
+	require.NoError(t, err)
+
+	require.True(t, events.Receive())
+	assert.Equal(t, homecallv1alpha.ScheduledCallEventType_SCHEDULED_CALL_EVENT_TYPE_REMINDER, events.Msg().GetType())
+	assert.Equal(t, soon.GetId(), events.Msg().GetScheduledCall().GetId())
+	require.True(t, events.Receive())
+	assert.Equal(t, homecallv1alpha.ScheduledCallEventType_SCHEDULED_CALL_EVENT_TYPE_MISSED, events.Msg().GetType())
+	assert.Equal(t, soon.GetId(), events.Msg().GetScheduledCall().GetId())
+	assert.Equal(t, homecallv1alpha.ScheduledCallStatus_SCHEDULED_CALL_STATUS_MISSED, events.Msg().GetScheduledCall().GetStatus())
+	assert.NotNil(t, events.Msg().GetScheduledCall().GetRemindedAt())
+
+	notificationDir := path.Join(globalTestApp.NotificationsDir(), directorynotifications.DevicesDirectory, notificationToken)
+	entries, err := os.ReadDir(notificationDir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	content, err := os.ReadFile(path.Join(notificationDir, entries[0].Name()))
+	require.NoError(t, err)
+	message := &messaging.Message{}
+	require.NoError(t, message.UnmarshalJSON(content))
+	assert.Equal(t, "scheduledCallReminder", message.Data["type"])
+	assert.Equal(t, soon.GetId(), message.Data["scheduledCallId"])
+
+	list, err := globalTestApp.OfficeClient().ListScheduledCalls(ctx, auth.WithDummyToken(carerUser, &connect.Request[homecallv1alpha.ListScheduledCallsRequest]{
+		Msg: &homecallv1alpha.ListScheduledCallsRequest{
+			TenantId: tenant.Id,
+			From:     timestamppb.New(time.Now().Add(-time.Hour)),
+		},
+	}))
+	require.NoError(t, err)
+	require.Len(t, list.Msg.GetScheduledCalls(), 2)
+	assert.Equal(t, soon.GetId(), list.Msg.GetScheduledCalls()[0].GetId())
+	assert.Equal(t, later.GetId(), list.Msg.GetScheduledCalls()[1].GetId())
+
+	// Calls that have taken place can not be changed
+	_, err = globalTestApp.OfficeClient().CancelScheduledCall(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.CancelScheduledCallRequest]{
+		Msg: &homecallv1alpha.CancelScheduledCallRequest{ScheduledCallId: soon.GetId()},
+	}))
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+	// Without an assigned member the caller is assigned
+	updated, err := globalTestApp.OfficeClient().UpdateScheduledCall(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.UpdateScheduledCallRequest]{
+		Msg: &homecallv1alpha.UpdateScheduledCallRequest{
+			ScheduledCallId: later.GetId(),
+			StartsAt:        timestamppb.New(later.GetStartsAt().AsTime().Add(time.Hour)),
+			DurationSeconds: 900,
+		},
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, adminMemberId, updated.Msg.GetScheduledCall().GetAssignedMemberId())
+	assert.Equal(t, int64(900), updated.Msg.GetScheduledCall().GetDurationSeconds())
+	assert.True(t, later.GetStartsAt().AsTime().Add(time.Hour).Equal(updated.Msg.GetScheduledCall().GetStartsAt().AsTime()))
+
+	cancelled, err := globalTestApp.OfficeClient().CancelScheduledCall(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.CancelScheduledCallRequest]{
+		Msg: &homecallv1alpha.CancelScheduledCallRequest{ScheduledCallId: later.GetId()},
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, homecallv1alpha.ScheduledCallStatus_SCHEDULED_CALL_STATUS_CANCELLED, cancelled.Msg.GetScheduledCall().GetStatus())
+
+	// Outsiders can not see the scheduled calls
+	_, err = globalTestApp.OfficeClient().ListScheduledCalls(ctx, auth.WithDummyToken(randomUser(), &connect.Request[homecallv1alpha.ListScheduledCallsRequest]{
+		Msg: &homecallv1alpha.ListScheduledCallsRequest{TenantId: tenant.Id},
+	}))
+	require.Error(t, err)
+}
+
+func TestCallSchedules(t *testing.T) {
+	t.Parallel()
+	ctx := testContext(t)
+	adminUser := randomUser()
+	tenant, err := createTestTenant(t.Name(), adminUser, globalTestApp.TenantClient())
+	require.NoError(t, err)
+
+	device, _ := createCallableTestDevice(ctx, t, tenant.Id, adminUser)
+	updateTimeZone := func(timeZone string) error {
+		_, err := globalTestApp.OfficeClient().UpdateDevice(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.UpdateDeviceRequest]{
+			Msg: &homecallv1alpha.UpdateDeviceRequest{DeviceId: device.ID, TimeZone: timeZone},
+		}))
+		return err
+	}
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(updateTimeZone("Europe/Nowhere")))
+	require.NoError(t, updateTimeZone("Europe/Stockholm"))
+	stockholm, err := time.LoadLocation("Europe/Stockholm")
+	require.NoError(t, err)
+
+	listOccurrences := func() []*homecallv1alpha.ScheduledCall {
+		list, err := globalTestApp.OfficeClient().ListScheduledCalls(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.ListScheduledCallsRequest]{
+			Msg: &homecallv1alpha.ListScheduledCallsRequest{TenantId: tenant.Id, DeviceId: device.ID},
+		}))
+		require.NoError(t, err)
+		return list.Msg.GetScheduledCalls()
+	}
+
+	tomorrow := time.Now().In(stockholm).AddDate(0, 0, 1)
+	startsAt := time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), 10, 0, 0, 0, stockholm)
+	createSchedule := func(rrule string) (*homecallv1alpha.CallSchedule, error) {
+		created, err := globalTestApp.OfficeClient().CreateCallSchedule(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.CreateCallScheduleRequest]{
+			Msg: &homecallv1alpha.CreateCallScheduleRequest{
+				DeviceId:        device.ID,
+				Rrule:           rrule,
+				StartsAt:        timestamppb.New(startsAt),
+				DurationSeconds: 900,
+			},
+		}))
+		if err != nil {
+			return nil, err
+		}
+		return created.Msg.GetCallSchedule(), nil
+	}
+
+	_, err = createSchedule("FREQ=HOURLY")
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	_, err = createSchedule("not a rule")
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	schedule, err := createSchedule("FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR")
+	require.NoError(t, err)
+	assert.Equal(t, "Europe/Stockholm", schedule.GetTimeZone())
+	assert.True(t, startsAt.Equal(schedule.GetStartsAt().AsTime()))
+
+	// The upcoming weekdays are added as scheduled calls at 10:00 on the clock of the device
+	occurrences := listOccurrences()
+	require.GreaterOrEqual(t, len(occurrences), 9)
+	for _, occurrence := range occurrences {
+		local := occurrence.GetStartsAt().AsTime().In(stockholm)
+		assert.Equal(t, schedule.GetId(), occurrence.GetScheduleId())
+		assert.Equal(t, 10, local.Hour())
+		assert.NotEqual(t, time.Saturday, local.Weekday())
+		assert.NotEqual(t, time.Sunday, local.Weekday())
+	}
+
+	// Skip the first occurrence
+	skipped := occurrences[0]
+	_, err = globalTestApp.OfficeClient().SkipCallScheduleOccurrence(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.SkipCallScheduleOccurrenceRequest]{
+		Msg: &homecallv1alpha.SkipCallScheduleOccurrenceRequest{ScheduleId: schedule.GetId(), StartsAt: skipped.GetStartsAt()},
+	}))
+	require.NoError(t, err)
+	_, err = globalTestApp.OfficeClient().SkipCallScheduleOccurrence(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.SkipCallScheduleOccurrenceRequest]{
+		Msg: &homecallv1alpha.SkipCallScheduleOccurrenceRequest{ScheduleId: schedule.GetId(), StartsAt: timestamppb.New(skipped.GetStartsAt().AsTime().Add(time.Hour))},
+	}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	occurrences = listOccurrences()
+	assert.Equal(t, skipped.GetId(), occurrences[0].GetId())
+	assert.Equal(t, homecallv1alpha.ScheduledCallStatus_SCHEDULED_CALL_STATUS_CANCELLED, occurrences[0].GetStatus())
+
+	// Moving the device to another time zone moves the upcoming calls along
+	require.NoError(t, updateTimeZone("America/New_York"))
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	for _, occurrence := range listOccurrences() {
+		if occurrence.GetStatus() == homecallv1alpha.ScheduledCallStatus_SCHEDULED_CALL_STATUS_CANCELLED {
+			continue
+		}
+		assert.Equal(t, 10, occurrence.GetStartsAt().AsTime().In(newYork).Hour())
+	}
+
+	// Updating the schedule replaces its upcoming occurrences
+	_, err = globalTestApp.OfficeClient().UpdateCallSchedule(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.UpdateCallScheduleRequest]{
+		Msg: &homecallv1alpha.UpdateCallScheduleRequest{
+			ScheduleId:      schedule.GetId(),
+			Rrule:           "FREQ=WEEKLY;BYDAY=SA",
+			StartsAt:        timestamppb.New(startsAt),
+			DurationSeconds: 600,
+		},
+	}))
+	require.NoError(t, err)
+	for _, occurrence := range listOccurrences() {
+		if occurrence.GetStatus() == homecallv1alpha.ScheduledCallStatus_SCHEDULED_CALL_STATUS_CANCELLED {
+			continue
+		}
+		assert.Equal(t, time.Saturday, occurrence.GetStartsAt().AsTime().In(newYork).Weekday())
+		assert.Equal(t, int64(600), occurrence.GetDurationSeconds())
+	}
+
+	schedulesList, err := globalTestApp.OfficeClient().ListCallSchedules(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.ListCallSchedulesRequest]{
+		Msg: &homecallv1alpha.ListCallSchedulesRequest{TenantId: tenant.Id},
+	}))
+	require.NoError(t, err)
+	require.Len(t, schedulesList.Msg.GetCallSchedules(), 1)
+	assert.Equal(t, "FREQ=WEEKLY;BYDAY=SA", schedulesList.Msg.GetCallSchedules()[0].GetRrule())
+
+	// Removing the schedule removes its upcoming occurrences
+	_, err = globalTestApp.OfficeClient().RemoveCallSchedule(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.RemoveCallScheduleRequest]{
+		Msg: &homecallv1alpha.RemoveCallScheduleRequest{ScheduleId: schedule.GetId()},
+	}))
+	require.NoError(t, err)
+	for _, occurrence := range listOccurrences() {
+		assert.Equal(t, homecallv1alpha.ScheduledCallStatus_SCHEDULED_CALL_STATUS_CANCELLED, occurrence.GetStatus())
+	}
+}
+
+func TestCalendarFeed(t *testing.T) {
+	t.Parallel()
+	ctx := testContext(t)
+	adminUser := randomUser()
+	tenant, err := createTestTenant(t.Name(), adminUser, globalTestApp.TenantClient())
+	require.NoError(t, err)
+
+	device, _ := createCallableTestDevice(ctx, t, tenant.Id, adminUser)
+	carerUser := randomUser()
+	carerMemberId := addTestMember(ctx, t, tenant.Id, adminUser, carerUser, homecallv1alpha.Role_ROLE_MEMBER)
+
+	startsAt := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+	scheduled, err := globalTestApp.OfficeClient().ScheduleCall(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.ScheduleCallRequest]{
+		Msg: &homecallv1alpha.ScheduleCallRequest{
+			DeviceId:         device.ID,
+			StartsAt:         timestamppb.New(startsAt),
+			DurationSeconds:  1800,
+			AssignedMemberId: carerMemberId,
+		},
+	}))
+	require.NoError(t, err)
+
+	created, err := globalTestApp.OfficeClient().CreateCalendarFeedToken(ctx, auth.WithDummyToken(carerUser, &connect.Request[homecallv1alpha.CreateCalendarFeedTokenRequest]{
+		Msg: &homecallv1alpha.CreateCalendarFeedTokenRequest{TenantId: tenant.Id},
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, carerMemberId, created.Msg.GetToken().GetMemberId())
+	assert.Nil(t, created.Msg.GetToken().GetLastUsedAt())
+
+	fetchFeed := func(url string) (int, string) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		require.NoError(t, err)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp.StatusCode, string(body)
+	}
+
+	// The feed contains the calls assigned to the member, in UTC
+	status, body := fetchFeed(created.Msg.GetFeedUrl())
+	require.Equal(t, http.StatusOK, status)
+	assert.True(t, strings.HasPrefix(body, "BEGIN:VCALENDAR\r\n"))
+	assert.Contains(t, body, "UID:"+scheduled.Msg.GetScheduledCall().GetId()+"@homecall\r\n")
+	assert.Contains(t, body, "DTSTART:"+startsAt.UTC().Format("20060102T150405Z")+"\r\n")
+	assert.Contains(t, body, "DTEND:"+startsAt.Add(30*time.Minute).UTC().Format("20060102T150405Z")+"\r\n")
+	assert.Contains(t, body, "STATUS:CONFIRMED\r\n")
+
+	// The admin is not assigned the call
+	adminCreated, err := globalTestApp.OfficeClient().CreateCalendarFeedToken(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.CreateCalendarFeedTokenRequest]{
+		Msg: &homecallv1alpha.CreateCalendarFeedTokenRequest{TenantId: tenant.Id},
+	}))
+	require.NoError(t, err)
+	status, body = fetchFeed(adminCreated.Msg.GetFeedUrl())
+	require.Equal(t, http.StatusOK, status)
+	assert.NotContains(t, body, "BEGIN:VEVENT")
+
+	tokens, err := globalTestApp.OfficeClient().ListCalendarFeedTokens(ctx, auth.WithDummyToken(carerUser, &connect.Request[homecallv1alpha.ListCalendarFeedTokensRequest]{
+		Msg: &homecallv1alpha.ListCalendarFeedTokensRequest{TenantId: tenant.Id},
+	}))
+	require.NoError(t, err)
+	require.Len(t, tokens.Msg.GetTokens(), 1)
+	assert.Equal(t, created.Msg.GetToken().GetId(), tokens.Msg.GetTokens()[0].GetId())
+	assert.NotNil(t, tokens.Msg.GetTokens()[0].GetLastUsedAt())
+
+	// Members can not revoke the tokens of others, but admins can
+	_, err = globalTestApp.OfficeClient().RevokeCalendarFeedToken(ctx, auth.WithDummyToken(carerUser, &connect.Request[homecallv1alpha.RevokeCalendarFeedTokenRequest]{
+		Msg: &homecallv1alpha.RevokeCalendarFeedTokenRequest{TokenId: adminCreated.Msg.GetToken().GetId()},
+	}))
+	assert.Error(t, err)
+	_, err = globalTestApp.OfficeClient().RevokeCalendarFeedToken(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.RevokeCalendarFeedTokenRequest]{
+		Msg: &homecallv1alpha.RevokeCalendarFeedTokenRequest{TokenId: created.Msg.GetToken().GetId()},
+	}))
+	require.NoError(t, err)
+
+	// Revoked and unknown tokens can not be used
+	status, _ = fetchFeed(created.Msg.GetFeedUrl())
+	assert.Equal(t, http.StatusNotFound, status)
+	status, _ = fetchFeed(globalTestApp.ApiAddress() + "/calendar/unknown.ics")
+	assert.Equal(t, http.StatusNotFound, status)
+}
+
+func TestQuietHours(t *testing.T) {
+	t.Parallel()
+	ctx := testContext(t)
+	adminUser := randomUser()
+	tenant, err := createTestTenant(t.Name(), adminUser, globalTestApp.TenantClient())
+	require.NoError(t, err)
+
+	device, _ := createCallableTestDevice(ctx, t, tenant.Id, adminUser)
+
+	// Quiet hours from an hour ago to an hour from now on the wall clock of the device
+	location, err := time.LoadLocation("Europe/Stockholm")
+	require.NoError(t, err)
+	now := time.Now().In(location)
+	minute := int32(now.Hour()*60 + now.Minute())
+	quietHours := &homecallv1alpha.QuietHours{
+		StartMinute: (minute + 23*60) % (24 * 60),
+		EndMinute:   (minute + 60) % (24 * 60),
+	}
+
+	devices, err := globalTestApp.OfficeClient().ListDevices(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.ListDevicesRequest]{
+		Msg: &homecallv1alpha.ListDevicesRequest{TenantId: tenant.Id},
+	}))
+	require.NoError(t, err)
+	require.Len(t, devices.Msg.GetDevices(), 1)
+	name := devices.Msg.GetDevices()[0].GetName()
+
+	updateDevice := func(req *homecallv1alpha.UpdateDeviceRequest) (*homecallv1alpha.Device, error) {
+		req.DeviceId = device.ID
+		updated, err := globalTestApp.OfficeClient().UpdateDevice(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.UpdateDeviceRequest]{
+			Msg: req,
+		}))
+		if err != nil {
+			return nil, err
+		}
+		return updated.Msg.GetDevice(), nil
+	}
+
+	_, err = updateDevice(&homecallv1alpha.UpdateDeviceRequest{
+		QuietHours: &homecallv1alpha.QuietHours{StartMinute: 60, EndMinute: 60},
+	})
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	_, err = updateDevice(&homecallv1alpha.UpdateDeviceRequest{
+		QuietHours: &homecallv1alpha.QuietHours{StartMinute: 0, EndMinute: 24 * 60},
+	})
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	updated, err := updateDevice(&homecallv1alpha.UpdateDeviceRequest{
+		TimeZone:   "Europe/Stockholm",
+		QuietHours: quietHours,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, quietHours.GetStartMinute(), updated.GetQuietHours().GetStartMinute())
+	assert.Equal(t, "Europe/Stockholm", updated.GetQuietHours().GetTimeZone())
+	// Fields that are not set are not changed
+	assert.Equal(t, name, updated.GetName())
+
+	// The device receives the quiet hours with its heartbeats
+	heartbeat, err := globalTestApp.DeviceClient().Heartbeat(ctx, auth.WithToken(device.mustToken(t), &connect.Request[homecallv1alpha.HeartbeatRequest]{
+		Msg: &homecallv1alpha.HeartbeatRequest{
+			State: &homecallv1alpha.DeviceState{AppState: homecallv1alpha.AppState_APP_STATE_FOREGROUND},
+		},
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, quietHours.GetEndMinute(), heartbeat.Msg.GetQuietHours().GetEndMinute())
+	assert.Equal(t, "Europe/Stockholm", heartbeat.Msg.GetQuietHours().GetTimeZone())
+
+	startCall := func(override bool) (*homecallv1alpha.Call, error) {
+		started, err := globalTestApp.OfficeClient().StartCall(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.StartCallRequest]{
+			Msg: &homecallv1alpha.StartCallRequest{
+				DeviceId:           device.ID,
+				OverrideQuietHours: override,
+			},
+		}))
+		if err != nil {
+			return nil, err
+		}
+		return started.Msg.GetCall(), nil
+	}
+
+	// Calls during quiet hours are refused unless overridden, the override is recorded
+	_, err = startCall(false)
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	call, err := startCall(true)
+	require.NoError(t, err)
+	for _, participant := range call.GetParticipants() {
+		assert.Equal(t, participant.GetDeviceId() == device.ID, participant.GetQuietHoursOverridden())
+	}
+
+	// Without quiet hours the device can be called and nothing is recorded
+	updated, err = updateDevice(&homecallv1alpha.UpdateDeviceRequest{ClearQuietHours: true})
+	require.NoError(t, err)
+	assert.Nil(t, updated.GetQuietHours())
+	assert.Equal(t, name, updated.GetName())
+	assert.Equal(t, "Europe/Stockholm", updated.GetTimeZone())
+	call, err = startCall(false)
+	require.NoError(t, err)
+	for _, participant := range call.GetParticipants() {
+		assert.False(t, participant.GetQuietHoursOverridden())
+	}
+}
+
+func TestAllowedCallers(t *testing.T) {
+	t.Parallel()
+	ctx := testContext(t)
+	adminUser := randomUser()
+	tenant, err := createTestTenant(t.Name(), adminUser, globalTestApp.TenantClient())
+	require.NoError(t, err)
+
+	device, _ := createCallableTestDevice(ctx, t, tenant.Id, adminUser)
+	allowedUser := randomUser()
+	allowedMemberId := addTestMember(ctx, t, tenant.Id, adminUser, allowedUser, homecallv1alpha.Role_ROLE_MEMBER)
+	groupUser := randomUser()
+	groupMemberId := addTestMember(ctx, t, tenant.Id, adminUser, groupUser, homecallv1alpha.Role_ROLE_MEMBER)
+	otherUser := randomUser()
+	addTestMember(ctx, t, tenant.Id, adminUser, otherUser, homecallv1alpha.Role_ROLE_MEMBER)
+
+	// The group member is scoped to the group of the device
+	group, err := globalTestApp.OfficeClient().CreateDeviceGroup(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.CreateDeviceGroupRequest]{
+		Msg: &homecallv1alpha.CreateDeviceGroupRequest{TenantId: tenant.Id, Name: "Ward"},
+	}))
+	require.NoError(t, err)
+	groupId := group.Msg.GetGroup().GetId()
+	_, err = globalTestApp.OfficeClient().SetDeviceGroup(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.SetDeviceGroupRequest]{
+		Msg: &homecallv1alpha.SetDeviceGroupRequest{DeviceId: device.ID, GroupId: groupId},
+	}))
+	require.NoError(t, err)
+	_, err = globalTestApp.TenantClient().SetTenantMemberDeviceGroups(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.SetTenantMemberDeviceGroupsRequest]{
+		Msg: &homecallv1alpha.SetTenantMemberDeviceGroupsRequest{MemberId: groupMemberId, DeviceGroupScoped: true, DeviceGroupIds: []string{groupId}},
+	}))
+	require.NoError(t, err)
+
+	startCall := func(user string) error {
+		_, err := globalTestApp.OfficeClient().StartCall(ctx, auth.WithDummyToken(user, &connect.Request[homecallv1alpha.StartCallRequest]{
+			Msg: &homecallv1alpha.StartCallRequest{DeviceId: device.ID},
+		}))
+		return err
+	}
+	setAllowedCallers := func(user string, restricted bool, callers ...*homecallv1alpha.AllowedCaller) (*homecallv1alpha.SetAllowedCallersResponse, error) {
+		resp, err := globalTestApp.OfficeClient().SetAllowedCallers(ctx, auth.WithDummyToken(user, &connect.Request[homecallv1alpha.SetAllowedCallersRequest]{
+			Msg: &homecallv1alpha.SetAllowedCallersRequest{DeviceId: device.ID, Restricted: restricted, Callers: callers},
+		}))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg, nil
+	}
+	memberCaller := &homecallv1alpha.AllowedCaller{Caller: &homecallv1alpha.AllowedCaller_MemberId{MemberId: allowedMemberId}}
+	groupCaller := &homecallv1alpha.AllowedCaller{Caller: &homecallv1alpha.AllowedCaller_GroupId{GroupId: groupId}}
+
+	// Unrestricted devices can be called by every member
+	require.NoError(t, startCall(otherUser))
+
+	// Only admins can restrict calls
+	_, err = setAllowedCallers(allowedUser, true, memberCaller)
+	assert.Error(t, err)
+	_, err = setAllowedCallers(adminUser, true, &homecallv1alpha.AllowedCaller{})
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	allowed, err := setAllowedCallers(adminUser, true, memberCaller, groupCaller)
+	require.NoError(t, err)
+	assert.True(t, allowed.GetRestricted())
+	require.Len(t, allowed.GetCallers(), 2)
+	assert.Equal(t, allowedMemberId, allowed.GetCallers()[0].GetMemberId())
+	assert.Equal(t, groupId, allowed.GetCallers()[1].GetGroupId())
+
+	require.NoError(t, startCall(allowedUser))
+	require.NoError(t, startCall(groupUser))
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(startCall(otherUser)))
+	// Admins bypass the restriction
+	require.NoError(t, startCall(adminUser))
+
+	// Removing the last allowed caller does not lift the restriction
+	_, err = setAllowedCallers(adminUser, true)
+	require.NoError(t, err)
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(startCall(allowedUser)))
+
+	_, err = setAllowedCallers(adminUser, false)
+	require.NoError(t, err)
+	require.NoError(t, startCall(otherUser))
+
+	// Every change is recorded
+	events, err := globalTestApp.OfficeClient().ListAllowedCallerEvents(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.ListAllowedCallerEventsRequest]{
+		Msg: &homecallv1alpha.ListAllowedCallerEventsRequest{DeviceId: device.ID},
+	}))
+	require.NoError(t, err)
+	var actions []homecallv1alpha.AllowedCallerAction
+	for _, event := range events.Msg.GetEvents() {
+		actions = append(actions, event.GetAction())
+		assert.NotEmpty(t, event.GetChangedByMemberId())
+	}
+	assert.Equal(t, []homecallv1alpha.AllowedCallerAction{
+		homecallv1alpha.AllowedCallerAction_ALLOWED_CALLER_ACTION_RESTRICTED,
+		homecallv1alpha.AllowedCallerAction_ALLOWED_CALLER_ACTION_ADDED,
+		homecallv1alpha.AllowedCallerAction_ALLOWED_CALLER_ACTION_ADDED,
+		homecallv1alpha.AllowedCallerAction_ALLOWED_CALLER_ACTION_REMOVED,
+		homecallv1alpha.AllowedCallerAction_ALLOWED_CALLER_ACTION_REMOVED,
+		homecallv1alpha.AllowedCallerAction_ALLOWED_CALLER_ACTION_UNRESTRICTED,
+	}, actions)
+	assert.Equal(t, allowedMemberId, events.Msg.GetEvents()[1].GetCaller().GetMemberId())
+	assert.Equal(t, groupId, events.Msg.GetEvents()[2].GetCaller().GetGroupId())
+}
+
+func TestAutoAnswer(t *testing.T) {
+	t.Parallel()
+	ctx := testContext(t)
+	adminUser := randomUser()
+	tenant, err := createTestTenant(t.Name(), adminUser, globalTestApp.TenantClient())
+	require.NoError(t, err)
+
+	device, notificationToken := createCallableTestDevice(ctx, t, tenant.Id, adminUser)
+	allowedUser := randomUser()
+	allowedMemberId := addTestMember(ctx, t, tenant.Id, adminUser, allowedUser, homecallv1alpha.Role_ROLE_MEMBER)
+	otherUser := randomUser()
+	addTestMember(ctx, t, tenant.Id, adminUser, otherUser, homecallv1alpha.Role_ROLE_MEMBER)
+
+	// The member is an allowed caller, without restricting who may call the device
+	_, err = globalTestApp.OfficeClient().SetAllowedCallers(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.SetAllowedCallersRequest]{
+		Msg: &homecallv1alpha.SetAllowedCallersRequest{
+			DeviceId: device.ID,
+			Callers: []*homecallv1alpha.AllowedCaller{
+				{Caller: &homecallv1alpha.AllowedCaller_MemberId{MemberId: allowedMemberId}},
+			},
+		},
+	}))
+	require.NoError(t, err)
+
+	setPolicy := func(policy *homecallv1alpha.AutoAnswerPolicy) error {
+		_, err := globalTestApp.OfficeClient().UpdateDevice(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.UpdateDeviceRequest]{
+			Msg: &homecallv1alpha.UpdateDeviceRequest{DeviceId: device.ID, AutoAnswer: policy},
+		}))
+		return err
+	}
+	// deviceDecision starts a call and returns the auto-answer decision for the device
+	deviceDecision := func(user string) (*homecallv1alpha.CallParticipant, *homecallv1alpha.GetCallDetailsResponse) {
+		started, err := globalTestApp.OfficeClient().StartCall(ctx, auth.WithDummyToken(user, &connect.Request[homecallv1alpha.StartCallRequest]{
+			Msg: &homecallv1alpha.StartCallRequest{DeviceId: device.ID},
+		}))
+		require.NoError(t, err)
+		details, err := globalTestApp.DeviceClient().GetCallDetails(ctx, auth.WithToken(device.mustToken(t), &connect.Request[homecallv1alpha.GetCallDetailsRequest]{
+			Msg: &homecallv1alpha.GetCallDetailsRequest{CallId: started.Msg.GetCallId()},
+		}))
+		require.NoError(t, err)
+		for _, participant := range started.Msg.GetCall().GetParticipants() {
+			if participant.GetDeviceId() == device.ID {
+				return participant, details.Msg
+			}
+		}
+		require.Fail(t, "device is not a participant")
+		return nil, nil
+	}
+
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(setPolicy(&homecallv1alpha.AutoAnswerPolicy{Enabled: true, DelaySeconds: 3600})))
+	require.NoError(t, setPolicy(&homecallv1alpha.AutoAnswerPolicy{Enabled: true, DelaySeconds: 5, AllowedCallersOnly: true}))
+
+	participant, details := deviceDecision(allowedUser)
+	assert.True(t, participant.GetAutoAnswer())
+	assert.Equal(t, int64(5), participant.GetAutoAnswerDelaySeconds())
+	assert.True(t, details.GetAutoAnswer())
+	assert.Equal(t, int64(5), details.GetAutoAnswerDelaySeconds())
+
+	// The decision is sent to the device with the call
+	notificationDir := path.Join(globalTestApp.NotificationsDir(), directorynotifications.DevicesDirectory, notificationToken)
+	entries, err := os.ReadDir(notificationDir)
+	require.NoError(t, err)
+	var found bool
+	for _, entry := range entries {
+		content, err := os.ReadFile(path.Join(notificationDir, entry.Name()))
+		require.NoError(t, err)
+		message := &messaging.Message{}
+		require.NoError(t, message.UnmarshalJSON(content))
+		if message.Data["callId"] == details.GetCallId() {
+			found = true
+			assert.Equal(t, "true", message.Data["autoAnswer"])
+			assert.Equal(t, "5", message.Data["autoAnswerDelaySeconds"])
+		}
+	}
+	assert.True(t, found)
+
+	// Other members, admins included, are not answered automatically
+	participant, details = deviceDecision(otherUser)
+	assert.False(t, participant.GetAutoAnswer())
+	assert.False(t, details.GetAutoAnswer())
+	participant, _ = deviceDecision(adminUser)
+	assert.False(t, participant.GetAutoAnswer())
+
+	// Outside of the auto-answer hours calls are answered by hand
+	now := time.Now().UTC()
+	minute := int32(now.Hour()*60 + now.Minute())
+	require.NoError(t, setPolicy(&homecallv1alpha.AutoAnswerPolicy{
+		Enabled:     true,
+		StartMinute: (minute + 60) % (24 * 60),
+		EndMinute:   (minute + 120) % (24 * 60),
+	}))
+	participant, _ = deviceDecision(otherUser)
+	assert.False(t, participant.GetAutoAnswer())
+
+	require.NoError(t, setPolicy(&homecallv1alpha.AutoAnswerPolicy{Enabled: true}))
+	participant, details = deviceDecision(otherUser)
+	assert.True(t, participant.GetAutoAnswer())
+	assert.Equal(t, int64(0), details.GetAutoAnswerDelaySeconds())
+}
+
+func TestMissedCallsWaitForAutoAnswer(t *testing.T) {
+	t.Parallel()
+	ctx := testContext(t)
+	adminUser := randomUser()
+	tenant, err := createTestTenant(t.Name(), adminUser, globalTestApp.TenantClient())
+	require.NoError(t, err)
+
+	device, _ := createCallableTestDevice(ctx, t, tenant.Id, adminUser)
+	const delaySeconds = 4
+	_, err = globalTestApp.OfficeClient().UpdateDevice(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.UpdateDeviceRequest]{
+		Msg: &homecallv1alpha.UpdateDeviceRequest{
+			DeviceId:   device.ID,
+			AutoAnswer: &homecallv1alpha.AutoAnswerPolicy{Enabled: true, DelaySeconds: delaySeconds},
+		},
+	}))
+	require.NoError(t, err)
+
+	watchCtx, cancelWatch := context.WithCancel(ctx)
+	defer cancelWatch()
+	missedCalls, err := globalTestApp.OfficeClient().WatchMissedCalls(watchCtx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.WatchMissedCallsRequest]{
+		Msg: &homecallv1alpha.WatchMissedCallsRequest{TenantId: tenant.Id},
+	}))
+	require.NoError(t, err)
+
+	started := time.Now()
+	call, err := globalTestApp.OfficeClient().StartCall(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.StartCallRequest]{
+		Msg: &homecallv1alpha.StartCallRequest{DeviceId: device.ID},
+	}))
+	require.NoError(t, err)
+
+	// The call is not missed before the device has had the time to answer it by itself
+	require.True(t, missedCalls.Receive())
+	assert.Equal(t, call.Msg.GetCallId(), missedCalls.Msg().GetMissedCall().GetCallId())
+	// The test app uses a missed call timeout of 3 seconds
+	assert.GreaterOrEqual(t, time.Since(started), (3+delaySeconds)*time.Second)
+}
+
+func TestMissedCalls(t *testing.T) {
+	t.Parallel()
+	ctx := testContext(t)
+	adminUser := randomUser()
+	tenant, err := createTestTenant(t.Name(), adminUser, globalTestApp.TenantClient())
+	require.NoError(t, err)
+
+	device, notificationToken := createCallableTestDevice(ctx, t, tenant.Id, adminUser)
+	callerUser := randomUser()
+	callerMemberId := addTestMember(ctx, t, tenant.Id, adminUser, callerUser, homecallv1alpha.Role_ROLE_MEMBER)
+
+	watchCtx, cancelWatch := context.WithCancel(ctx)
+	defer cancelWatch()
+	missedCalls, err := globalTestApp.OfficeClient().WatchMissedCalls(watchCtx, auth.WithDummyToken(callerUser, &connect.Request[homecallv1alpha.WatchMissedCallsRequest]{
+		Msg: &homecallv1alpha.WatchMissedCallsRequest{TenantId: tenant.Id},
+	}))
+	require.NoError(t, err)
+
+	startCall := func() string {
+		started, err := globalTestApp.OfficeClient().StartCall(ctx, auth.WithDummyToken(callerUser, &connect.Request[homecallv1alpha.StartCallRequest]{
+			Msg: &homecallv1alpha.StartCallRequest{DeviceId: device.ID},
+		}))
+		require.NoError(t, err)
+		return started.Msg.GetCallId()
+	}
+
+	// The device does not join in time
+	timedOutCallId := startCall()
+	require.True(t, missedCalls.Receive())
+	timedOut := missedCalls.Msg().GetMissedCall()
+	assert.Equal(t, timedOutCallId, timedOut.GetCallId())
+	assert.Equal(t, device.ID, timedOut.GetDeviceId())
+	assert.Equal(t, callerMemberId, timedOut.GetCallerMemberId())
+	assert.NotEmpty(t, timedOut.GetCallerDisplayName())
+	assert.Equal(t, homecallv1alpha.MissedCallReason_MISSED_CALL_REASON_TIMEOUT, timedOut.GetReason())
+
+	// The device declines the call
+	declinedCallId := startCall()
+	_, err = globalTestApp.DeviceClient().UpdateCallState(ctx, auth.WithToken(device.mustToken(t), &connect.Request[homecallv1alpha.UpdateCallStateRequest]{
+		Msg: &homecallv1alpha.UpdateCallStateRequest{
+			CallId: declinedCallId,
+			State:  homecallv1alpha.CallParticipantState_CALL_PARTICIPANT_STATE_DECLINED,
+		},
+	}))
+	require.NoError(t, err)
+	require.True(t, missedCalls.Receive())
+	declined := missedCalls.Msg().GetMissedCall()
+	assert.Equal(t, declinedCallId, declined.GetCallId())
+	assert.Equal(t, homecallv1alpha.MissedCallReason_MISSED_CALL_REASON_DECLINED, declined.GetReason())
+
+	// The device is told about the missed calls
+	notificationDir := path.Join(globalTestApp.NotificationsDir(), directorynotifications.DevicesDirectory, notificationToken)
+	entries, err := os.ReadDir(notificationDir)
+	require.NoError(t, err)
+	var notified []string
+	for _, entry := range entries {
+		content, err := os.ReadFile(path.Join(notificationDir, entry.Name()))
+		require.NoError(t, err)
+		message := &messaging.Message{}
+		require.NoError(t, message.UnmarshalJSON(content))
+		if message.Data["type"] == "missedCall" {
+			notified = append(notified, message.Data["missedCallId"])
+		}
+	}
+	assert.ElementsMatch(t, []string{timedOut.GetId(), declined.GetId()}, notified)
+
+	listMissedCalls := func() []*homecallv1alpha.MissedCall {
+		listed, err := globalTestApp.DeviceClient().ListMissedCalls(ctx, auth.WithToken(device.mustToken(t), &connect.Request[homecallv1alpha.ListMissedCallsRequest]{
+			Msg: &homecallv1alpha.ListMissedCallsRequest{},
+		}))
+		require.NoError(t, err)
+		return listed.Msg.GetMissedCalls()
+	}
+	listed := listMissedCalls()
+	require.Len(t, listed, 2)
+	assert.Equal(t, declined.GetId(), listed[0].GetId())
+	assert.Equal(t, timedOut.GetId(), listed[1].GetId())
+
+	_, err = globalTestApp.DeviceClient().DismissMissedCall(ctx, auth.WithToken(device.mustToken(t), &connect.Request[homecallv1alpha.DismissMissedCallRequest]{
+		Msg: &homecallv1alpha.DismissMissedCallRequest{MissedCallId: timedOut.GetId()},
+	}))
+	require.NoError(t, err)
+	listed = listMissedCalls()
+	require.Len(t, listed, 1)
+	assert.Equal(t, declined.GetId(), listed[0].GetId())
+
+	// Calling back dismisses the missed call and asking again keeps the open request
+	requestCallback := func(missedCallId string) (*homecallv1alpha.CallbackRequest, error) {
+		requested, err := globalTestApp.DeviceClient().RequestCallback(ctx, auth.WithToken(device.mustToken(t), &connect.Request[homecallv1alpha.RequestCallbackRequest]{
+			Msg: &homecallv1alpha.RequestCallbackRequest{MissedCallId: missedCallId},
+		}))
+		if err != nil {
+			return nil, err
+		}
+		return requested.Msg.GetCallbackRequest(), nil
+	}
+	_, err = requestCallback("unknown")
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	callbackRequest, err := requestCallback(declined.GetId())
+	require.NoError(t, err)
+	assert.Equal(t, tenant.Id, callbackRequest.GetTenantId())
+	assert.Equal(t, device.ID, callbackRequest.GetDeviceId())
+	assert.Equal(t, declined.GetId(), callbackRequest.GetMissedCall().GetId())
+	assert.Empty(t, listMissedCalls())
+	again, err := requestCallback("")
+	require.NoError(t, err)
+	assert.Equal(t, callbackRequest.GetId(), again.GetId())
+
+	// The request is in the queue of the tenant until a member resolves it
+	listCallbackRequests := func(includeResolved bool) []*homecallv1alpha.CallbackRequest {
+		listed, err := globalTestApp.OfficeClient().ListCallbackRequests(ctx, auth.WithDummyToken(callerUser, &connect.Request[homecallv1alpha.ListCallbackRequestsRequest]{
+			Msg: &homecallv1alpha.ListCallbackRequestsRequest{TenantId: tenant.Id, IncludeResolved: includeResolved},
+		}))
+		require.NoError(t, err)
+		return listed.Msg.GetCallbackRequests()
+	}
+	queue := listCallbackRequests(false)
+	require.Len(t, queue, 1)
+	assert.Equal(t, callbackRequest.GetId(), queue[0].GetId())
+
+	resolved, err := globalTestApp.OfficeClient().ResolveCallbackRequest(ctx, auth.WithDummyToken(callerUser, &connect.Request[homecallv1alpha.ResolveCallbackRequestRequest]{
+		Msg: &homecallv1alpha.ResolveCallbackRequestRequest{CallbackRequestId: callbackRequest.GetId()},
+	}))
+	require.NoError(t, err)
+	assert.NotNil(t, resolved.Msg.GetCallbackRequest().GetResolvedAt())
+	assert.Equal(t, callerMemberId, resolved.Msg.GetCallbackRequest().GetResolvedByMemberId())
+	assert.Empty(t, listCallbackRequests(false))
+	assert.Len(t, listCallbackRequests(true), 1)
+
+	// Once resolved the device can ask again
+	newRequest, err := requestCallback("")
+	require.NoError(t, err)
+	assert.NotEqual(t, callbackRequest.GetId(), newRequest.GetId())
+	assert.Nil(t, newRequest.GetMissedCall())
+}
+
+func TestDeviceMessages(t *testing.T) {
+	t.Parallel()
+	ctx := testContext(t)
+	adminUser := randomUser()
+	tenant, err := createTestTenant(t.Name(), adminUser, globalTestApp.TenantClient())
+	require.NoError(t, err)
+
+	device, notificationToken := createCallableTestDevice(ctx, t, tenant.Id, adminUser)
+	senderUser := randomUser()
+	senderMemberId := addTestMember(ctx, t, tenant.Id, adminUser, senderUser, homecallv1alpha.Role_ROLE_MEMBER)
+
+	sendMessage := func(msg *homecallv1alpha.SendMessageRequest) (*homecallv1alpha.DeviceMessage, error) {
+		msg.DeviceId = device.ID
+		sent, err := globalTestApp.OfficeClient().SendMessage(ctx, auth.WithDummyToken(senderUser, &connect.Request[homecallv1alpha.SendMessageRequest]{Msg: msg}))
+		if err != nil {
+			return nil, err
+		}
+		return sent.Msg.GetMessage(), nil
+	}
+	listMessages := func() []*homecallv1alpha.DeviceMessage {
+		listed, err := globalTestApp.DeviceClient().ListMessages(ctx, auth.WithToken(device.mustToken(t), &connect.Request[homecallv1alpha.ListMessagesRequest]{
+			Msg: &homecallv1alpha.ListMessagesRequest{},
+		}))
+		require.NoError(t, err)
+		return listed.Msg.GetMessages()
+	}
+
+	// Messages are limited in size and images must be something a device can show
+	_, err = sendMessage(&homecallv1alpha.SendMessageRequest{})
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	_, err = sendMessage(&homecallv1alpha.SendMessageRequest{Content: &homecallv1alpha.SendMessageRequest_Text{Text: strings.Repeat("a", 1001)}})
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	_, err = sendMessage(&homecallv1alpha.SendMessageRequest{Content: &homecallv1alpha.SendMessageRequest_Image{Image: []byte("not an image")}})
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	text, err := sendMessage(&homecallv1alpha.SendMessageRequest{Content: &homecallv1alpha.SendMessageRequest_Text{Text: "Vi ses på söndag!"}})
+	require.NoError(t, err)
+	assert.Equal(t, senderMemberId, text.GetSenderMemberId())
+	assert.Equal(t, "Vi ses på söndag!", text.GetText())
+	assert.Nil(t, text.GetReadAt())
+
+	png := append([]byte("\x89PNG\x0d\x0a\x1a\x0a"), make([]byte, 64)...)
+	image, err := sendMessage(&homecallv1alpha.SendMessageRequest{Content: &homecallv1alpha.SendMessageRequest_Image{Image: png}})
+	require.NoError(t, err)
+	assert.Equal(t, "image/png", image.GetImage().GetContentType())
+	assert.Equal(t, int64(len(png)), image.GetImage().GetSizeBytes())
+
+	// The device is notified about every message
+	notificationDir := path.Join(globalTestApp.NotificationsDir(), directorynotifications.DevicesDirectory, notificationToken)
+	entries, err := os.ReadDir(notificationDir)
+	require.NoError(t, err)
+	var notified []string
+	for _, entry := range entries {
+		content, err := os.ReadFile(path.Join(notificationDir, entry.Name()))
+		require.NoError(t, err)
+		message := &messaging.Message{}
+		require.NoError(t, message.UnmarshalJSON(content))
+		if message.Data["type"] == "message" {
+			notified = append(notified, message.Data["messageId"])
+		}
+	}
+	assert.ElementsMatch(t, []string{text.GetId(), image.GetId()}, notified)
+
+	listed := listMessages()
+	require.Len(t, listed, 2)
+	assert.Equal(t, image.GetId(), listed[0].GetId())
+	assert.Equal(t, text.GetId(), listed[1].GetId())
+
+	// Photos are downloaded separately from the list
+	getMessageImage := func(messageId string) ([]byte, error) {
+		downloaded, err := globalTestApp.DeviceClient().GetMessageImage(ctx, auth.WithToken(device.mustToken(t), &connect.Request[homecallv1alpha.GetMessageImageRequest]{
+			Msg: &homecallv1alpha.GetMessageImageRequest{MessageId: messageId},
+		}))
+		if err != nil {
+			return nil, err
+		}
+		return downloaded.Msg.GetData(), nil
+	}
+	data, err := getMessageImage(image.GetId())
+	require.NoError(t, err)
+	assert.Equal(t, png, data)
+	_, err = getMessageImage(text.GetId())
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	getDeviceMessageImage := func(user string) ([]byte, error) {
+		downloaded, err := globalTestApp.OfficeClient().GetDeviceMessageImage(ctx, auth.WithDummyToken(user, &connect.Request[homecallv1alpha.GetDeviceMessageImageRequest]{
+			Msg: &homecallv1alpha.GetDeviceMessageImageRequest{MessageId: image.GetId()},
+		}))
+		if err != nil {
+			return nil, err
+		}
+		return downloaded.Msg.GetData(), nil
+	}
+	data, err = getDeviceMessageImage(senderUser)
+	require.NoError(t, err)
+	assert.Equal(t, png, data)
+	_, err = getDeviceMessageImage(randomUser())
+	assert.Error(t, err)
+
+	// Read receipts are shown in the office app
+	ackMessage := func(messageId string) error {
+		_, err := globalTestApp.DeviceClient().AckMessage(ctx, auth.WithToken(device.mustToken(t), &connect.Request[homecallv1alpha.AckMessageRequest]{
+			Msg: &homecallv1alpha.AckMessageRequest{MessageId: messageId},
+		}))
+		return err
+	}
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(ackMessage("unknown")))
+	require.NoError(t, ackMessage(text.GetId()))
+	require.NoError(t, ackMessage(text.GetId()))
+
+	sentMessages, err := globalTestApp.OfficeClient().ListDeviceMessages(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.ListDeviceMessagesRequest]{
+		Msg: &homecallv1alpha.ListDeviceMessagesRequest{DeviceId: device.ID},
+	}))
+	require.NoError(t, err)
+	require.Len(t, sentMessages.Msg.GetMessages(), 2)
+	assert.Nil(t, sentMessages.Msg.GetMessages()[0].GetReadAt())
+	assert.NotNil(t, sentMessages.Msg.GetMessages()[1].GetReadAt())
+
+	// Only the latest messages are kept
+	for i := 0; i < 20; i++ {
+		_, err := sendMessage(&homecallv1alpha.SendMessageRequest{Content: &homecallv1alpha.SendMessageRequest_Text{Text: fmt.Sprintf("Meddelande %d", i)}})
+		require.NoError(t, err)
+	}
+	listed = listMessages()
+	require.Len(t, listed, 20)
+	assert.Equal(t, "Meddelande 19", listed[0].GetText())
+	assert.Equal(t, "Meddelande 0", listed[19].GetText())
+	_, err = getMessageImage(image.GetId())
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+func TestAlbums(t *testing.T) {
+	t.Parallel()
+	ctx := testContext(t)
+	adminUser := randomUser()
+	tenant, err := createTestTenant(t.Name(), adminUser, globalTestApp.TenantClient())
+	require.NoError(t, err)
+
+	device, _ := createCallableTestDevice(ctx, t, tenant.Id, adminUser)
+	createGroup := func(name string) string {
+		resp, err := globalTestApp.OfficeClient().CreateDeviceGroup(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.CreateDeviceGroupRequest]{
+			Msg: &homecallv1alpha.CreateDeviceGroupRequest{TenantId: tenant.Id, Name: name},
+		}))
+		require.NoError(t, err)
+		return resp.Msg.GetGroup().GetId()
+	}
+	homeGroupId := createGroup("Home")
+	otherGroupId := createGroup("Other")
+	_, err = globalTestApp.OfficeClient().SetDeviceGroup(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.SetDeviceGroupRequest]{
+		Msg: &homecallv1alpha.SetDeviceGroupRequest{DeviceId: device.ID, GroupId: homeGroupId},
+	}))
+	require.NoError(t, err)
+
+	createAlbum := func(user string, msg *homecallv1alpha.CreateAlbumRequest) (*homecallv1alpha.Album, error) {
+		msg.TenantId = tenant.Id
+		resp, err := globalTestApp.OfficeClient().CreateAlbum(ctx, auth.WithDummyToken(user, &connect.Request[homecallv1alpha.CreateAlbumRequest]{Msg: msg}))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg.GetAlbum(), nil
+	}
+	addImage := func(user string, albumId string, image []byte) (*homecallv1alpha.AlbumImage, error) {
+		resp, err := globalTestApp.OfficeClient().AddAlbumImage(ctx, auth.WithDummyToken(user, &connect.Request[homecallv1alpha.AddAlbumImageRequest]{
+			Msg: &homecallv1alpha.AddAlbumImageRequest{AlbumId: albumId, Image: image},
+		}))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg.GetImage(), nil
+	}
+	syncContent := func(etag string) *homecallv1alpha.SyncContentResponse {
+		resp, err := globalTestApp.DeviceClient().SyncContent(ctx, auth.WithToken(device.mustToken(t), &connect.Request[homecallv1alpha.SyncContentRequest]{
+			Msg: &homecallv1alpha.SyncContentRequest{Etag: etag},
+		}))
+		require.NoError(t, err)
+		return resp.Msg
+	}
+
+	_, err = createAlbum(adminUser, &homecallv1alpha.CreateAlbumRequest{Name: "No target"})
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	deviceAlbum, err := createAlbum(adminUser, &homecallv1alpha.CreateAlbumRequest{
+		Name:   "Barnbarnen",
+		Target: &homecallv1alpha.CreateAlbumRequest_DeviceId{DeviceId: device.ID},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, device.ID, deviceAlbum.GetDeviceId())
+	groupAlbum, err := createAlbum(adminUser, &homecallv1alpha.CreateAlbumRequest{
+		Name:   "Sommaren",
+		Target: &homecallv1alpha.CreateAlbumRequest_GroupId{GroupId: homeGroupId},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, homeGroupId, groupAlbum.GetGroupId())
+	_, err = createAlbum(adminUser, &homecallv1alpha.CreateAlbumRequest{
+		Name:   "Elsewhere",
+		Target: &homecallv1alpha.CreateAlbumRequest_GroupId{GroupId: otherGroupId},
+	})
+	require.NoError(t, err)
+
+	_, err = addImage(adminUser, deviceAlbum.GetId(), []byte("not an image"))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	png := append([]byte("\x89PNG\x0d\x0a\x1a\x0a"), make([]byte, 64)...)
+	image, err := addImage(adminUser, deviceAlbum.GetId(), png)
+	require.NoError(t, err)
+	assert.Equal(t, "image/png", image.GetContentType())
+	assert.Equal(t, int64(len(png)), image.GetSizeBytes())
+	assert.NotEmpty(t, image.GetEtag())
+	_, err = addImage(adminUser, groupAlbum.GetId(), png)
+	require.NoError(t, err)
+
+	// The device gets the albums shown on it, directly or through its group
+	synced := syncContent("")
+	assert.False(t, synced.GetNotModified())
+	require.Len(t, synced.GetAlbums(), 2)
+	assert.Equal(t, deviceAlbum.GetId(), synced.GetAlbums()[0].GetId())
+	assert.Equal(t, groupAlbum.GetId(), synced.GetAlbums()[1].GetId())
+	require.Len(t, synced.GetAlbums()[0].GetImages(), 1)
+	assert.Equal(t, image.GetEtag(), synced.GetAlbums()[0].GetImages()[0].GetEtag())
+
+	unchanged := syncContent(synced.GetEtag())
+	assert.True(t, unchanged.GetNotModified())
+	assert.Equal(t, synced.GetEtag(), unchanged.GetEtag())
+	assert.Empty(t, unchanged.GetAlbums())
+
+	downloaded, err := globalTestApp.DeviceClient().GetAlbumImage(ctx, auth.WithToken(device.mustToken(t), &connect.Request[homecallv1alpha.GetAlbumImageRequest]{
+		Msg: &homecallv1alpha.GetAlbumImageRequest{ImageId: image.GetId()},
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, png, downloaded.Msg.GetData())
+	assert.Equal(t, image.GetEtag(), downloaded.Msg.GetImage().GetEtag())
+
+	// Members scoped to another group can not see or change the albums
+	memberUser := randomUser()
+	memberId := addTestMember(ctx, t, tenant.Id, adminUser, memberUser, homecallv1alpha.Role_ROLE_MEMBER)
+	_, err = globalTestApp.TenantClient().SetTenantMemberDeviceGroups(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.SetTenantMemberDeviceGroupsRequest]{
+		Msg: &homecallv1alpha.SetTenantMemberDeviceGroupsRequest{
+			MemberId:          memberId,
+			DeviceGroupScoped: true,
+			DeviceGroupIds:    []string{otherGroupId},
+		},
+	}))
+	require.NoError(t, err)
+	_, err = addImage(memberUser, groupAlbum.GetId(), png)
+	assert.Error(t, err)
+	_, err = addImage(memberUser, deviceAlbum.GetId(), png)
+	assert.Error(t, err)
+	memberAlbums, err := globalTestApp.OfficeClient().ListAlbums(ctx, auth.WithDummyToken(memberUser, &connect.Request[homecallv1alpha.ListAlbumsRequest]{
+		Msg: &homecallv1alpha.ListAlbumsRequest{TenantId: tenant.Id},
+	}))
+	require.NoError(t, err)
+	require.Len(t, memberAlbums.Msg.GetAlbums(), 1)
+	assert.Equal(t, "Elsewhere", memberAlbums.Msg.GetAlbums()[0].GetName())
+
+	// Removing content changes the manifest
+	_, err = globalTestApp.OfficeClient().RemoveAlbumImage(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.RemoveAlbumImageRequest]{
+		Msg: &homecallv1alpha.RemoveAlbumImageRequest{ImageId: image.GetId()},
+	}))
+	require.NoError(t, err)
+	_, err = globalTestApp.DeviceClient().GetAlbumImage(ctx, auth.WithToken(device.mustToken(t), &connect.Request[homecallv1alpha.GetAlbumImageRequest]{
+		Msg: &homecallv1alpha.GetAlbumImageRequest{ImageId: image.GetId()},
+	}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	_, err = globalTestApp.OfficeClient().DeleteAlbum(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.DeleteAlbumRequest]{
+		Msg: &homecallv1alpha.DeleteAlbumRequest{AlbumId: groupAlbum.GetId()},
+	}))
+	require.NoError(t, err)
+	changed := syncContent(synced.GetEtag())
+	assert.False(t, changed.GetNotModified())
+	assert.NotEqual(t, synced.GetEtag(), changed.GetEtag())
+	require.Len(t, changed.GetAlbums(), 1)
+	assert.Empty(t, changed.GetAlbums()[0].GetImages())
+}
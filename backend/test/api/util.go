@@ -1,9 +1,18 @@
 package api
 
 import (
+	"bytes"
 	"connectrpc.com/connect"
 	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
 	"net"
 	homecallv1alpha "sidus.io/home-call/gen/connect/homecall/v1alpha"
 	"sidus.io/home-call/gen/connect/homecall/v1alpha/homecallv1alphaconnect"
@@ -12,6 +21,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 )
 
 func createTestTenant(name string, adminUser string, tenantClient homecallv1alphaconnect.TenantServiceClient) (*homecallv1alpha.Tenant, error) {
@@ -29,6 +39,88 @@ func createTestTenant(name string, adminUser string, tenantClient homecallv1alph
 	return createRsp.Msg.GetTenant(), nil
 }
 
+type testDevice struct {
+	ID  string
+	key crypto.Signer
+	// method is the signing method of the key, defaults to RS256.
+	method jwt.SigningMethod
+}
+
+// Token returns a freshly signed device token.
+func (d *testDevice) Token() (string, error) {
+	method := d.method
+	if method == nil {
+		method = jwt.SigningMethodRS256
+	}
+	return jwt.NewWithClaims(method, jwt.RegisteredClaims{
+		ID:        uuid.New().String(),
+		Subject:   d.ID,
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(5 * time.Minute)),
+		IssuedAt:  jwt.NewNumericDate(time.Now()),
+		Issuer:    "homecall-device",
+		Audience:  jwt.ClaimStrings{"homecall"},
+	}).SignedString(d.key)
+}
+
+// mustToken returns a freshly signed device token, tokens can only be used once.
+func (d *testDevice) mustToken(t *testing.T) string {
+	t.Helper()
+	token, err := d.Token()
+	require.NoError(t, err)
+	return token
+}
+
+// createEnrolledTestDevice creates a device in the tenant and enrolls it with a new key.
+func createEnrolledTestDevice(ctx context.Context, tenantID string, adminUser string, officeClient homecallv1alphaconnect.OfficeServiceClient, deviceClient homecallv1alphaconnect.DeviceServiceClient) (*testDevice, error) {
+	device, err := officeClient.CreateDevice(ctx, auth.WithDummyToken(adminUser, &connect.Request[homecallv1alpha.CreateDeviceRequest]{
+		Msg: &homecallv1alpha.CreateDeviceRequest{
+			Name:            fmt.Sprintf("test-%s", randomUser()),
+			TenantId:        tenantID,
+			DefaultSettings: &homecallv1alpha.DeviceSettings{},
+		},
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create device: %w", err)
+	}
+
+	key, publicKey, err := generateTestKey()
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = deviceClient.Enroll(ctx, &connect.Request[homecallv1alpha.EnrollRequest]{
+		Msg: &homecallv1alpha.EnrollRequest{
+			EnrollmentKey: device.Msg.GetDevice().GetEnrollmentKey(),
+			PublicKey:     publicKey,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to enroll device: %w", err)
+	}
+
+	return &testDevice{
+		ID:  device.Msg.GetDevice().GetId(),
+		key: key,
+	}, nil
+}
+
+// generateTestKey generates a device key pair and returns the PEM encoded public key.
+func generateTestKey() (*rsa.PrivateKey, string, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to generate key: %w", err)
+	}
+	var publicKey bytes.Buffer
+	err = pem.Encode(&publicKey, &pem.Block{
+		Type:  "RSA PUBLIC KEY",
+		Bytes: x509.MarshalPKCS1PublicKey(&key.PublicKey),
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to encode public key: %w", err)
+	}
+	return key, publicKey.String(), nil
+}
+
 func randomUser() string {
 	user, err := util.RandomString(10)
 	if err != nil {
@@ -0,0 +1,13 @@
+package util
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// HashSecret returns the hex encoded SHA-256 hash of a secret.
+// Only use it for randomly generated high entropy secrets, not for passwords.
+func HashSecret(secret string) string {
+	hash := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(hash[:])
+}
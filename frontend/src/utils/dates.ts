@@ -0,0 +1,11 @@
+/**
+ * Format a protobuf timestamp as a Swedish date and time, e.g. "2024-05-01 13:37".
+ */
+const formatTimestamp = (timestamp?: { toDate(): Date }) => {
+    if (!timestamp) {
+        return ''
+    }
+    return timestamp.toDate().toLocaleString('sv-SE', { dateStyle: 'short', timeStyle: 'short' })
+}
+
+export default formatTimestamp;